@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,9 +18,23 @@ type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetValueForKey(address string, key string) (string, error)
 	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccountsBulk(addresses []string) ([]*external.AccountResponse, error)
 	IsInterfaceNil() bool
 }
 
+const maxAccountsBulkSize = 100
+
+// AccountsBulkRequest represents the structure that holds the addresses of a bulk accounts request
+type AccountsBulkRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+type accountBulkResponse struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	Balance string `json:"balance"`
+}
+
 type accountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
@@ -34,6 +49,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/:address", GetAccount)
 	router.RegisterHandler(http.MethodGet, "/:address/balance", GetBalance)
 	router.RegisterHandler(http.MethodGet, "/:address/key/:key", GetValueForKey)
+	router.RegisterHandler(http.MethodPost, "/bulk", GetAccountsBulk)
 }
 
 // GetAccount returns an accountResponse containing information
@@ -54,6 +70,47 @@ func GetAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
 }
 
+// GetAccountsBulk returns the nonce and the balance of at most 100 accounts, all read from the last committed state
+func GetAccountsBulk(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var request AccountsBulkRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+	if len(request.Addresses) == 0 || len(request.Addresses) > maxAccountsBulkSize {
+		c.JSON(
+			http.StatusBadRequest,
+			gin.H{"error": fmt.Sprintf("%s: %s, expected between 1 and %d",
+				errors.ErrValidation.Error(), errors.ErrInvalidAccountsBulkSize.Error(), maxAccountsBulkSize)},
+		)
+		return
+	}
+
+	accounts, err := ef.GetAccountsBulk(request.Addresses)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccountsBulk.Error(), err.Error())})
+		return
+	}
+
+	response := make([]accountBulkResponse, 0, len(accounts))
+	for i, account := range accounts {
+		response = append(response, accountBulkResponse{
+			Address: request.Addresses[i],
+			Nonce:   account.Nonce,
+			Balance: account.Balance.String(),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"accounts": response})
+}
+
 // GetBalance returns the balance for the address parameter
 func GetBalance(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
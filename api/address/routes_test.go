@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +64,15 @@ type AccountResponse struct {
 	} `json:"account"`
 }
 
+type accountsBulkResponse struct {
+	GeneralResponse
+	Accounts []struct {
+		Address string `json:"address"`
+		Nonce   uint64 `json:"nonce"`
+		Balance string `json:"balance"`
+	} `json:"accounts"`
+}
+
 func TestAddressRoute_EmptyTrailReturns404(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{}
@@ -275,6 +286,100 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccountsBulk_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountsBulkCalled: func(addresses []string) ([]*external.AccountResponse, error) {
+			assert.Equal(t, []string{"address1", "address2"}, addresses)
+			return []*external.AccountResponse{
+				{Address: []byte("decoded1"), Nonce: 1, Balance: big.NewInt(100)},
+				{Address: []byte("decoded2"), Nonce: 0, Balance: big.NewInt(0)},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/address/bulk", bytes.NewBufferString(`{"addresses":["address1","address2"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsBulkResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, 2, len(response.Accounts))
+	assert.Equal(t, "address1", response.Accounts[0].Address)
+	assert.Equal(t, uint64(1), response.Accounts[0].Nonce)
+	assert.Equal(t, "100", response.Accounts[0].Balance)
+	assert.Equal(t, "address2", response.Accounts[1].Address)
+	assert.Equal(t, "0", response.Accounts[1].Balance)
+}
+
+func TestGetAccountsBulk_TooManyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountsBulkCalled: func(addresses []string) ([]*external.AccountResponse, error) {
+			assert.Fail(t, "should have not read the accounts")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	addresses := make([]string, 101)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("address%d", i)
+	}
+	buff, _ := json.Marshal(&address.AccountsBulkRequest{Addresses: addresses})
+	req, _ := http.NewRequest("POST", "/address/bulk", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsBulkResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidAccountsBulkSize.Error()))
+}
+
+func TestGetAccountsBulk_NoAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/address/bulk", bytes.NewBufferString(`{"addresses":[]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsBulkResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidAccountsBulkSize.Error()))
+}
+
+func TestGetAccountsBulk_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAccountsBulkCalled: func(addresses []string) ([]*external.AccountResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/address/bulk", bytes.NewBufferString(`{"addresses":["address1"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountsBulkResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Empty(t, response.Accounts)
+	assert.True(t, strings.Contains(response.Error, fmt.Sprintf("%s: %s", errors2.ErrCouldNotGetAccountsBulk.Error(), expectedErr.Error())))
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -319,6 +424,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/bulk", Open: true},
 				},
 			},
 		},
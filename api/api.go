@@ -76,6 +76,9 @@ func Start(elrondFacade MainApiHandler, routesConfig config.ApiRoutesConfig, pro
 	}
 	ws = gin.Default()
 	ws.Use(cors.Default())
+	if routesConfig.GzipResponsesEnabled {
+		ws.Use(middleware.WithGzipResponses())
+	}
 	for _, proc := range processors {
 		if check.IfNil(proc) {
 			continue
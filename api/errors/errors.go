@@ -13,6 +13,12 @@ var ErrInvalidJSONRequest = errors.New("invalid json request")
 // ErrCouldNotGetAccount signals that a requested account could not be retrieved
 var ErrCouldNotGetAccount = errors.New("could not get requested account")
 
+// ErrCouldNotGetAccountsBulk signals that the requested accounts could not be retrieved
+var ErrCouldNotGetAccountsBulk = errors.New("could not get requested accounts")
+
+// ErrInvalidAccountsBulkSize signals that the number of requested accounts is empty or exceeds the maximum allowed one
+var ErrInvalidAccountsBulkSize = errors.New("invalid number of requested accounts")
+
 // ErrGetBalance signals an error in getting the balance for an account
 var ErrGetBalance = errors.New("get balance error")
 
@@ -42,3 +48,18 @@ var ErrTxNotFound = errors.New("transaction was not found")
 
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
+
+// ErrSCQueryTimeout signals that the smart contract query did not finish in the allowed time
+var ErrSCQueryTimeout = errors.New("smart contract query timeout")
+
+// ErrInvalidStatisticsDetail signals that an unknown statistics detail level was requested
+var ErrInvalidStatisticsDetail = errors.New("invalid statistics detail, expected minimal or full")
+
+// ErrInvalidValidatorsSortCriterion signals that an unknown sort criterion was requested for the validators page
+var ErrInvalidValidatorsSortCriterion = errors.New("invalid sort criterion, expected rating or pubkey")
+
+// ErrInvalidValidatorsPageSize signals that the requested validators page size exceeds the maximum allowed one
+var ErrInvalidValidatorsPageSize = errors.New("invalid validators page size")
+
+// ErrStatisticsNotAvailable signals that the statistics were requested before the TPS benchmark was initialized
+var ErrStatisticsNotAvailable = errors.New("statistics not yet available")
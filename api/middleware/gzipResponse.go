@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gzipResponseWriter compresses the body written by the handlers before sending it to the client
+type gzipResponseWriter struct {
+	gin.ResponseWriter
+	gzipWriter *gzip.Writer
+}
+
+// Write compresses the provided data
+func (grw *gzipResponseWriter) Write(data []byte) (int, error) {
+	grw.Header().Del("Content-Length")
+	return grw.gzipWriter.Write(data)
+}
+
+// WriteString compresses the provided string
+func (grw *gzipResponseWriter) WriteString(s string) (int, error) {
+	return grw.Write([]byte(s))
+}
+
+// WithGzipResponses middleware will gzip compress the responses of the requests accepting the gzip encoding. The
+// websocket upgrade requests are not compressed
+func WithGzipResponses() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.Contains(c.GetHeader("Accept-Encoding"), "gzip") || c.GetHeader("Upgrade") != "" {
+			c.Next()
+			return
+		}
+
+		c.Header("Content-Encoding", "gzip")
+		c.Header("Vary", "Accept-Encoding")
+
+		gzipWriter := gzip.NewWriter(c.Writer)
+		c.Writer = &gzipResponseWriter{
+			ResponseWriter: c.Writer,
+			gzipWriter:     gzipWriter,
+		}
+		defer func() {
+			_ = gzipWriter.Close()
+		}()
+
+		c.Next()
+	}
+}
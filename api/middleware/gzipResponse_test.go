@@ -0,0 +1,71 @@
+package middleware_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const gzipTestResponse = "response that will be compressed"
+
+func startGzipResponsesServer() *gin.Engine {
+	ws := gin.New()
+	ws.Use(middleware.WithGzipResponses())
+	ws.GET("/test", func(c *gin.Context) {
+		c.String(http.StatusOK, gzipTestResponse)
+	})
+
+	return ws
+}
+
+func TestWithGzipResponses_GzipAcceptedShouldCompress(t *testing.T) {
+	t.Parallel()
+
+	ws := startGzipResponsesServer()
+	req, _ := http.NewRequest("GET", "/test", nil)
+	req.Header.Set("Accept-Encoding", "deflate, gzip")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "gzip", resp.Header().Get("Content-Encoding"))
+
+	gzipReader, err := gzip.NewReader(resp.Body)
+	assert.Nil(t, err)
+	body, err := ioutil.ReadAll(gzipReader)
+	assert.Nil(t, err)
+	assert.Equal(t, gzipTestResponse, string(body))
+}
+
+func TestWithGzipResponses_GzipNotAcceptedShouldNotCompress(t *testing.T) {
+	t.Parallel()
+
+	ws := startGzipResponsesServer()
+	req, _ := http.NewRequest("GET", "/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Header().Get("Content-Encoding"))
+	assert.Equal(t, gzipTestResponse, resp.Body.String())
+}
+
+func TestWithGzipResponses_WebsocketUpgradeShouldNotCompress(t *testing.T) {
+	t.Parallel()
+
+	ws := startGzipResponsesServer()
+	req, _ := http.NewRequest("GET", "/test", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	req.Header.Set("Upgrade", "websocket")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Empty(t, resp.Header().Get("Content-Encoding"))
+	assert.Equal(t, gzipTestResponse, resp.Body.String())
+}
@@ -20,21 +20,77 @@ type Facade struct {
 	ShouldErrorStop                   bool
 	TpsBenchmarkHandler               func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler              func() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatsSummaryCalled        func() (*data.HeartbeatSummary, error)
+	GetPeerInfoCalled                 func() *external.PeerInfo
 	BalanceHandler                    func(string) (*big.Int, error)
 	GetAccountHandler                 func(address string) (state.UserAccountHandler, error)
+	GetAccountsBulkCalled             func(addresses []string) ([]*external.AccountResponse, error)
 	GenerateTransactionHandler        func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler             func(hash string) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler          func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler        func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler       func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryAsyncHandler        func(query *process.SCQuery) <-chan process.SCQueryResult
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler        func() (map[string]*state.ValidatorApiResponse, error)
+	ValidatorsPageHandler             func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int)
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                  func() map[string]interface{}
 	GetQueryHandlerCalled             func(name string) (debug.QueryHandler, error)
 	GetTransactionStatusCalled        func(hash string) (string, error)
 	GetValueForKeyCalled              func(address string, key string) (string, error)
+	ForceCleanTxsPoolsCalled          func() (int, error)
+	GetBootstrapStatusCalled          func() (*external.BootstrapStatus, error)
+	GetEpochInfoCalled                func() (*external.EpochInfo, error)
+	CheckIndexerHealthCalled          func() error
+	ReindexBlockCalled                func(hash string) error
+	GetIndexerGapsCalled              func(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
+}
+
+// GetIndexerGaps -
+func (f *Facade) GetIndexerGaps(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	if f.GetIndexerGapsCalled != nil {
+		return f.GetIndexerGapsCalled(fromNonce, toNonce, shardID)
+	}
+
+	return nil, nil
+}
+
+// ReindexBlock -
+func (f *Facade) ReindexBlock(hash string) error {
+	if f.ReindexBlockCalled != nil {
+		return f.ReindexBlockCalled(hash)
+	}
+
+	return nil
+}
+
+// CheckIndexerHealth -
+func (f *Facade) CheckIndexerHealth() error {
+	if f.CheckIndexerHealthCalled != nil {
+		return f.CheckIndexerHealthCalled()
+	}
+
+	return nil
+}
+
+// GetEpochInfo -
+func (f *Facade) GetEpochInfo() (*external.EpochInfo, error) {
+	if f.GetEpochInfoCalled != nil {
+		return f.GetEpochInfoCalled()
+	}
+
+	return &external.EpochInfo{}, nil
+}
+
+// GetBootstrapStatus -
+func (f *Facade) GetBootstrapStatus() (*external.BootstrapStatus, error) {
+	if f.GetBootstrapStatusCalled != nil {
+		return f.GetBootstrapStatusCalled()
+	}
+
+	return &external.BootstrapStatus{}, nil
 }
 
 // GetTransactionStatus -
@@ -70,6 +126,30 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return f.GetHeartbeatsHandler()
 }
 
+// GetHeartbeatsSummary returns the aggregated heartbeat info
+func (f *Facade) GetHeartbeatsSummary() (*data.HeartbeatSummary, error) {
+	if f.GetHeartbeatsSummaryCalled != nil {
+		return f.GetHeartbeatsSummaryCalled()
+	}
+	return &data.HeartbeatSummary{}, nil
+}
+
+// ForceCleanTxsPools -
+func (f *Facade) ForceCleanTxsPools() (int, error) {
+	if f.ForceCleanTxsPoolsCalled != nil {
+		return f.ForceCleanTxsPoolsCalled()
+	}
+	return 0, nil
+}
+
+// GetPeerInfo is the mock implementation of a handler's GetPeerInfo method
+func (f *Facade) GetPeerInfo() *external.PeerInfo {
+	if f.GetPeerInfoCalled != nil {
+		return f.GetPeerInfoCalled()
+	}
+	return &external.PeerInfo{}
+}
+
 // GetBalance is the mock implementation of a handler's GetBalance method
 func (f *Facade) GetBalance(address string) (*big.Int, error) {
 	return f.BalanceHandler(address)
@@ -123,11 +203,44 @@ func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRespons
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetAccountsBulk is the mock implementation of a handler's GetAccountsBulk method
+func (f *Facade) GetAccountsBulk(addresses []string) ([]*external.AccountResponse, error) {
+	if f.GetAccountsBulkCalled != nil {
+		return f.GetAccountsBulkCalled(addresses)
+	}
+
+	return make([]*external.AccountResponse, 0), nil
+}
+
+// ValidatorsPageApi is the mock implementation of a handler's ValidatorsPageApi method
+func (f *Facade) ValidatorsPageApi(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+	if f.ValidatorsPageHandler != nil {
+		return f.ValidatorsPageHandler(offset, limit, sortBy)
+	}
+
+	return make([]state.ValidatorApiResponseWithKey, 0), 0
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueryAsync is a mock implementation. It delivers the ExecuteSCQueryHandler result if no async handler is set
+func (f *Facade) ExecuteSCQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	if f.ExecuteSCQueryAsyncHandler != nil {
+		return f.ExecuteSCQueryAsyncHandler(query)
+	}
+
+	chResult := make(chan process.SCQueryResult, 1)
+	vmOutput, err := f.ExecuteSCQueryHandler(query)
+	chResult <- process.SCQueryResult{
+		VMOutput: vmOutput,
+		Err:      err,
+	}
+	return chResult
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
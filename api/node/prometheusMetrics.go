@@ -0,0 +1,115 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// prometheusContentType is the content type of the Prometheus text exposition format
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// prometheusInfoMetric is the metric holding the string metrics of the node as labels
+const prometheusInfoMetric = "erd_node_info"
+
+// prometheusInfoLabels holds the string metrics exported as labels of the info metric. Only the metrics which do not
+// change while the node runs are exported, as each new label value creates a new Prometheus time series
+var prometheusInfoLabels = map[string]struct{}{
+	core.MetricAppVersion:         {},
+	core.MetricNodeType:           {},
+	core.MetricPeerType:           {},
+	core.MetricNodeDisplayName:    {},
+	core.MetricPublicKeyBlockSign: {},
+}
+
+// formatPrometheusMetrics renders the provided metrics in the Prometheus text exposition format. The numeric metrics
+// are exported as gauges, while the stable string metrics are exported as the labels of a single info metric
+func formatPrometheusMetrics(metrics map[string]interface{}) string {
+	keys := make([]string, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	labels := make([]string, 0)
+	for _, key := range keys {
+		name := sanitizePrometheusName(key)
+		switch value := metrics[key].(type) {
+		case string:
+			_, isInfoLabel := prometheusInfoLabels[key]
+			if !isInfoLabel {
+				continue
+			}
+			labels = append(labels, fmt.Sprintf("%s=\"%s\"", name, escapePrometheusLabelValue(value)))
+		case bool:
+			writePrometheusGauge(&builder, name, boolToGaugeValue(value))
+		default:
+			gaugeValue, ok := numberToGaugeValue(value)
+			if !ok {
+				continue
+			}
+			writePrometheusGauge(&builder, name, gaugeValue)
+		}
+	}
+
+	if len(labels) > 0 {
+		builder.WriteString(fmt.Sprintf("# TYPE %s gauge\n", prometheusInfoMetric))
+		builder.WriteString(fmt.Sprintf("%s{%s} 1\n", prometheusInfoMetric, strings.Join(labels, ",")))
+	}
+
+	return builder.String()
+}
+
+func writePrometheusGauge(builder *strings.Builder, name string, value string) {
+	builder.WriteString(fmt.Sprintf("# TYPE %s gauge\n", name))
+	builder.WriteString(fmt.Sprintf("%s %s\n", name, value))
+}
+
+func numberToGaugeValue(value interface{}) (string, bool) {
+	switch number := value.(type) {
+	case uint64:
+		return strconv.FormatUint(number, 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(number), 10), true
+	case int64:
+		return strconv.FormatInt(number, 10), true
+	case int:
+		return strconv.Itoa(number), true
+	case float64:
+		return strconv.FormatFloat(number, 'g', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+func boolToGaugeValue(value bool) string {
+	if value {
+		return "1"
+	}
+
+	return "0"
+}
+
+// sanitizePrometheusName replaces the characters not allowed in the Prometheus metric and label names with underscores
+func sanitizePrometheusName(name string) string {
+	runes := []rune(name)
+	for i, r := range runes {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
+		isDigit := r >= '0' && r <= '9'
+		if !isLetter && !(isDigit && i > 0) {
+			runes[i] = '_'
+		}
+	}
+
+	return string(runes)
+}
+
+func escapePrometheusLabelValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return strings.ReplaceAll(value, "\n", `\n`)
+}
@@ -17,9 +17,17 @@ import (
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatsSummary() (*data.HeartbeatSummary, error)
+	GetPeerInfo() *external.PeerInfo
 	TpsBenchmark() *statistics.TpsBenchmark
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
+	ForceCleanTxsPools() (int, error)
+	GetBootstrapStatus() (*external.BootstrapStatus, error)
+	GetEpochInfo() (*external.EpochInfo, error)
+	CheckIndexerHealth() error
+	ReindexBlock(hash string) error
+	GetIndexerGaps(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
 	IsInterfaceNil() bool
 }
 
@@ -29,6 +37,13 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// IndexerGapsRequest represents the structure on which user input for searching the indexer gaps will validate against
+type IndexerGapsRequest struct {
+	ShardID   uint32 `form:"shard" json:"shard"`
+	FromNonce uint64 `form:"from" json:"from"`
+	ToNonce   uint64 `form:"to" json:"to"`
+}
+
 type statisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
@@ -42,6 +57,12 @@ type statisticsResponse struct {
 	NrOfShards            uint32                    `json:"nrOfShards"`
 }
 
+// minimalStatisticsResponse is the trimmed statistics payload, meant for the high frequency pollers
+type minimalStatisticsResponse struct {
+	LiveTPS     float64 `json:"liveTPS"`
+	BlockNumber uint64  `json:"blockNumber"`
+}
+
 type shardStatisticsResponse struct {
 	LiveTPS               float64  `json:"liveTPS"`
 	AverageTPS            *big.Int `json:"averageTPS"`
@@ -53,13 +74,28 @@ type shardStatisticsResponse struct {
 	LastBlockTxCount      uint32   `json:"lastBlockTxCount"`
 }
 
+const (
+	statisticsDetailParam   = "detail"
+	statisticsDetailMinimal = "minimal"
+	statisticsDetailFull    = "full"
+)
+
 // Routes defines node related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/heartbeatstatus", HeartbeatStatus)
+	router.RegisterHandler(http.MethodGet, "/heartbeatstatus/summary", HeartbeatStatusSummary)
 	router.RegisterHandler(http.MethodGet, "/statistics", Statistics)
 	router.RegisterHandler(http.MethodGet, "/status", StatusMetrics)
+	router.RegisterHandler(http.MethodGet, "/metrics", PrometheusMetrics)
 	router.RegisterHandler(http.MethodGet, "/p2pstatus", P2pStatusMetrics)
+	router.RegisterHandler(http.MethodGet, "/peerinfo", PeerInfo)
+	router.RegisterHandler(http.MethodGet, "/bootstrapstatus", BootstrapStatus)
+	router.RegisterHandler(http.MethodGet, "/indexer/health", IndexerHealth)
+	router.RegisterHandler(http.MethodGet, "/indexer/gaps", IndexerGaps)
+	router.RegisterHandler(http.MethodGet, "/epoch", EpochInfo)
 	router.RegisterHandler(http.MethodPost, "/debug", QueryDebug)
+	router.RegisterHandler(http.MethodPost, "/txspools/clean", ForceCleanTxsPools)
+	router.RegisterHandler(http.MethodPost, "/indexer/reindex/:hash", ReindexBlock)
 	// placeholder for custom routes
 }
 
@@ -80,7 +116,25 @@ func HeartbeatStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": hbStatus})
 }
 
-// Statistics returns the blockchain statistics
+// HeartbeatStatusSummary respond with the number of active and inactive peers from each shard
+func HeartbeatStatusSummary(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	summary, err := ef.GetHeartbeatsSummary()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"summary": summary})
+}
+
+// Statistics returns the blockchain statistics. The detail query parameter can be set to minimal in order to only
+// receive the live TPS and the block number or to full (default) in order to receive all the statistics
 func Statistics(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -88,7 +142,23 @@ func Statistics(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"statistics": statsFromTpsBenchmark(ef.TpsBenchmark())})
+	tpsBenchmark := ef.TpsBenchmark()
+	if tpsBenchmark == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": errors.ErrStatisticsNotAvailable.Error()})
+		return
+	}
+
+	switch c.Query(statisticsDetailParam) {
+	case "", statisticsDetailFull:
+		c.JSON(http.StatusOK, gin.H{"statistics": statsFromTpsBenchmark(tpsBenchmark)})
+	case statisticsDetailMinimal:
+		c.JSON(http.StatusOK, gin.H{"statistics": minimalStatsFromTpsBenchmark(tpsBenchmark)})
+	default:
+		c.JSON(
+			http.StatusBadRequest,
+			gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidStatisticsDetail.Error())},
+		)
+	}
 }
 
 // StatusMetrics returns the node statistics exported by an StatusMetricsHandler without p2p statistics
@@ -103,6 +173,18 @@ func StatusMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"details": details})
 }
 
+// PrometheusMetrics returns the same node statistics as StatusMetrics, rendered in the Prometheus text exposition format
+func PrometheusMetrics(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	metrics := formatPrometheusMetrics(ef.StatusMetrics().StatusMetricsMapWithoutP2P())
+	c.Data(http.StatusOK, prometheusContentType, []byte(metrics))
+}
+
 // P2pStatusMetrics returns the node's p2p statistics exported by a StatusMetricsHandler
 func P2pStatusMetrics(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
@@ -115,6 +197,70 @@ func P2pStatusMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"details": details})
 }
 
+// PeerInfo returns the node's peer ID, its advertised addresses and the number of its connected peers
+func PeerInfo(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"peerInfo": ef.GetPeerInfo()})
+}
+
+// BootstrapStatus returns the probable highest nonce of the network, the current nonce of the node and whether the
+// node is synced
+func BootstrapStatus(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	bootstrapStatus, err := ef.GetBootstrapStatus()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"bootstrapStatus": bootstrapStatus})
+}
+
+// IndexerHealth returns 200 if the indexer can reach the elasticsearch server and all its indexes exist, so that it can
+// be used as a readiness probe. It returns 503 and the cause otherwise
+func IndexerHealth(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	err := ef.CheckIndexerHealth()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// EpochInfo returns the current epoch and round of the node, together with the number of rounds left until the next epoch
+func EpochInfo(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	epochInfo, err := ef.GetEpochInfo()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"epochInfo": epochInfo})
+}
+
 func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResponse {
 	sr := statisticsResponse{}
 	sr.LiveTPS = tpsBenchmark.LiveTPS()
@@ -145,6 +291,13 @@ func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResp
 	return sr
 }
 
+func minimalStatsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) minimalStatisticsResponse {
+	return minimalStatisticsResponse{
+		LiveTPS:     tpsBenchmark.LiveTPS(),
+		BlockNumber: tpsBenchmark.BlockNumber(),
+	}
+}
+
 // QueryDebug returns the debug information after the query has been interpreted
 func QueryDebug(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
@@ -168,3 +321,63 @@ func QueryDebug(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"result": qh.Query(gtx.Search)})
 }
+
+// ForceCleanTxsPools will remove right away the stale transactions from the node's pools
+func ForceCleanTxsPools(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	numTxsCleaned, err := ef.ForceCleanTxsPools()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"numTxsCleaned": numTxsCleaned})
+}
+
+// ReindexBlock indexes again the block with the provided header hash, loading it from the node storage. It is meant to
+// repair a block found missing or corrupted in the index, without syncing the whole index again
+func ReindexBlock(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	err := ef.ReindexBlock(c.Param("hash"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+// IndexerGaps returns the nonces from the [from, to] range for which no block of the shard was found in the index, so
+// that the missing blocks can be reindexed
+func IndexerGaps(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var gapsRequest = IndexerGapsRequest{}
+	err := c.ShouldBindQuery(&gapsRequest)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	missingNonces, err := ef.GetIndexerGaps(gapsRequest.FromNonce, gapsRequest.ToNonce, gapsRequest.ShardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"missingNonces": missingNonces})
+}
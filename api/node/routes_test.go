@@ -2,6 +2,7 @@ package node_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	errs "errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -43,6 +45,31 @@ type QueryResponse struct {
 	Result []string `json:"result"`
 }
 
+type HeartbeatSummaryResponse struct {
+	GeneralResponse
+	Summary data.HeartbeatSummary `json:"summary"`
+}
+
+type PeerInfoResponse struct {
+	GeneralResponse
+	PeerInfo external.PeerInfo `json:"peerInfo"`
+}
+
+type BootstrapStatusResponse struct {
+	GeneralResponse
+	BootstrapStatus external.BootstrapStatus `json:"bootstrapStatus"`
+}
+
+type EpochInfoResponse struct {
+	GeneralResponse
+	EpochInfo external.EpochInfo `json:"epochInfo"`
+}
+
+type ForceCleanTxsPoolsResponse struct {
+	GeneralResponse
+	NumTxsCleaned int `json:"numTxsCleaned"`
+}
+
 type StatisticsResponse struct {
 	GeneralResponse
 	Statistics struct {
@@ -154,6 +181,56 @@ func TestHeartbeatstatus(t *testing.T) {
 	assert.NotEqual(t, "", statusRsp.Message)
 }
 
+func TestHeartbeatStatusSummary_FromFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetHeartbeatsSummaryCalled: func() (*data.HeartbeatSummary, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus/summary", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	summaryRsp := HeartbeatSummaryResponse{}
+	loadResponse(resp.Body, &summaryRsp)
+
+	assert.Equal(t, resp.Code, http.StatusInternalServerError)
+	assert.Equal(t, errExpected.Error(), summaryRsp.Error)
+}
+
+func TestHeartbeatStatusSummary(t *testing.T) {
+	t.Parallel()
+
+	summary := &data.HeartbeatSummary{
+		Shards: []data.ShardHeartbeatSummary{
+			{ShardID: 0, Active: 2, Inactive: 1, ActiveValidators: 1},
+		},
+		Active:           2,
+		Inactive:         1,
+		ActiveValidators: 1,
+		Total:            3,
+	}
+	facade := mock.Facade{
+		GetHeartbeatsSummaryCalled: func() (*data.HeartbeatSummary, error) {
+			return summary, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus/summary", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	summaryRsp := HeartbeatSummaryResponse{}
+	loadResponse(resp.Body, &summaryRsp)
+
+	assert.Equal(t, resp.Code, http.StatusOK)
+	assert.Equal(t, *summary, summaryRsp.Summary)
+}
+
 func TestStatistics_FailsWithoutFacade(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -200,6 +277,428 @@ func TestStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, statisticsRsp.Statistics.NrOfShards, nrOfShards)
 }
 
+func TestStatistics_NilTpsBenchmarkShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	facade.TpsBenchmarkHandler = func() *statistics.TpsBenchmark {
+		return nil
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statisticsRsp := StatisticsResponse{}
+	loadResponse(resp.Body, &statisticsRsp)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrStatisticsNotAvailable.Error(), statisticsRsp.Error)
+}
+
+func TestStatistics_MinimalDetailShouldOmitTheFullFields(t *testing.T) {
+	nrOfShards := uint32(10)
+	roundTime := uint64(4)
+	benchmark, _ := statistics.NewTPSBenchmark(nrOfShards, roundTime)
+
+	facade := mock.Facade{}
+	facade.TpsBenchmarkHandler = func() *statistics.TpsBenchmark {
+		return benchmark
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/statistics?detail=minimal", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := make(map[string]map[string]interface{})
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	minimalStatistics := response["statistics"]
+	assert.Contains(t, minimalStatistics, "liveTPS")
+	assert.Contains(t, minimalStatistics, "blockNumber")
+	assert.NotContains(t, minimalStatistics, "nrOfShards")
+	assert.NotContains(t, minimalStatistics, "shardStatistics")
+
+	req, _ = http.NewRequest("GET", "/node/statistics?detail=full", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = make(map[string]map[string]interface{})
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	fullStatistics := response["statistics"]
+	assert.Contains(t, fullStatistics, "liveTPS")
+	assert.Contains(t, fullStatistics, "blockNumber")
+	assert.Contains(t, fullStatistics, "nrOfShards")
+	assert.Contains(t, fullStatistics, "shardStatistics")
+}
+
+func TestStatistics_InvalidDetailShouldErr(t *testing.T) {
+	benchmark, _ := statistics.NewTPSBenchmark(1, 4)
+
+	facade := mock.Facade{}
+	facade.TpsBenchmarkHandler = func() *statistics.TpsBenchmark {
+		return benchmark
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/statistics?detail=verbose", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statisticsRsp := StatisticsResponse{}
+	loadResponse(resp.Body, &statisticsRsp)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(statisticsRsp.Error, errors.ErrInvalidStatisticsDetail.Error()))
+}
+
+func TestPeerInfo_FailsWithoutFacade(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(nil)
+	defer func() {
+		r := recover()
+		assert.NotNil(t, r, "Not providing elrondFacade context should panic")
+	}()
+	req, _ := http.NewRequest("GET", "/node/peerinfo", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+}
+
+func TestPeerInfo_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedPeerInfo := external.PeerInfo{
+		PeerID:          "peer ID",
+		Addresses:       []string{"/ip4/127.0.0.1/tcp/37373"},
+		IntraShardPeers: 3,
+		CrossShardPeers: 5,
+		UnknownPeers:    1,
+	}
+	facade := mock.Facade{
+		GetPeerInfoCalled: func() *external.PeerInfo {
+			return &expectedPeerInfo
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/peerinfo", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	peerInfoRsp := PeerInfoResponse{}
+	loadResponse(resp.Body, &peerInfoRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedPeerInfo, peerInfoRsp.PeerInfo)
+}
+
+func TestBootstrapStatus_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedBootstrapStatus := external.BootstrapStatus{
+		ProbableHighestNonce: 100,
+		CurrentNonce:         98,
+		IsSynced:             false,
+	}
+	facade := mock.Facade{
+		GetBootstrapStatusCalled: func() (*external.BootstrapStatus, error) {
+			return &expectedBootstrapStatus, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/bootstrapstatus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	bootstrapStatusRsp := BootstrapStatusResponse{}
+	loadResponse(resp.Body, &bootstrapStatusRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBootstrapStatus, bootstrapStatusRsp.BootstrapStatus)
+}
+
+func TestBootstrapStatus_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/node/bootstrapstatus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	bootstrapStatusRsp := BootstrapStatusResponse{}
+	loadResponse(resp.Body, &bootstrapStatusRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), bootstrapStatusRsp.Error)
+}
+
+func TestBootstrapStatus_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		GetBootstrapStatusCalled: func() (*external.BootstrapStatus, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/bootstrapstatus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	bootstrapStatusRsp := BootstrapStatusResponse{}
+	loadResponse(resp.Body, &bootstrapStatusRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), bootstrapStatusRsp.Error)
+}
+
+func TestIndexerHealth_HealthyIndexerShouldReturnOk(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		CheckIndexerHealthCalled: func() error {
+			return nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/indexer/health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	indexerHealthRsp := GeneralResponse{}
+	loadResponse(resp.Body, &indexerHealthRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, indexerHealthRsp.Error)
+}
+
+func TestIndexerHealth_UnhealthyIndexerShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("missing index")
+	facade := mock.Facade{
+		CheckIndexerHealthCalled: func() error {
+			return expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/indexer/health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	indexerHealthRsp := GeneralResponse{}
+	loadResponse(resp.Body, &indexerHealthRsp)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, expectedErr.Error(), indexerHealthRsp.Error)
+}
+
+func TestIndexerGaps_ShouldReturnTheMissingNonces(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetIndexerGapsCalled: func(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+			assert.Equal(t, uint64(10), fromNonce)
+			assert.Equal(t, uint64(20), toNonce)
+			assert.Equal(t, uint32(1), shardID)
+			return []uint64{12, 17}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/indexer/gaps?shard=1&from=10&to=20", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	gapsRsp := struct {
+		MissingNonces []uint64 `json:"missingNonces"`
+	}{}
+	loadResponse(resp.Body, &gapsRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []uint64{12, 17}, gapsRsp.MissingNonces)
+}
+
+func TestIndexerGaps_InvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/node/indexer/gaps?from=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	gapsRsp := GeneralResponse{}
+	loadResponse(resp.Body, &gapsRsp)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, gapsRsp.Error, errors.ErrValidation.Error())
+}
+
+func TestIndexerGaps_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		GetIndexerGapsCalled: func(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/indexer/gaps?shard=0&from=20&to=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	gapsRsp := GeneralResponse{}
+	loadResponse(resp.Body, &gapsRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), gapsRsp.Error)
+}
+
+func TestEpochInfo_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedEpochInfo := external.EpochInfo{
+		Epoch:           3,
+		CurrentRound:    350,
+		EpochStartRound: 300,
+		RoundsPerEpoch:  100,
+		RoundsRemaining: 50,
+	}
+	facade := mock.Facade{
+		GetEpochInfoCalled: func() (*external.EpochInfo, error) {
+			return &expectedEpochInfo, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/epoch", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	epochInfoRsp := EpochInfoResponse{}
+	loadResponse(resp.Body, &epochInfoRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedEpochInfo, epochInfoRsp.EpochInfo)
+}
+
+func TestEpochInfo_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/node/epoch", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	epochInfoRsp := EpochInfoResponse{}
+	loadResponse(resp.Body, &epochInfoRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), epochInfoRsp.Error)
+}
+
+func TestEpochInfo_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		GetEpochInfoCalled: func() (*external.EpochInfo, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/epoch", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	epochInfoRsp := EpochInfoResponse{}
+	loadResponse(resp.Body, &epochInfoRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), epochInfoRsp.Error)
+}
+
+func TestForceCleanTxsPools_ReturnsTheNumberOfCleanedTxs(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ForceCleanTxsPoolsCalled: func() (int, error) {
+			return 7, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("POST", "/node/txspools/clean", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	cleanRsp := ForceCleanTxsPoolsResponse{}
+	loadResponse(resp.Body, &cleanRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 7, cleanRsp.NumTxsCleaned)
+}
+
+func TestForceCleanTxsPools_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		ForceCleanTxsPoolsCalled: func() (int, error) {
+			return 0, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("POST", "/node/txspools/clean", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	cleanRsp := ForceCleanTxsPoolsResponse{}
+	loadResponse(resp.Body, &cleanRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), cleanRsp.Error)
+}
+
+func TestReindexBlock_ShouldReindexTheProvidedHash(t *testing.T) {
+	t.Parallel()
+
+	reindexedHash := ""
+	facade := mock.Facade{
+		ReindexBlockCalled: func(hash string) error {
+			reindexedHash = hash
+			return nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("POST", "/node/indexer/reindex/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	reindexRsp := GeneralResponse{}
+	loadResponse(resp.Body, &reindexRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", reindexedHash)
+}
+
+func TestReindexBlock_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		ReindexBlockCalled: func(hash string) error {
+			return expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("POST", "/node/indexer/reindex/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	reindexRsp := GeneralResponse{}
+	loadResponse(resp.Body, &reindexRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), reindexRsp.Error)
+}
+
 func TestStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-details-key"
@@ -228,6 +727,94 @@ func TestStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	assert.False(t, strings.Contains(respStr, p2pKey))
 }
 
+func TestPrometheusMetrics_ShouldRenderTheTextExpositionFormat(t *testing.T) {
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	statusMetricsProvider.SetUInt64Value("erd_nonce", 37)
+	statusMetricsProvider.SetInt64Value("erd_negative-value", -2)
+	statusMetricsProvider.SetStringValue("erd_app_version", "v1.0.\"1\"")
+	statusMetricsProvider.SetStringValue("erd_node_type", "validator")
+	statusMetricsProvider.SetStringValue("erd_current_block_hash", "hash")
+	statusMetricsProvider.SetStringValue("a_p2p_specific_key", "p2p value")
+
+	facade := mock.Facade{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusMetricsProvider
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.HasPrefix(resp.Header().Get("Content-Type"), "text/plain"))
+	assert.True(t, strings.Contains(respStr, "# TYPE erd_nonce gauge\nerd_nonce 37\n"))
+	assert.True(t, strings.Contains(respStr, "# TYPE erd_negative_value gauge\nerd_negative_value -2\n"))
+	assert.True(t, strings.Contains(respStr, `erd_node_info{erd_app_version="v1.0.\"1\"",erd_node_type="validator"} 1`))
+	assert.False(t, strings.Contains(respStr, "p2p"))
+	assert.False(t, strings.Contains(respStr, "erd_current_block_hash"))
+}
+
+func TestPrometheusMetrics_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/node/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statusRsp := StatusResponse{}
+	loadResponse(resp.Body, &statusRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), statusRsp.Error)
+}
+
+func TestStatusMetrics_GzipAcceptedShouldCompressTheResponse(t *testing.T) {
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	key := "test-details-key"
+	value := "test-details-value"
+	statusMetricsProvider.SetStringValue(key, value)
+
+	facade := mock.Facade{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusMetricsProvider
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/status", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "gzip", resp.Header().Get("Content-Encoding"))
+
+	gzipReader, err := gzip.NewReader(resp.Body)
+	assert.Nil(t, err)
+	respBytes, err := ioutil.ReadAll(gzipReader)
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(string(respBytes), value))
+}
+
+func TestStatusMetrics_GzipNotAcceptedShouldNotCompressTheResponse(t *testing.T) {
+	facade := mock.Facade{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusHandler.NewStatusMetrics()
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/status", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statusRsp := StatusResponse{}
+	loadResponse(resp.Body, &statusRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Header().Get("Content-Encoding"))
+}
+
 func TestP2PStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-details-key"
@@ -351,6 +938,10 @@ func startNodeServerWrongFacade() *gin.Engine {
 func startNodeServerWithFacade(facade interface{}) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
+	routesConfig := getRoutesConfig()
+	if routesConfig.GzipResponsesEnabled {
+		ws.Use(middleware.WithGzipResponses())
+	}
 	if facade != nil {
 		ws.Use(func(c *gin.Context) {
 			c.Set("elrondFacade", facade)
@@ -358,21 +949,31 @@ func startNodeServerWithFacade(facade interface{}) *gin.Engine {
 	}
 
 	ginNodeRoutes := ws.Group("/node")
-	nodeRoutes, _ := wrapper.NewRouterWrapper("node", ginNodeRoutes, getRoutesConfig())
+	nodeRoutes, _ := wrapper.NewRouterWrapper("node", ginNodeRoutes, routesConfig)
 	node.Routes(nodeRoutes)
 	return ws
 }
 
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
+		GzipResponsesEnabled: true,
 		APIPackages: map[string]config.APIPackageConfig{
 			"node": {
 				[]config.RouteConfig{
 					{Name: "/status", Open: true},
+					{Name: "/metrics", Open: true},
 					{Name: "/statistics", Open: true},
 					{Name: "/heartbeatstatus", Open: true},
+					{Name: "/heartbeatstatus/summary", Open: true},
+					{Name: "/peerinfo", Open: true},
+					{Name: "/bootstrapstatus", Open: true},
+					{Name: "/indexer/health", Open: true},
+					{Name: "/indexer/gaps", Open: true},
+					{Name: "/epoch", Open: true},
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
+					{Name: "/txspools/clean", Open: true},
+					{Name: "/indexer/reindex/:hash", Open: true},
 				},
 			},
 		},
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
@@ -12,12 +13,28 @@ import (
 // ValidatorsStatisticsApiHandler interface defines methods that can be used from `elrondFacade` context variable
 type ValidatorsStatisticsApiHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	ValidatorsPageApi(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int)
 	IsInterfaceNil() bool
 }
 
+// ValidatorsPageRequest represents the structure on which user input for paging the validators will validate against
+type ValidatorsPageRequest struct {
+	Page   uint32 `form:"page" json:"page"`
+	Size   uint32 `form:"size" json:"size"`
+	SortBy string `form:"sort" json:"sort"`
+}
+
+const (
+	defaultValidatorsPageSize = 25
+	maxValidatorsPageSize     = 100
+	sortByRating              = "rating"
+	sortByPublicKey           = "pubkey"
+)
+
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/statistics", Statistics)
+	router.RegisterHandler(http.MethodGet, "/list", ValidatorsPage)
 }
 
 // Statistics will return the validation statistics for all validators
@@ -36,3 +53,41 @@ func Statistics(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"statistics": valStats})
 }
+
+// ValidatorsPage will return a page of the validators statistics, sorted by rating or by public key. The pages are
+// zero indexed and hold at most maxValidatorsPageSize validators
+func ValidatorsPage(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(ValidatorsStatisticsApiHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var pageRequest = ValidatorsPageRequest{}
+	err := c.ShouldBindQuery(&pageRequest)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	if pageRequest.Size == 0 {
+		pageRequest.Size = defaultValidatorsPageSize
+	}
+	if pageRequest.Size > maxValidatorsPageSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: maximum is %d", errors.ErrInvalidValidatorsPageSize.Error(), maxValidatorsPageSize)})
+		return
+	}
+	if pageRequest.SortBy == "" {
+		pageRequest.SortBy = sortByPublicKey
+	}
+	if pageRequest.SortBy != sortByRating && pageRequest.SortBy != sortByPublicKey {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidValidatorsSortCriterion.Error()})
+		return
+	}
+
+	// computed on 64 bits so that a large page index can not overflow
+	offset := int64(pageRequest.Page) * int64(pageRequest.Size)
+	validators, total := ef.ValidatorsPageApi(int(offset), int(pageRequest.Size), pageRequest.SortBy)
+
+	c.JSON(http.StatusOK, gin.H{"validators": validators, "total": total})
+}
@@ -25,6 +25,12 @@ type ValidatorStatisticsResponse struct {
 	Error  string                                 `json:"error"`
 }
 
+type ValidatorsPageResponse struct {
+	Validators []state.ValidatorApiResponseWithKey `json:"validators"`
+	Total      int                                 `json:"total"`
+	Error      string                              `json:"error"`
+}
+
 func TestValidatorStatistics_ErrorWithWrongFacade(t *testing.T) {
 	t.Parallel()
 
@@ -91,6 +97,98 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Result, mapToReturn)
 }
 
+func TestValidatorsPage_ErrorWithWrongFacade(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/validator/list", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestValidatorsPage_InvalidParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ValidatorsPageHandler: func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, 0
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	urls := []string{
+		"/validator/list?page=-1",
+		"/validator/list?size=abc",
+		"/validator/list?size=101",
+		"/validator/list?sort=tempRating",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := ValidatorsPageResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.NotEmpty(t, response.Error, url)
+	}
+}
+
+func TestValidatorsPage_DefaultParametersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ValidatorsPageHandler: func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+			assert.Equal(t, 0, offset)
+			assert.Equal(t, 25, limit)
+			assert.Equal(t, "pubkey", sortBy)
+
+			return make([]state.ValidatorApiResponseWithKey, 0), 0
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/list", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestValidatorsPage_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	pageToReturn := []state.ValidatorApiResponseWithKey{
+		{PublicKey: "key1", ValidatorApiResponse: state.ValidatorApiResponse{Rating: 70}},
+		{PublicKey: "key0", ValidatorApiResponse: state.ValidatorApiResponse{Rating: 50}},
+	}
+	facade := mock.Facade{
+		ValidatorsPageHandler: func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+			assert.Equal(t, 20, offset)
+			assert.Equal(t, 10, limit)
+			assert.Equal(t, "rating", sortBy)
+
+			return pageToReturn, 22
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/list?page=2&size=10&sort=rating", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ValidatorsPageResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, pageToReturn, response.Validators)
+	assert.Equal(t, 22, response.Total)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -135,6 +233,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/list", Open: true},
 				},
 			},
 		},
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -12,9 +13,12 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// scQueryTimeout is the maximum duration a request waits for the smart contract query result
+const scQueryTimeout = 30 * time.Second
+
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
-	ExecuteSCQuery(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryAsync(*process.SCQuery) <-chan process.SCQueryResult
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -94,12 +98,27 @@ func doExecuteQuery(context *gin.Context) (*vmcommon.VMOutput, error) {
 		return nil, err
 	}
 
-	vmOutput, err := facade.ExecuteSCQuery(command)
-	if err != nil {
-		return nil, err
-	}
+	return waitSCQueryResult(context, facade.ExecuteSCQueryAsync(command), scQueryTimeout)
+}
 
-	return vmOutput, nil
+// waitSCQueryResult releases the request as soon as the query timed out or the client disconnected, the query result
+// being dropped in these cases
+func waitSCQueryResult(
+	context *gin.Context,
+	chResult <-chan process.SCQueryResult,
+	timeout time.Duration,
+) (*vmcommon.VMOutput, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-chResult:
+		return result.VMOutput, result.Err
+	case <-timer.C:
+		return nil, errors.ErrSCQueryTimeout
+	case <-context.Request.Context().Done():
+		return nil, context.Request.Context().Err()
+	}
 }
 
 func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
@@ -2,6 +2,7 @@ package vmValues
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
@@ -262,6 +264,91 @@ func TestAllRoutes_WhenBadFacadeShouldErr(t *testing.T) {
 	requireErrorOnAllRoutes(t, &facade, request, apiErrors.ErrInvalidAppContext)
 }
 
+func TestQuery_ShouldUseTheAsyncQuery(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Fail(t, "the synchronous query should not have been called")
+			return nil, nil
+		},
+		ExecuteSCQueryAsyncHandler: func(query *process.SCQuery) <-chan process.SCQueryResult {
+			chResult := make(chan process.SCQueryResult, 1)
+			go func() {
+				chResult <- process.SCQueryResult{
+					VMOutput: &vmcommon.VMOutput{ReturnData: [][]byte{[]byte(query.FuncName)}},
+				}
+			}()
+			return chResult
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/string", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, "function", response.Data)
+}
+
+func TestQuery_ClientDisconnectedShouldReleaseTheRequest(t *testing.T) {
+	t.Parallel()
+
+	chQueryStarted := make(chan struct{})
+	facade := mock.Facade{
+		ExecuteSCQueryAsyncHandler: func(query *process.SCQuery) <-chan process.SCQueryResult {
+			close(chQueryStarted)
+			return make(chan process.SCQueryResult)
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+	}
+	requestAsBytes, _ := json.Marshal(request)
+	ctx, cancel := context.WithCancel(context.Background())
+	httpRequest, _ := http.NewRequestWithContext(ctx, "POST", "/vm-values/query", bytes.NewBuffer(requestAsBytes))
+	responseRecorder := httptest.NewRecorder()
+
+	chServed := make(chan struct{})
+	go func() {
+		startNodeServer(&facade).ServeHTTP(responseRecorder, httpRequest)
+		close(chServed)
+	}()
+
+	<-chQueryStarted
+	cancel()
+
+	select {
+	case <-chServed:
+	case <-time.After(time.Second):
+		require.Fail(t, "the request should have been released after the client disconnected")
+	}
+
+	response := simpleResponse{}
+	parseResponse(responseRecorder.Body, &response)
+	require.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	require.Contains(t, response.Error, context.Canceled.Error())
+}
+
+func TestWaitSCQueryResult_TimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ginContext, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ginContext.Request, _ = http.NewRequest("POST", "/vm-values/query", nil)
+
+	vmOutput, err := waitSCQueryResult(ginContext, make(chan process.SCQueryResult), time.Millisecond*10)
+
+	require.Nil(t, vmOutput)
+	require.Equal(t, apiErrors.ErrSCQueryTimeout, err)
+}
+
 func doPost(facade interface{}, url string, request interface{}, response interface{}) int {
 	// Serialize if not already
 	requestAsBytes, ok := request.([]byte)
@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // HeaderSigVerifierHandler is the interface needed to check that a header's signature is correct
@@ -29,3 +30,14 @@ type P2PAntifloodHandler interface {
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
 	IsInterfaceNil() bool
 }
+
+// TxLogsProcessorHandler is the interface needed to save the logs generated by the VM and to keep them in cache until
+// the block that generated them is indexed
+type TxLogsProcessorHandler interface {
+	GetLog(txHash []byte) (data.LogHandler, error)
+	SaveLog(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error
+	GetLogFromCache(txHash []byte) (data.LogHandler, bool)
+	EnableLogToBeSavedInCache()
+	Clean()
+	IsInterfaceNil() bool
+}
@@ -1,7 +1,9 @@
 package factory
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -41,6 +43,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
@@ -87,6 +90,7 @@ type EpochStartNotifier interface {
 // Process struct holds the process components
 type Process struct {
 	InterceptorsContainer    process.InterceptorsContainer
+	InterceptorsFactory      process.InterceptorsContainerFactory
 	ResolversFinder          dataRetriever.ResolversFinder
 	Rounder                  consensus.Rounder
 	EpochStartTrigger        epochStart.TriggerHandler
@@ -103,6 +107,8 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	SyncStateHandler         process.SyncStateHandler
+	TxsPoolsCleaner          process.TxsPoolsCleaner
 }
 
 type processComponentsFactoryArgs struct {
@@ -288,6 +294,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		ValidatorStatistics:               validatorStatisticsProcessor,
 		MaxRating:                         args.maxRating,
 		PubKeyConverter:                   args.validatorPubkeyConverter,
+		ChanceComputer:                    args.rater,
 	}
 
 	validatorsProvider, err := peer.NewValidatorsProvider(argVSP)
@@ -371,17 +378,31 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 
 	mbsPoolsCleaner.StartCleaning()
 
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(
-		args.state.AddressPubkeyConverter,
-		args.data.Datapool,
-		args.rounder,
-		args.shardCoordinator,
-	)
+	whitelistedTxHashes, err := decodeHexHashes(args.mainConfig.PoolsCleanersConfig.WhitelistedTxHashes)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w when decoding the pools cleaner whitelisted tx hashes", err)
 	}
 
-	txsPoolsCleaner.StartCleaning()
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleanerWithArgs(poolsCleaner.ArgTxsPoolsCleaner{
+		AddressPubkeyConverter:   args.state.AddressPubkeyConverter,
+		BlockTransactionsPool:    args.data.Datapool.Transactions(),
+		RewardTransactionsPool:   args.data.Datapool.RewardTransactions(),
+		UnsignedTransactionsPool: args.data.Datapool.UnsignedTransactions(),
+		Rounder:                  args.rounder,
+		ShardCoordinator:         args.shardCoordinator,
+		MaxTraceLogsPerSecond:    args.mainConfig.PoolsCleanersConfig.MaxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed: poolsCleaner.RoundsToKeepUnprocessed{
+			BlockTxs:    args.mainConfig.PoolsCleanersConfig.MaxRoundsToKeepUnprocessedTxs,
+			RewardTxs:   args.mainConfig.PoolsCleanersConfig.MaxRoundsToKeepUnprocessedRewardTxs,
+			UnsignedTxs: args.mainConfig.PoolsCleanersConfig.MaxRoundsToKeepUnprocessedUnsignedTxs,
+		},
+		AutoStartCleaning:   true,
+		AppStatusHandler:    args.coreData.StatusHandler,
+		WhitelistedTxHashes: whitelistedTxHashes,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	//TODO: Will be useful/used when the implementation of the cacher notifier about transactions which should be
 	// protected for eviction will be done
@@ -392,6 +413,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		}
 	}
 
+	syncStateHandler := processSync.NewSyncState()
 	interceptorContainerFactory, blackListHandler, err := newInterceptorContainerFactory(
 		args.shardCoordinator,
 		args.nodesCoordinator,
@@ -408,6 +430,9 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		epochStartTrigger,
 		args.whiteListHandler,
 		args.whiteListerVerifiedTxs,
+		args.mainConfig.Antiflood.TrieNodes,
+		args.mainConfig.GeneralSettings,
+		syncStateHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -471,6 +496,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 
 	return &Process{
 		InterceptorsContainer:    interceptorsContainer,
+		InterceptorsFactory:      interceptorContainerFactory,
 		ResolversFinder:          resolversFinder,
 		Rounder:                  args.rounder,
 		ForkDetector:             forkDetector,
@@ -487,6 +513,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		SyncStateHandler:         syncStateHandler,
+		TxsPoolsCleaner:          txsPoolsCleaner,
 	}, nil
 }
 
@@ -635,6 +663,9 @@ func newInterceptorContainerFactory(
 	epochStartTrigger process.EpochStartTriggerHandler,
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	trieNodesAntiflood config.TrieNodesAntifloodConfig,
+	generalSettings config.GeneralSettingsConfig,
+	syncStateHandler process.SyncStateHandler,
 ) (process.InterceptorsContainerFactory, process.BlackListHandler, error) {
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return newShardInterceptorContainerFactory(
@@ -653,6 +684,9 @@ func newInterceptorContainerFactory(
 			epochStartTrigger,
 			whiteListHandler,
 			whiteListerVerifiedTxs,
+			trieNodesAntiflood,
+			generalSettings,
+			syncStateHandler,
 		)
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -672,6 +706,8 @@ func newInterceptorContainerFactory(
 			epochStartTrigger,
 			whiteListHandler,
 			whiteListerVerifiedTxs,
+			trieNodesAntiflood,
+			syncStateHandler,
 		)
 	}
 
@@ -730,7 +766,15 @@ func newShardInterceptorContainerFactory(
 	epochStartTrigger process.EpochStartTriggerHandler,
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	trieNodesAntiflood config.TrieNodesAntifloodConfig,
+	generalSettings config.GeneralSettingsConfig,
+	syncStateHandler process.SyncStateHandler,
 ) (process.InterceptorsContainerFactory, process.BlackListHandler, error) {
+	interceptorMetricsSink, err := interceptors.NewStatusMetricsSink(dataCore.StatusHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	shardInterceptorsContainerFactoryArgs := interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
 		Accounts:                state.AccountsAdapter,
@@ -749,6 +793,8 @@ func newShardInterceptorContainerFactory(
 		DataPool:                data.Datapool,
 		AddressPubkeyConverter:  state.AddressPubkeyConverter,
 		MaxTxNonceDeltaAllowed:  core.MaxTxNonceDeltaAllowed,
+		IntraShardTxNonceDelta:  generalSettings.IntraShardTxNonceDelta,
+		CrossShardTxNonceDelta:  generalSettings.CrossShardTxNonceDelta,
 		TxFeeHandler:            economics,
 		BlackList:               headerBlackList,
 		HeaderSigVerifier:       headerSigVerifier,
@@ -759,7 +805,11 @@ func newShardInterceptorContainerFactory(
 		WhiteListHandler:        whiteListHandler,
 		WhiteListerVerifiedTxs:  whiteListerVerifiedTxs,
 		AntifloodHandler:        network.InputAntifloodHandler,
+		PeerBlackListHandler:    network.PeerBlackListHandler,
 		NonceConverter:          dataCore.Uint64ByteSliceConverter,
+		TrieNodesAntiflood:      trieNodesAntiflood,
+		SyncStateHandler:        syncStateHandler,
+		InterceptorMetricsSink:  interceptorMetricsSink,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -785,7 +835,14 @@ func newMetaInterceptorContainerFactory(
 	epochStartTrigger process.EpochStartTriggerHandler,
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	trieNodesAntiflood config.TrieNodesAntifloodConfig,
+	syncStateHandler process.SyncStateHandler,
 ) (process.InterceptorsContainerFactory, process.BlackListHandler, error) {
+	interceptorMetricsSink, err := interceptors.NewStatusMetricsSink(dataCore.StatusHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	metaInterceptorsContainerFactoryArgs := interceptorscontainer.MetaInterceptorsContainerFactoryArgs{
 		ShardCoordinator:        shardCoordinator,
@@ -814,7 +871,11 @@ func newMetaInterceptorContainerFactory(
 		WhiteListHandler:        whiteListHandler,
 		WhiteListerVerifiedTxs:  whiteListerVerifiedTxs,
 		AntifloodHandler:        network.InputAntifloodHandler,
+		PeerBlackListHandler:    network.PeerBlackListHandler,
 		NonceConverter:          dataCore.Uint64ByteSliceConverter,
+		TrieNodesAntiflood:      trieNodesAntiflood,
+		SyncStateHandler:        syncStateHandler,
+		InterceptorMetricsSink:  interceptorMetricsSink,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1008,7 +1069,7 @@ func newBlockProcessor(
 	headerValidator process.HeaderConstructionValidator,
 	blockTracker process.BlockTracker,
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
-	txLogsProcessor process.TransactionLogProcessor,
+	txLogsProcessor TxLogsProcessorHandler,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1092,7 +1153,7 @@ func newShardBlockProcessor(
 	blockTracker process.BlockTracker,
 	minSizeInBytes uint32,
 	maxSizeInBytes uint32,
-	txLogsProcessor process.TransactionLogProcessor,
+	txLogsProcessor TxLogsProcessorHandler,
 	version string,
 ) (process.BlockProcessor, error) {
 	argsParser := vmcommon.NewAtArgumentParser()
@@ -1320,6 +1381,7 @@ func newShardBlockProcessor(
 		BlockChain:             data.Blkc,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockSizeThrottler:     blockSizeThrottler,
+		TxLogsProcessor:        txLogsProcessor,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -1362,7 +1424,7 @@ func newMetaBlockProcessor(
 	maxSizeInBytes uint32,
 	ratingsData process.RatingsInfoHandler,
 	nodesSetup sharding.GenesisNodesSetupHandler,
-	txLogsProcessor process.TransactionLogProcessor,
+	txLogsProcessor TxLogsProcessorHandler,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	version string,
 ) (process.BlockProcessor, error) {
@@ -1646,6 +1708,7 @@ func newMetaBlockProcessor(
 		BlockChain:             data.Blkc,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockSizeThrottler:     blockSizeThrottler,
+		TxLogsProcessor:        txLogsProcessor,
 	}
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
@@ -1778,6 +1841,20 @@ func createNetworkShardingCollector(
 	return psm, nil
 }
 
+func decodeHexHashes(hexHashes []string) ([][]byte, error) {
+	hashes := make([][]byte, 0, len(hexHashes))
+	for _, hexHash := range hexHashes {
+		hash, err := hex.DecodeString(hexHash)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
 func createCache(cacheConfig config.CacheConfig) (storage.Cacher, error) {
 	return storageUnit.NewCache(storageUnit.CacheType(cacheConfig.Type), cacheConfig.Capacity, cacheConfig.Shards, cacheConfig.SizeInBytes)
 }
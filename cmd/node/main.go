@@ -38,6 +38,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -674,6 +675,24 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	var archivePathManager *pathmanager.PathManager
+	archiveBasePath := generalConfig.StoragePruning.ArchiveBasePath
+	if len(archiveBasePath) > 0 {
+		pathTemplateForArchivedPruningStorer := filepath.Join(
+			archiveBasePath,
+			defaultDBPath,
+			genesisNodesConfig.ChainID,
+			fmt.Sprintf("%s_%s", defaultEpochString, core.PathEpochPlaceholder),
+			fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+			core.PathIdentifierPlaceholder)
+
+		archivePathManager, err = pathmanager.NewPathManager(pathTemplateForArchivedPruningStorer, pathTemplateForStaticStorer)
+		if err != nil {
+			return err
+		}
+		log.Info("old epochs databases will be opened from the archive path", "path", archiveBasePath)
+	}
+
 	genesisShardCoordinator, nodeType, err := createShardCoordinator(genesisNodesConfig, cryptoParams.PublicKey, preferencesConfig.Preferences, log)
 	if err != nil {
 		return err
@@ -917,6 +936,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		ShardCoordinator:   shardCoordinator,
 		Core:               coreComponents,
 		PathManager:        pathManager,
+		ArchivePathManager: archivePathManager,
 		EpochStartNotifier: epochStartNotifier,
 		CurrentEpoch:       storerEpoch,
 	}
@@ -1073,6 +1093,9 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 			epochStartNotifier,
 			addressPubkeyConverter,
 			validatorPubkeyConverter,
+			dataComponents.Store,
+			economicsData,
+			coreComponents.StatusHandler,
 			shardCoordinator.SelfId(),
 		)
 		if err != nil {
@@ -1232,6 +1255,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		generalConfig,
 		stateComponents.AccountsAdapter,
 		stateComponents.PeerAccounts,
+		triesComponents.TriesContainer.Get([]byte(trieFactory.UserAccountTrie)),
 		stateComponents.AddressPubkeyConverter,
 		dataComponents.Store,
 		dataComponents.Blkc,
@@ -1313,8 +1337,18 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		log.Info("terminating at internal stop signal", "reason", sig.Reason)
 	}
 
-	log.Debug("closing all store units....")
-	err = dataComponents.Store.CloseAll()
+	if dbIndexer != nil {
+		log.Debug("closing the indexer....")
+		err = dbIndexer.Close()
+		log.LogIfError(err)
+	}
+
+	log.Debug("closing the interceptors....")
+	err = processComponents.InterceptorsFactory.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing the data pools and all store units....")
+	err = dataComponents.Close()
 	log.LogIfError(err)
 
 	dataTries := triesComponents.TriesContainer.GetAll()
@@ -1802,20 +1836,75 @@ func createElasticIndexer(
 	startNotifier notifier.EpochStartNotifier,
 	addressPubkeyConverter core.PubkeyConverter,
 	validatorPubkeyConverter core.PubkeyConverter,
+	store dataRetriever.StorageService,
+	feeHandler process.FeeHandler,
+	appStatusHandler core.AppStatusHandler,
 	shardId uint32,
 ) (indexer.Indexer, error) {
+	options := &indexer.Options{
+		TxIndexingEnabled:       ctx.GlobalBoolT(enableTxIndexing.Name),
+		EnabledMiniBlockTypes:   elasticSearchConfig.EnabledMiniBlockTypes,
+		IndexTemplatesPath:      elasticSearchConfig.IndexTemplatesPath,
+		MaxInFlightBulkRequests: elasticSearchConfig.MaxInFlightBulkRequests,
+		BulkFlushIntervalInSec:  elasticSearchConfig.BulkFlushIntervalInSec,
+		BulkLogSamplingRate:     elasticSearchConfig.BulkLogSamplingRate,
+		Denomination:            elasticSearchConfig.Denomination,
+		IndexedShards:           elasticSearchConfig.IndexedShards,
+		MetachainIndexingOff:    elasticSearchConfig.MetachainIndexingOff,
+		IndicesSettings:         make(map[string]indexer.IndexSettings),
+
+		IndexCreationMaxAttempts:        elasticSearchConfig.IndexCreationMaxAttempts,
+		IndexCreationRetryIntervalInSec: elasticSearchConfig.IndexCreationRetryIntervalInSec,
+
+		BulkRequestMaxAttempts:           elasticSearchConfig.BulkRequestMaxAttempts,
+		BulkRequestRetryDelayInMillisecs: elasticSearchConfig.BulkRequestRetryDelayInMillisecs,
+		BulkRequestMaxSizeInBytes:        elasticSearchConfig.BulkRequestMaxSizeInBytes,
+
+		ThrottlingMaxRetries:            elasticSearchConfig.ThrottlingMaxRetries,
+		ThrottlingRetryDelayInMillisecs: elasticSearchConfig.ThrottlingRetryDelayInMillisecs,
+
+		IndexingQueueSize: elasticSearchConfig.IndexingQueueSize,
+		IndexingWorkers:   elasticSearchConfig.IndexingWorkers,
+
+		MaxIdleConnsPerHost: elasticSearchConfig.MaxIdleConnsPerHost,
+		MaxConnsPerHost:     elasticSearchConfig.MaxConnsPerHost,
+		UseGzip:             elasticSearchConfig.UseGzip,
+		RequestTimeoutInSec: elasticSearchConfig.RequestTimeoutInSec,
+
+		ResolveRoundConsensusGroup: elasticSearchConfig.ResolveRoundConsensusGroup,
+
+		RouteDocumentsByShard: elasticSearchConfig.RouteDocumentsByShard,
+
+		TxDataIndexingOff: elasticSearchConfig.TxDataIndexingOff,
+		MaxTxDataBytes:    elasticSearchConfig.MaxTxDataBytes,
+
+		FieldNaming: elasticSearchConfig.FieldNaming,
+	}
+	for _, indexSettings := range elasticSearchConfig.IndicesSettings {
+		options.IndicesSettings[indexSettings.Index] = indexer.IndexSettings{
+			NumberOfShards:   indexSettings.NumberOfShards,
+			NumberOfReplicas: indexSettings.NumberOfReplicas,
+		}
+	}
 	arguments := indexer.ElasticIndexerArgs{
 		Url:                      url,
+		FailoverUrls:             elasticSearchConfig.FailoverURLs,
 		UserName:                 elasticSearchConfig.Username,
 		Password:                 elasticSearchConfig.Password,
+		MetricsUrl:               elasticSearchConfig.MetricsURL,
+		MetricsUserName:          elasticSearchConfig.MetricsUsername,
+		MetricsPassword:          elasticSearchConfig.MetricsPassword,
 		Marshalizer:              marshalizer,
 		Hasher:                   hasher,
-		Options:                  &indexer.Options{TxIndexingEnabled: ctx.GlobalBoolT(enableTxIndexing.Name)},
+		Options:                  options,
 		NodesCoordinator:         nodesCoordinator,
 		EpochStartNotifier:       startNotifier,
 		AddressPubkeyConverter:   addressPubkeyConverter,
 		ValidatorPubkeyConverter: validatorPubkeyConverter,
 		ShardId:                  shardId,
+		Storage:                  store,
+		FeeHandler:               feeHandler,
+		AppStatusHandler:         appStatusHandler,
 	}
 
 	var err error
@@ -2044,6 +2133,8 @@ func createNode(
 		node.WithHardforkTrigger(hardForkTrigger),
 		node.WithWhiteListHandler(whiteListRequest),
 		node.WithWhiteListHandlerVerified(whiteListerVerifiedTxs),
+		node.WithSyncStateHandler(process.SyncStateHandler),
+		node.WithTxsPoolsCleaner(process.TxsPoolsCleaner),
 		node.WithSignatureSize(config.ValidatorPubkeyConverter.SignatureLength),
 		node.WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		node.WithNodeStopChannel(chanStopNodeProcess),
@@ -2171,6 +2262,7 @@ func createApiResolver(
 	config *config.Config,
 	accnts state.AccountsAdapter,
 	validatorAccounts state.AccountsAdapter,
+	accountsTrie data.Trie,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
 	blockChain data.ChainHandler,
@@ -2240,7 +2332,7 @@ func createApiResolver(
 		return nil, err
 	}
 
-	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics)
+	scQueryService, err := createSCQueryService(config.SCQueryCacheConfig, config.SCQueryRateLimiter, vmContainer, economics, blockChain)
 	if err != nil {
 		return nil, err
 	}
@@ -2261,7 +2353,64 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler)
+	argsAccountsBulkGetter := external.ArgsAccountsBulkGetter{
+		AccountsTrie:   accountsTrie,
+		BlockChain:     blockChain,
+		Hasher:         hasher,
+		Marshalizer:    marshalizer,
+		AccountFactory: stateFactory.NewAccountCreator(),
+	}
+	accountsBulkGetter, err := external.NewAccountsBulkGetter(argsAccountsBulkGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, accountsBulkGetter)
+}
+
+func createSCQueryService(
+	cacheConfig config.SCQueryCacheConfig,
+	rateLimiterConfig config.SCQueryRateLimiterConfig,
+	vmContainer process.VirtualMachinesContainer,
+	economics *economics.EconomicsData,
+	blockChain data.ChainHandler,
+) (external.SCQueryService, error) {
+	var scQueryService external.SCQueryService
+	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics)
+	if err != nil {
+		return nil, err
+	}
+	if rateLimiterConfig.Enabled {
+		rateLimiter, errLimiter := throttler.NewTokenBucketRateLimiter(rateLimiterConfig.MaxQueriesPerSec, rateLimiterConfig.Burst)
+		if errLimiter != nil {
+			return nil, errLimiter
+		}
+
+		argsRateLimitedSCQueryService := smartContract.ArgsRateLimitedSCQueryService{
+			QueryService: scQueryService,
+			RateLimiter:  rateLimiter,
+		}
+		scQueryService, err = smartContract.NewRateLimitedSCQueryService(argsRateLimitedSCQueryService)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !cacheConfig.Enabled {
+		return scQueryService, nil
+	}
+
+	cacher, err := lrucache.NewCache(int(cacheConfig.Capacity))
+	if err != nil {
+		return nil, err
+	}
+
+	argsCachedSCQueryService := smartContract.ArgsCachedSCQueryService{
+		QueryService: scQueryService,
+		BlockChain:   blockChain,
+		Cacher:       cacher,
+	}
+
+	return smartContract.NewCachedSCQueryService(argsCachedSCQueryService)
 }
 
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
@@ -96,6 +96,9 @@ func InitMetrics(
 	appStatusHandler.SetUInt64Value(core.MetricNumMetachainNodes, uint64(nodesConfig.MetaChainMinNodes))
 	appStatusHandler.SetUInt64Value(core.MetricStartTime, uint64(nodesConfig.StartTime))
 	appStatusHandler.SetUInt64Value(core.MetricRoundDuration, nodesConfig.RoundDuration)
+	appStatusHandler.SetUInt64Value(core.MetricIndexerTxsIndexedTotal, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricIndexerBulkErrorsTotal, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricIndexerBulkDurationMs, initUint)
 
 	var consensusGroupSize uint32
 	switch {
@@ -16,6 +16,25 @@ type HeadersPoolConfig struct {
 	NumElementsToRemoveOnEviction int
 }
 
+// PoolsCleanersConfig will map the pools cleaners configuration
+type PoolsCleanersConfig struct {
+	MaxTraceLogsPerSecond uint32
+
+	MaxRoundsToKeepUnprocessedTxs         int64
+	MaxRoundsToKeepUnprocessedRewardTxs   int64
+	MaxRoundsToKeepUnprocessedUnsignedTxs int64
+
+	WhitelistedTxHashes []string
+}
+
+// TxPoolSnapshotConfig will map the transactions pool snapshot configuration
+type TxPoolSnapshotConfig struct {
+	Enabled           bool
+	IntervalInSeconds uint32
+	MaxAgeInSeconds   uint32
+	MaxNumTxs         uint32
+}
+
 // DBConfig will map the json db configuration
 type DBConfig struct {
 	FilePath          string
@@ -99,6 +118,7 @@ type Config struct {
 	ShardHdrNonceHashStorage   StorageConfig
 	MetaHdrNonceHashStorage    StorageConfig
 	StatusMetricsStorage       StorageConfig
+	TxPoolSnapshotStorage      StorageConfig
 
 	BootstrapStorage StorageConfig
 	MetaBlockStorage StorageConfig
@@ -144,8 +164,12 @@ type Config struct {
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
+	PoolsCleanersConfig     PoolsCleanersConfig
+	TxPoolSnapshotConfig    TxPoolSnapshotConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
 	VirtualMachineConfig    VirtualMachineConfig
+	SCQueryCacheConfig      SCQueryCacheConfig
+	SCQueryRateLimiter      SCQueryRateLimiterConfig
 
 	Hardfork HardforkConfig
 	Debug    DebugConfig
@@ -157,6 +181,7 @@ type StoragePruningConfig struct {
 	FullArchive         bool
 	NumEpochsToKeep     uint64
 	NumActivePersisters uint64
+	ArchiveBasePath     string
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -172,6 +197,7 @@ type HeartbeatConfig struct {
 	DurationToConsiderUnresponsiveInSec int
 	HeartbeatRefreshIntervalInSec       uint32
 	HideInactiveValidatorIntervalInSec  uint32
+	PruneInactivePeerIntervalInSec      uint32
 	HeartbeatStorage                    StorageConfig
 }
 
@@ -185,6 +211,8 @@ type GeneralSettingsConfig struct {
 	StatusPollingIntervalSec int
 	MaxComputableRounds      uint64
 	StartInEpochEnabled      bool
+	IntraShardTxNonceDelta   int
+	CrossShardTxNonceDelta   int
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -222,6 +250,7 @@ type BlackListConfig struct {
 	ThresholdSizePerInterval        uint64
 	NumFloodingRounds               uint32
 	PeerBanDurationInSeconds        uint32
+	NumOverQuotaIntervals           uint32
 }
 
 // TopicMaxMessagesConfig will hold the maximum number of messages/sec per topic value
@@ -236,6 +265,16 @@ type TopicAntifloodConfig struct {
 	MaxMessages              []TopicMaxMessagesConfig
 }
 
+// TrieNodesAntifloodConfig will hold the maximum number of trie nodes messages/sec and bytes/sec accepted from a peer,
+// while the node is syncing and while it is synchronized
+type TrieNodesAntifloodConfig struct {
+	Enabled                      bool
+	MaxMessagesPerSecDuringSync  uint32
+	MaxMessagesPerSec            uint32
+	MaxTotalSizePerSecDuringSync uint64
+	MaxTotalSizePerSec           uint64
+}
+
 // TxAccumulatorConfig will hold the tx accumulator config values
 type TxAccumulatorConfig struct {
 	MaxAllowedTimeInMilliseconds   uint32
@@ -246,6 +285,7 @@ type TxAccumulatorConfig struct {
 type AntifloodConfig struct {
 	Enabled                   bool
 	NumConcurrentResolverJobs int32
+	QuotaGranularity          string
 	OutOfSpecs                FloodPreventerConfig
 	FastReacting              FloodPreventerConfig
 	SlowReacting              FloodPreventerConfig
@@ -254,14 +294,16 @@ type AntifloodConfig struct {
 	WebServer                 WebServerAntifloodConfig
 	Topic                     TopicAntifloodConfig
 	TxAccumulator             TxAccumulatorConfig
+	TrieNodes                 TrieNodesAntifloodConfig
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
 type FloodPreventerConfig struct {
-	IntervalInSeconds uint32
-	ReservedPercent   float32
-	PeerMaxInput      AntifloodLimitsConfig
-	BlackList         BlackListConfig
+	IntervalInSeconds         uint32
+	ResetJitterInMilliseconds uint32
+	ReservedPercent           float32
+	PeerMaxInput              AntifloodLimitsConfig
+	BlackList                 BlackListConfig
 }
 
 // AntifloodLimitsConfig will hold the maximum antiflood limits in both number of messages and total
@@ -291,6 +333,20 @@ type VirtualMachineOutOfProcessConfig struct {
 	MaxLoopTime         int
 }
 
+// SCQueryCacheConfig holds the configuration for the cache of the smart contract query results
+type SCQueryCacheConfig struct {
+	Enabled  bool
+	Capacity uint32
+}
+
+// SCQueryRateLimiterConfig holds the configuration for the node wide rate limit of the smart contract executions
+// triggered by queries
+type SCQueryRateLimiterConfig struct {
+	Enabled          bool
+	MaxQueriesPerSec uint32
+	Burst            uint32
+}
+
 // HardforkConfig holds the configuration for the hardfork trigger
 type HardforkConfig struct {
 	EnableTrigger             bool
@@ -337,7 +393,8 @@ type AntifloodDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	APIPackages map[string]APIPackageConfig
+	GzipResponsesEnabled bool
+	APIPackages          map[string]APIPackageConfig
 }
 
 // APIPackageConfig holds the configuration for the routes of each package
@@ -11,4 +11,54 @@ type ElasticSearchConfig struct {
 	URL      string
 	Username string
 	Password string
+
+	FailoverURLs []string
+
+	MetricsURL      string
+	MetricsUsername string
+	MetricsPassword string
+
+	EnabledMiniBlockTypes   []string
+	IndexTemplatesPath      string
+	MaxInFlightBulkRequests uint32
+	BulkFlushIntervalInSec  uint32
+	BulkLogSamplingRate     uint32
+	Denomination            int
+	IndexedShards           []uint32
+	MetachainIndexingOff    bool
+	IndicesSettings         []ElasticSearchIndexSettingsConfig
+
+	IndexCreationMaxAttempts        uint32
+	IndexCreationRetryIntervalInSec uint32
+
+	BulkRequestMaxAttempts           uint32
+	BulkRequestRetryDelayInMillisecs uint32
+	BulkRequestMaxSizeInBytes        uint32
+
+	ThrottlingMaxRetries            uint32
+	ThrottlingRetryDelayInMillisecs uint32
+
+	IndexingQueueSize uint32
+	IndexingWorkers   uint32
+
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	UseGzip             bool
+	RequestTimeoutInSec uint32
+
+	ResolveRoundConsensusGroup bool
+
+	RouteDocumentsByShard bool
+
+	TxDataIndexingOff bool
+	MaxTxDataBytes    uint32
+
+	FieldNaming string
+}
+
+// ElasticSearchIndexSettingsConfig will hold the number of shards and replicas used when creating an index
+type ElasticSearchIndexSettingsConfig struct {
+	Index            string
+	NumberOfShards   uint32
+	NumberOfReplicas *uint32
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -28,6 +29,10 @@ func (im *IndexerMock) SaveValidatorsRating(_ string, _ []indexer.ValidatorRatin
 
 }
 
+// SaveValidatorsRatingHistory -
+func (im *IndexerMock) SaveValidatorsRatingHistory(_ uint32, _ map[string]*state.ValidatorApiResponse) {
+}
+
 // UpdateTPS -
 func (im *IndexerMock) UpdateTPS(_ statistics.TPSBenchmark) {
 	panic("implement me")
@@ -38,16 +43,63 @@ func (im *IndexerMock) SaveRoundInfo(_ indexer.RoundInfo) {
 	panic("implement me")
 }
 
+// SaveLogs -
+func (im *IndexerMock) SaveLogs(_ map[string]data.LogHandler) {
+	panic("implement me")
+}
+
+// RevertIndexedBlock -
+func (im *IndexerMock) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) {
+	panic("implement me")
+}
+
+// SaveRoundsInfo -
+func (im *IndexerMock) SaveRoundsInfo(_ []indexer.RoundInfo) {
+	panic("implement me")
+}
+
+// ReindexBlock -
+func (im *IndexerMock) ReindexBlock(_ []byte) error {
+	panic("implement me")
+}
+
 // SaveValidatorsPubKeys -
 func (im *IndexerMock) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 	panic("implement me")
 }
 
+// SaveAccountsHistory -
+func (im *IndexerMock) SaveAccountsHistory(_ uint64, _ []indexer.AccountBalanceChange) {
+}
+
+// SaveEpochStartEconomics -
+func (im *IndexerMock) SaveEpochStartEconomics(_ uint32, _ indexer.EpochEconomics) {
+}
+
+// SaveEpochStartInfo -
+func (im *IndexerMock) SaveEpochStartInfo(_ *block.MetaBlock) {
+}
+
+// VerifyContiguity -
+func (im *IndexerMock) VerifyContiguity(_ uint64, _ uint64, _ uint32) ([]uint64, error) {
+	return nil, nil
+}
+
+// CheckHealth -
+func (im *IndexerMock) CheckHealth() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (im *IndexerMock) IsInterfaceNil() bool {
 	return im == nil
 }
 
+// Close -
+func (im *IndexerMock) Close() error {
+	return nil
+}
+
 // IsNilIndexer -
 func (im *IndexerMock) IsNilIndexer() bool {
 	return false
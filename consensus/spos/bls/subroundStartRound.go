@@ -222,6 +222,8 @@ func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 		BlockWasProposed: false,
 		ShardId:          shardId,
 		Timestamp:        time.Duration(sr.RoundTimeStamp.Unix()),
+		Epoch:            epoch,
+		Randomness:       currentHeader.GetRandSeed(),
 	}
 
 	go sr.indexer.SaveRoundInfo(roundInfo)
@@ -384,6 +384,52 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricTxPoolTrackedCount is the metric for monitoring the number of transactions tracked by the txs pools cleaner
+const MetricTxPoolTrackedCount = "erd_tx_pool_tracked_count"
+
+// MetricTxPoolTrackedBlockTxs is the metric for monitoring the number of block transactions tracked by the txs pools
+// cleaner
+const MetricTxPoolTrackedBlockTxs = "erd_tx_pool_tracked_block_txs"
+
+// MetricTxPoolTrackedRewardTxs is the metric for monitoring the number of reward transactions tracked by the txs pools
+// cleaner
+const MetricTxPoolTrackedRewardTxs = "erd_tx_pool_tracked_reward_txs"
+
+// MetricTxPoolTrackedUnsignedTxs is the metric for monitoring the number of unsigned transactions tracked by the txs
+// pools cleaner
+const MetricTxPoolTrackedUnsignedTxs = "erd_tx_pool_tracked_unsigned_txs"
+
+// MetricTxPoolWrongTypeAssertionsBlockTxs is the metric for monitoring the number of block transactions pool values
+// dropped by the txs pools cleaner because of their unexpected type
+const MetricTxPoolWrongTypeAssertionsBlockTxs = "erd_tx_pool_wrong_type_assertions_block_txs"
+
+// MetricTxPoolWrongTypeAssertionsUnsignedTxs is the metric for monitoring the number of unsigned transactions pool
+// values dropped by the txs pools cleaner because of their unexpected type
+const MetricTxPoolWrongTypeAssertionsUnsignedTxs = "erd_tx_pool_wrong_type_assertions_unsigned_txs"
+
+// MetricIndexerBulkDurationMs is the metric that outputs the duration, in milliseconds, of the last bulk request sent
+// by the indexer, including the retries
+const MetricIndexerBulkDurationMs = "erd_indexer_bulk_duration_ms"
+
+// MetricIndexerTxsIndexedTotal is the metric that outputs the number of transactions indexed since the node started
+const MetricIndexerTxsIndexedTotal = "erd_indexer_txs_indexed_total"
+
+// MetricIndexerBulkErrorsTotal is the metric that outputs the number of bulk requests the indexer failed to send since
+// the node started
+const MetricIndexerBulkErrorsTotal = "erd_indexer_bulk_errors_total"
+
+// MetricInterceptorMessagesTotalPrefix is the prefix of the per topic metrics that output the number of messages
+// processed by the interceptors since the node started
+const MetricInterceptorMessagesTotalPrefix = "erd_interceptor_messages_total_"
+
+// MetricInterceptorLatencyTotalUsPrefix is the prefix of the per topic metrics that output the time, in microseconds,
+// spent by the interceptors to process the messages since the node started
+const MetricInterceptorLatencyTotalUsPrefix = "erd_interceptor_latency_total_us_"
+
+// MetricInterceptorLatencyMaxUsPrefix is the prefix of the per topic metrics that output the longest time, in
+// microseconds, spent by the interceptors to process a message
+const MetricInterceptorLatencyMaxUsPrefix = "erd_interceptor_latency_max_us_"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
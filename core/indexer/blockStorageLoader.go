@@ -0,0 +1,162 @@
+package indexer
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+func (ei *elasticIndexer) getHeaderFromStorage(headerHash []byte) (data.HeaderHandler, error) {
+	if ei.shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err := ei.getFromStorage(dataRetriever.MetaBlockUnit, headerHash, metaBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaBlock, nil
+	}
+
+	header := &block.Header{}
+	err := ei.getFromStorage(dataRetriever.BlockHeaderUnit, headerHash, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (ei *elasticIndexer) getBodyFromStorage(header data.HeaderHandler) (*block.Body, error) {
+	miniBlocksHashes := getMiniBlocksHashes(header)
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(miniBlocksHashes)),
+	}
+	for _, mbHash := range miniBlocksHashes {
+		miniBlock := &block.MiniBlock{}
+		err := ei.getFromStorage(dataRetriever.MiniBlockUnit, mbHash, miniBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (ei *elasticIndexer) getTransactionsFromStorage(body *block.Body) (map[string]data.TransactionHandler, error) {
+	txPool := make(map[string]data.TransactionHandler)
+	for _, mb := range body.MiniBlocks {
+		unitType, ok := getStorageUnitForMiniBlockType(mb.Type)
+		if !ok {
+			continue
+		}
+
+		for _, txHash := range mb.TxHashes {
+			tx := createEmptyTransactionForMiniBlockType(mb.Type)
+			err := ei.getFromStorage(unitType, txHash, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			txPool[string(txHash)] = tx
+		}
+	}
+
+	return txPool, nil
+}
+
+func (ei *elasticIndexer) getFromStorage(unitType dataRetriever.UnitType, key []byte, obj interface{}) error {
+	buff, err := ei.storage.Get(unitType, key)
+	if err != nil {
+		return err
+	}
+
+	return ei.marshalizer.Unmarshal(obj, buff)
+}
+
+// getSignersIndexes recomputes the consensus group of the provided header in the same way the block processors do
+//  before indexing a committed block
+func (ei *elasticIndexer) getSignersIndexes(header data.HeaderHandler) ([]uint64, error) {
+	epoch := header.GetEpoch()
+	if header.GetShardID() != core.MetachainShardId && header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	publicKeys, err := ei.coordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		epoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ei.coordinator.GetValidatorsIndexes(publicKeys, epoch)
+}
+
+func getMiniBlockHeaders(header data.HeaderHandler) []block.MiniBlockHeader {
+	switch hdr := header.(type) {
+	case *block.Header:
+		return hdr.MiniBlockHeaders
+	case *block.MetaBlock:
+		return hdr.MiniBlockHeaders
+	default:
+		return nil
+	}
+}
+
+func getMiniBlocksHashes(header data.HeaderHandler) [][]byte {
+	miniBlockHeaders := getMiniBlockHeaders(header)
+	miniBlocksHashes := make([][]byte, 0, len(miniBlockHeaders))
+	for _, mbHeader := range miniBlockHeaders {
+		miniBlocksHashes = append(miniBlocksHashes, mbHeader.Hash)
+	}
+
+	return miniBlocksHashes
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+func getStorageUnitForMiniBlockType(mbType block.Type) (dataRetriever.UnitType, bool) {
+	switch mbType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+func createEmptyTransactionForMiniBlockType(mbType block.Type) data.TransactionHandler {
+	switch mbType {
+	case block.SmartContractResultBlock:
+		return &smartContractResult.SmartContractResult{}
+	case block.RewardsBlock:
+		return &rewardTx.RewardTx{}
+	default:
+		return &transaction.Transaction{}
+	}
+}
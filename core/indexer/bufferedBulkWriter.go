@@ -0,0 +1,175 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const bufferedBulkFlushThresholdInBytes = 1024 * 1024
+
+// bufferedBulkWriter accumulates the bulk requests of each index and sends them together once the buffered data
+// reaches the flush threshold. A timer forces the buffered bulks out periodically so that the indexing latency stays
+// bounded when the traffic is too low to reach the threshold. The buffered bulks are sent with the provided send
+// function, which is expected to retry the failed requests. The other requests are passed directly to the wrapped
+// writer
+type bufferedBulkWriter struct {
+	databaseWriterHandler
+	sendBulk              func(buff *bytes.Buffer, index string) error
+	flushThresholdInBytes int
+
+	mutBuffers sync.Mutex
+	buffers    map[string]*bytes.Buffer
+	closeChan  chan struct{}
+	closeOnce  sync.Once
+	flushDone  chan struct{}
+}
+
+// newBufferedBulkWriter creates a buffered bulk writer. The flush threshold is lowered to the provided maximum bulk
+// size, if set, so that the buffered bulks are not larger than the bulks sent directly
+func newBufferedBulkWriter(
+	writer databaseWriterHandler,
+	sendBulk func(buff *bytes.Buffer, index string) error,
+	flushInterval time.Duration,
+	maxBulkSizeInBytes uint32,
+) *bufferedBulkWriter {
+	flushThresholdInBytes := bufferedBulkFlushThresholdInBytes
+	if maxBulkSizeInBytes > 0 && int(maxBulkSizeInBytes) < flushThresholdInBytes {
+		flushThresholdInBytes = int(maxBulkSizeInBytes)
+	}
+
+	bbw := &bufferedBulkWriter{
+		databaseWriterHandler: writer,
+		sendBulk:              sendBulk,
+		flushThresholdInBytes: flushThresholdInBytes,
+		buffers:               make(map[string]*bytes.Buffer),
+		closeChan:             make(chan struct{}),
+		flushDone:             make(chan struct{}),
+	}
+
+	go bbw.flushPeriodically(flushInterval)
+
+	return bbw
+}
+
+// DoBulkRequest will buffer the provided bulk data and will send the buffered bulk of the index if the flush
+// threshold has been reached
+func (bbw *bufferedBulkWriter) DoBulkRequest(buff *bytes.Buffer, index string) error {
+	var lastErr error
+	for _, bulk := range bbw.bufferBulk(buff, index) {
+		err := bbw.sendBulk(bulk, index)
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// bufferBulk appends the bulk data to the buffer of the index and returns the buffers which have to be sent. The
+// buffered bulk is returned before the new data is appended if the result would exceed the flush threshold
+func (bbw *bufferedBulkWriter) bufferBulk(buff *bytes.Buffer, index string) []*bytes.Buffer {
+	bbw.mutBuffers.Lock()
+	defer bbw.mutBuffers.Unlock()
+
+	bulksToSend := make([]*bytes.Buffer, 0)
+	indexBuffer, ok := bbw.buffers[index]
+	if ok && indexBuffer.Len()+buff.Len() > bbw.flushThresholdInBytes {
+		bulksToSend = append(bulksToSend, indexBuffer)
+		ok = false
+	}
+	if !ok {
+		indexBuffer = &bytes.Buffer{}
+		bbw.buffers[index] = indexBuffer
+	}
+
+	_, _ = indexBuffer.Write(buff.Bytes())
+	if indexBuffer.Len() >= bbw.flushThresholdInBytes {
+		bulksToSend = append(bulksToSend, indexBuffer)
+		delete(bbw.buffers, index)
+	}
+
+	return bulksToSend
+}
+
+func (bbw *bufferedBulkWriter) flushPeriodically(flushInterval time.Duration) {
+	ticker := time.NewTicker(flushInterval)
+	defer func() {
+		ticker.Stop()
+		close(bbw.flushDone)
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			bbw.flush()
+		case <-bbw.closeChan:
+			bbw.flush()
+			return
+		}
+	}
+}
+
+// DoDeleteRequest will send the buffered bulk of the index before deleting the documents, so that a document still
+// waiting in the buffer will not be indexed again after its deletion
+func (bbw *bufferedBulkWriter) DoDeleteRequest(index string, ids []string) error {
+	err := bbw.flushIndex(index)
+	if err != nil {
+		return err
+	}
+
+	return bbw.databaseWriterHandler.DoDeleteRequest(index, ids)
+}
+
+// DoGetRequest will send the buffered bulk of the index before reading the document, so that the documents still
+// waiting in the buffer can be read back
+func (bbw *bufferedBulkWriter) DoGetRequest(index string, id string) (json.RawMessage, error) {
+	err := bbw.flushIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return bbw.databaseWriterHandler.DoGetRequest(index, id)
+}
+
+// flushIndex sends the buffered bulk of the provided index, if any
+func (bbw *bufferedBulkWriter) flushIndex(index string) error {
+	bbw.mutBuffers.Lock()
+	indexBuffer, ok := bbw.buffers[index]
+	delete(bbw.buffers, index)
+	bbw.mutBuffers.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return bbw.sendBulk(indexBuffer, index)
+}
+
+// flush sends all the buffered bulks regardless of their size
+func (bbw *bufferedBulkWriter) flush() {
+	bbw.mutBuffers.Lock()
+	buffers := bbw.buffers
+	bbw.buffers = make(map[string]*bytes.Buffer)
+	bbw.mutBuffers.Unlock()
+
+	for index, buff := range buffers {
+		size := buff.Len()
+		err := bbw.sendBulk(buff, index)
+		if err != nil {
+			log.Warn("indexer: could not flush the buffered bulk",
+				"index", index,
+				"size", size,
+				"error", err.Error())
+		}
+	}
+}
+
+// close stops the flush timer and waits until the remaining buffered bulks are sent
+func (bbw *bufferedBulkWriter) close() {
+	bbw.closeOnce.Do(func() {
+		close(bbw.closeChan)
+	})
+	<-bbw.flushDone
+}
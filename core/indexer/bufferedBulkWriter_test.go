@@ -0,0 +1,163 @@
+package indexer
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRecordingDatabaseWriter(mut *sync.Mutex, writtenBulks map[string]string) *mock.DatabaseWriterStub {
+	return &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			mut.Lock()
+			writtenBulks[index] += buff.String()
+			mut.Unlock()
+
+			return nil
+		},
+	}
+}
+
+func newTestBufferedBulkWriter(writer *mock.DatabaseWriterStub, flushInterval time.Duration) *bufferedBulkWriter {
+	return newBufferedBulkWriter(writer, writer.DoBulkRequest, flushInterval, 0)
+}
+
+func TestBufferedBulkWriter_SubThresholdBulkShouldBeFlushedWhenTheTimerElapses(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	writtenBulks := make(map[string]string)
+	flushInterval := time.Millisecond * 100
+	bbw := newTestBufferedBulkWriter(createRecordingDatabaseWriter(&mut, writtenBulks), flushInterval)
+	defer bbw.close()
+
+	err := bbw.DoBulkRequest(bytes.NewBufferString("tx1\n"), txIndex)
+	assert.Nil(t, err)
+	err = bbw.DoBulkRequest(bytes.NewBufferString("tx2\n"), txIndex)
+	assert.Nil(t, err)
+
+	mut.Lock()
+	assert.Equal(t, 0, len(writtenBulks))
+	mut.Unlock()
+
+	time.Sleep(flushInterval * 3)
+
+	mut.Lock()
+	assert.Equal(t, "tx1\ntx2\n", writtenBulks[txIndex])
+	mut.Unlock()
+}
+
+func TestBufferedBulkWriter_ThresholdReachedShouldFlushImmediately(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	writtenBulks := make(map[string]string)
+	bbw := newTestBufferedBulkWriter(createRecordingDatabaseWriter(&mut, writtenBulks), time.Hour)
+	defer bbw.close()
+	bbw.flushThresholdInBytes = 8
+
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx1\n"), txIndex)
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("mb1\n"), miniblocksIndex)
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx2\n"), txIndex)
+
+	mut.Lock()
+	assert.Equal(t, "tx1\ntx2\n", writtenBulks[txIndex])
+	_, miniblocksWritten := writtenBulks[miniblocksIndex]
+	assert.False(t, miniblocksWritten)
+	mut.Unlock()
+}
+
+func TestBufferedBulkWriter_CloseShouldFlushTheRemainingBulks(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	writtenBulks := make(map[string]string)
+	bbw := newTestBufferedBulkWriter(createRecordingDatabaseWriter(&mut, writtenBulks), time.Hour)
+
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("round1\n"), roundIndex)
+	bbw.close()
+
+	mut.Lock()
+	assert.Equal(t, "round1\n", writtenBulks[roundIndex])
+	mut.Unlock()
+}
+
+func TestBufferedBulkWriter_DeleteShouldFlushTheBufferedBulkOfTheIndexFirst(t *testing.T) {
+	t.Parallel()
+
+	requests := make([]string, 0)
+	writer := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			requests = append(requests, "bulk "+index+" "+buff.String())
+			return nil
+		},
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			requests = append(requests, "delete "+index)
+			return nil
+		},
+	}
+	bbw := newTestBufferedBulkWriter(writer, time.Hour)
+	defer bbw.close()
+
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx1\n"), txIndex)
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("round1\n"), roundIndex)
+	err := bbw.DoDeleteRequest(txIndex, []string{"tx1"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bulk " + txIndex + " tx1\n", "delete " + txIndex}, requests)
+}
+
+func TestNewBufferedBulkWriter_FlushThresholdShouldBeCappedAtTheMaxBulkSize(t *testing.T) {
+	t.Parallel()
+
+	writer := &mock.DatabaseWriterStub{}
+	bbw := newBufferedBulkWriter(writer, writer.DoBulkRequest, time.Hour, 1024)
+	defer bbw.close()
+	assert.Equal(t, 1024, bbw.flushThresholdInBytes)
+
+	bbwNoMaxSize := newBufferedBulkWriter(writer, writer.DoBulkRequest, time.Hour, 0)
+	defer bbwNoMaxSize.close()
+	assert.Equal(t, bufferedBulkFlushThresholdInBytes, bbwNoMaxSize.flushThresholdInBytes)
+}
+
+func TestBufferedBulkWriter_BulkExceedingTheThresholdShouldSendTheBufferedBulkFirst(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	writtenBulks := make(map[string]string)
+	writer := createRecordingDatabaseWriter(&mut, writtenBulks)
+	bbw := newBufferedBulkWriter(writer, writer.DoBulkRequest, time.Hour, 6)
+	defer bbw.close()
+
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx1\n"), txIndex)
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx2\n"), txIndex)
+
+	mut.Lock()
+	assert.Equal(t, "tx1\n", writtenBulks[txIndex])
+	mut.Unlock()
+}
+
+func TestBufferedBulkWriter_FlushShouldUseTheSendFunction(t *testing.T) {
+	t.Parallel()
+
+	numAttempts := 0
+	writer := &mock.DatabaseWriterStub{}
+	sendBulk := func(buff *bytes.Buffer, index string) error {
+		numAttempts++
+		return nil
+	}
+	bbw := newBufferedBulkWriter(writer, sendBulk, time.Hour, 0)
+
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx1\n"), txIndex)
+	err := bbw.DoDeleteRequest(txIndex, []string{"tx1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numAttempts)
+
+	_ = bbw.DoBulkRequest(bytes.NewBufferString("tx2\n"), txIndex)
+	bbw.close()
+	assert.Equal(t, 2, numAttempts)
+}
@@ -0,0 +1,28 @@
+package indexer
+
+import (
+	"sync/atomic"
+)
+
+// bulkLogSampler decides which of the successfully indexed bulks are logged. Only one out of samplingRate bulks is
+//  logged, a value of 0 or 1 will log all the bulks
+type bulkLogSampler struct {
+	samplingRate uint32
+	numBulks     uint64
+}
+
+func newBulkLogSampler(samplingRate uint32) *bulkLogSampler {
+	return &bulkLogSampler{
+		samplingRate: samplingRate,
+	}
+}
+
+// shouldLog returns true if the current bulk was sampled for logging
+func (bls *bulkLogSampler) shouldLog() bool {
+	numBulks := atomic.AddUint64(&bls.numBulks, 1)
+	if bls.samplingRate <= 1 {
+		return true
+	}
+
+	return (numBulks-1)%uint64(bls.samplingRate) == 0
+}
@@ -0,0 +1,135 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// bulkResponse holds the fields of a bulk response needed to find the items which were not indexed
+type bulkResponse struct {
+	Errors bool                          `json:"errors"`
+	Items  []map[string]bulkResponseItem `json:"items"`
+}
+
+type bulkResponseItem struct {
+	Status int `json:"status"`
+}
+
+// throttledBulkItemsError signals that elasticsearch accepted a bulk request but rejected some of its items with a too
+//  many requests status. It holds the bulk made only of the rejected items, which is the one to be resent
+type throttledBulkItemsError struct {
+	index          string
+	numItems       int
+	throttledItems *bytes.Buffer
+}
+
+// Error returns the error message
+func (e *throttledBulkItemsError) Error() string {
+	return fmt.Sprintf("%s, index: %s, throttled items: %d", ErrTooManyRequests.Error(), e.index, e.numItems)
+}
+
+// Unwrap returns ErrTooManyRequests, so that the error is handled as a throttled request
+func (e *throttledBulkItemsError) Unwrap() error {
+	return ErrTooManyRequests
+}
+
+// checkBulkResponseItems reads the response of an accepted bulk request and returns a throttledBulkItemsError if some
+//  of the items were throttled. The items which failed for other reasons are only logged, as resending them would
+//  fail again. A response which can not be read is considered successful, as the bulk request was accepted
+func checkBulkResponseItems(requestBody []byte, responseBody io.Reader, index string) error {
+	response, err := readBulkResponse(responseBody)
+	if err != nil {
+		log.Debug("indexer: could not read the bulk response", "index", index, "error", err.Error())
+		return nil
+	}
+	if !response.Errors {
+		return nil
+	}
+
+	items, err := splitBulkItems(requestBody)
+	if err != nil {
+		return err
+	}
+	if len(items) != len(response.Items) {
+		return fmt.Errorf("%w, index: %s, %d items sent, %d items in the response",
+			ErrBulkRequestRejected, index, len(items), len(response.Items))
+	}
+
+	throttled := &throttledBulkItemsError{
+		index:          index,
+		throttledItems: &bytes.Buffer{},
+	}
+	numFailedItems := 0
+	for idx, responseItem := range response.Items {
+		for _, result := range responseItem {
+			switch {
+			case result.Status == http.StatusTooManyRequests:
+				throttled.numItems++
+				_, _ = throttled.throttledItems.Write(items[idx])
+			case result.Status >= http.StatusBadRequest:
+				numFailedItems++
+			}
+		}
+	}
+
+	if numFailedItems > 0 {
+		log.Warn("indexer: bulk items not indexed", "index", index, "num items", numFailedItems)
+	}
+	if throttled.numItems > 0 {
+		return throttled
+	}
+
+	return nil
+}
+
+func readBulkResponse(responseBody io.Reader) (*bulkResponse, error) {
+	body, err := ioutil.ReadAll(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &bulkResponse{}
+	if len(body) == 0 {
+		return response, nil
+	}
+
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// splitBulkItems splits the body of a bulk request in items, each of them holding the action line and, except for
+//  the delete actions, the document line
+func splitBulkItems(body []byte) ([][]byte, error) {
+	lines := bytes.SplitAfter(body, []byte("\n"))
+	items := make([][]byte, 0, len(lines)/2)
+	for i := 0; i < len(lines); i++ {
+		if len(bytes.TrimSpace(lines[i])) == 0 {
+			continue
+		}
+
+		action := make(map[string]json.RawMessage)
+		err := json.Unmarshal(lines[i], &action)
+		if err != nil {
+			return nil, err
+		}
+
+		item := append([]byte{}, lines[i]...)
+		_, isDelete := action["delete"]
+		if !isDelete && i+1 < len(lines) {
+			i++
+			item = append(item, lines[i]...)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
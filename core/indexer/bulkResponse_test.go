@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBulkItems_DeleteActionShouldHaveNoDocumentLine(t *testing.T) {
+	t.Parallel()
+
+	body := `{ "index" : { "_id" : "a" } }` + "\n" + `{"nonce":1}` + "\n" +
+		`{ "delete" : { "_id" : "b" } }` + "\n" +
+		`{ "update" : { "_id" : "c" } }` + "\n" + `{"doc":{"status":"pending"}}` + "\n"
+
+	items, err := splitBulkItems([]byte(body))
+	require.Nil(t, err)
+	require.Equal(t, 3, len(items))
+	require.Equal(t, `{ "index" : { "_id" : "a" } }`+"\n"+`{"nonce":1}`+"\n", string(items[0]))
+	require.Equal(t, `{ "delete" : { "_id" : "b" } }`+"\n", string(items[1]))
+	require.Equal(t, `{ "update" : { "_id" : "c" } }`+"\n"+`{"doc":{"status":"pending"}}`+"\n", string(items[2]))
+}
+
+func TestSplitBulkItems_InvalidActionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	items, err := splitBulkItems([]byte("not json\n"))
+	require.NotNil(t, err)
+	require.Nil(t, items)
+}
+
+func TestCheckBulkResponseItems_NoErrorsShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{ "index" : { "_id" : "a" } }` + "\n" + `{"nonce":1}` + "\n")
+
+	err := checkBulkResponseItems(body, strings.NewReader(`{"errors":false,"items":[{"index":{"status":201}}]}`), txIndex)
+	require.Nil(t, err)
+
+	err = checkBulkResponseItems(body, strings.NewReader(""), txIndex)
+	require.Nil(t, err)
+}
+
+func TestCheckBulkResponseItems_ThrottledItemsShouldBeReturned(t *testing.T) {
+	t.Parallel()
+
+	first := `{ "index" : { "_id" : "a" } }` + "\n" + `{"nonce":1}` + "\n"
+	second := `{ "index" : { "_id" : "b" } }` + "\n" + `{"nonce":2}` + "\n"
+	third := `{ "index" : { "_id" : "c" } }` + "\n" + `{"nonce":3}` + "\n"
+	response := `{"errors":true,"items":[{"index":{"status":201}},{"index":{"status":429}},{"index":{"status":400}}]}`
+
+	err := checkBulkResponseItems([]byte(first+second+third), strings.NewReader(response), txIndex)
+	require.True(t, errors.Is(err, ErrTooManyRequests))
+
+	throttledItemsErr := &throttledBulkItemsError{}
+	require.True(t, errors.As(err, &throttledItemsErr))
+	require.Equal(t, 1, throttledItemsErr.numItems)
+	require.Equal(t, second, throttledItemsErr.throttledItems.String())
+}
+
+func TestCheckBulkResponseItems_ItemsCountMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := bytes.NewBufferString(`{ "index" : { "_id" : "a" } }` + "\n" + `{"nonce":1}` + "\n")
+	response := `{"errors":true,"items":[{"index":{"status":429}},{"index":{"status":429}}]}`
+
+	err := checkBulkResponseItems(body.Bytes(), strings.NewReader(response), txIndex)
+	require.True(t, errors.Is(err, ErrBulkRequestRejected))
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"math/big"
 	"strings"
 	"time"
@@ -24,6 +23,7 @@ import (
 type commonProcessor struct {
 	addressPubkeyConverter   core.PubkeyConverter
 	validatorPubkeyConverter core.PubkeyConverter
+	denomination             int
 }
 
 func checkElasticSearchParams(arguments ElasticIndexerArgs) error {
@@ -54,17 +54,34 @@ func checkElasticSearchParams(arguments ElasticIndexerArgs) error {
 	if arguments.EpochStartNotifier == nil {
 		return core.ErrNilEpochStartNotifier
 	}
+	if arguments.Options == nil {
+		return ErrNilOptions
+	}
 
 	return nil
 }
 
-func timestampMapping() io.Reader {
-	return strings.NewReader(
-		`{
-				"settings": {"index": {"sort.field": "timestamp", "sort.order": "desc"}},
-				"mappings": {"_doc": {"properties": {"timestamp": {"type": "date"}}}}
-			}`,
-	)
+func parseMiniBlockTypes(miniBlockTypesNames []string) (map[block.Type]struct{}, error) {
+	miniBlockTypes := make(map[block.Type]struct{}, len(miniBlockTypesNames))
+	for _, name := range miniBlockTypesNames {
+		value, ok := block.Type_value[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMiniBlockType, name)
+		}
+
+		miniBlockTypes[block.Type(value)] = struct{}{}
+	}
+
+	return miniBlockTypes, nil
+}
+
+func createIndexedShardsSet(shardIDs []uint32) map[uint32]struct{} {
+	indexedShards := make(map[uint32]struct{}, len(shardIDs))
+	for _, shardID := range shardIDs {
+		indexedShards[shardID] = struct{}{}
+	}
+
+	return indexedShards
 }
 
 func prepareGeneralInfo(tpsBenchmark statistics.TPSBenchmark) bytes.Buffer {
@@ -144,7 +161,8 @@ func (cm *commonProcessor) buildTransaction(
 		MBHash:        hex.EncodeToString(mbHash),
 		Nonce:         tx.Nonce,
 		Round:         header.GetRound(),
-		Value:         tx.Value.String(),
+		Value:         bigIntToString(tx.Value),
+		ValueNum:      computeDenominatedValue(tx.Value, cm.denomination),
 		Receiver:      cm.addressPubkeyConverter.Encode(tx.RcvAddr),
 		Sender:        cm.addressPubkeyConverter.Encode(tx.SndAddr),
 		ReceiverShard: mb.ReceiverShardID,
@@ -155,6 +173,7 @@ func (cm *commonProcessor) buildTransaction(
 		Signature:     hex.EncodeToString(tx.Signature),
 		Timestamp:     time.Duration(header.GetTimeStamp()),
 		Status:        txStatus,
+		TxType:        getTransactionType(tx),
 		GasUsed:       tx.GasLimit,
 	}
 }
@@ -172,7 +191,9 @@ func (cm *commonProcessor) buildRewardTransaction(
 		MBHash:        hex.EncodeToString(mbHash),
 		Nonce:         0,
 		Round:         rTx.Round,
-		Value:         rTx.Value.String(),
+		Value:         bigIntToString(rTx.Value),
+		ValueNum:      computeDenominatedValue(rTx.Value, cm.denomination),
+		Fee:           "0",
 		Receiver:      cm.addressPubkeyConverter.Encode(rTx.RcvAddr),
 		Sender:        fmt.Sprintf("%d", core.MetachainShardId),
 		ReceiverShard: mb.ReceiverShardID,
@@ -183,6 +204,7 @@ func (cm *commonProcessor) buildRewardTransaction(
 		Signature:     "",
 		Timestamp:     time.Duration(header.GetTimeStamp()),
 		Status:        txStatus,
+		TxType:        txTypeReward,
 	}
 }
 
@@ -239,14 +261,43 @@ func canInterpretAsString(bytes []byte) bool {
 	return true
 }
 
-func serializeBulkMiniBlocks(hdrShardID uint32, bulkMbs []*Miniblock) bytes.Buffer {
+// routeByShard routes the documents by the shard they originate from, so that the queries scoped to a shard will
+//  only hit the elasticsearch shard holding its documents
+func routeByShard(shardID uint32) string {
+	return fmt.Sprintf("%d", shardID)
+}
+
+// formatRouting returns the routing segment to be added in the metadata of a bulk action, or an empty string if
+//  there is no routing function or the function does not route the document
+func formatRouting(routingFunc func(shardID uint32) string, shardID uint32) string {
+	if routingFunc == nil {
+		return ""
+	}
+
+	routing := routingFunc(shardID)
+	if routing == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`, "routing" : "%s"`, routing)
+}
+
+// serializeBulkMiniBlocks inserts the miniblocks that are not indexed yet and only updates the block hash of the
+//  provided shard on the existing ones, so the block hash set by the other shard is preserved
+func serializeBulkMiniBlocks(
+	hdrShardID uint32,
+	bulkMbs []*Miniblock,
+	existingMbs map[string]bool,
+	routingFunc func(shardID uint32) string,
+) bytes.Buffer {
 	var err error
 	var buff bytes.Buffer
 	for _, mb := range bulkMbs {
 		var meta, serializedData []byte
-		if hdrShardID == mb.SenderShardID {
+		routing := formatRouting(routingFunc, mb.SenderShardID)
+		if !existingMbs[mb.Hash] {
 			//insert miniblock
-			meta = []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, mb.Hash, "_doc", "\n"))
+			meta = []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s"%s } }%s`, mb.Hash, "_doc", routing, "\n"))
 			serializedData, err = json.Marshal(mb)
 			if err != nil {
 				log.Debug("indexer: marshal",
@@ -254,10 +305,13 @@ func serializeBulkMiniBlocks(hdrShardID uint32, bulkMbs []*Miniblock) bytes.Buff
 					"mb hash", mb.Hash)
 				continue
 			}
-
+		} else if hdrShardID == mb.SenderShardID {
+			// update miniblock indexed first by the receiver shard
+			meta = []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"%s  } }%s`, mb.Hash, "_doc", routing, "\n"))
+			serializedData = []byte(fmt.Sprintf(`{ "doc" : { "senderBlockHash" : "%s" } }`, mb.SenderBlockHash))
 		} else {
 			// update miniblock
-			meta = []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"  } }%s`, mb.Hash, "_doc", "\n"))
+			meta = []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"%s  } }%s`, mb.Hash, "_doc", routing, "\n"))
 			serializedData = []byte(fmt.Sprintf(`{ "doc" : { "receiverBlockHash" : "%s" } }`, mb.ReceiverBlockHash))
 		}
 		buff = prepareBufferMiniblocks(buff, meta, serializedData)
@@ -282,20 +336,95 @@ func prepareBufferMiniblocks(buff bytes.Buffer, meta, serializedData []byte) byt
 	return buff
 }
 
-func serializeBulkTxs(bulk []*Transaction, selfShardID uint32) bytes.Buffer {
+func serializeScResults(
+	scResults []*ScResultDocument,
+	routingFunc func(shardID uint32) string,
+	fieldNamingFunc func(fieldName string) string,
+) bytes.Buffer {
+	var buff bytes.Buffer
+
+	for _, scr := range scResults {
+		routing := formatRouting(routingFunc, scr.SenderShard)
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s"%s } }%s`, scr.Hash, "_doc", routing, "\n"))
+		serializedData, err := marshalDocument(scr, fieldNamingFunc)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize smart contract result, will skip indexing",
+				"scr hash", scr.Hash)
+			continue
+		}
+
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize smart contract results, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize smart contract results, write serialized scr", "error", err.Error())
+		}
+	}
+
+	return buff
+}
+
+func serializeLogs(logs []*LogDocument, fieldNamingFunc func(fieldName string) string) bytes.Buffer {
+	var buff bytes.Buffer
+
+	for _, logDocument := range logs {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, logDocument.TxHash, "_doc", "\n"))
+		serializedData, err := marshalDocument(logDocument, fieldNamingFunc)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize log, will skip indexing",
+				"tx hash", logDocument.TxHash)
+			continue
+		}
+
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize logs, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize logs, write serialized log", "error", err.Error())
+		}
+	}
+
+	return buff
+}
+
+func serializeBulkTxs(
+	bulk []*Transaction,
+	selfShardID uint32,
+	routingFunc func(shardID uint32) string,
+	fieldNamingFunc func(fieldName string) string,
+) bytes.Buffer {
 	var buff bytes.Buffer
 	var err error
 
 	for _, tx := range bulk {
 		var meta, serializedData []byte
 
+		// the update of a cross shard transaction has to be routed as its insert, which is done on the source shard
+		routing := formatRouting(routingFunc, tx.SenderShard)
 		if isCrossShardDstMe(tx, selfShardID) && tx.Status != txStatusInvalid {
 			// update tx
-			meta, serializedData = prepareTxUpdate(tx)
+			meta, serializedData = prepareTxUpdate(tx, routing, fieldNamingFunc)
+			if meta == nil {
+				continue
+			}
 		} else {
 			// write tx
-			meta = []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, tx.Hash, "_doc", "\n"))
-			serializedData, err = json.Marshal(tx)
+			meta = []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s"%s } }%s`, tx.Hash, "_doc", routing, "\n"))
+			serializedData, err = marshalDocument(tx, fieldNamingFunc)
 			if err != nil {
 				log.Debug("indexer: marshal",
 					"error", "could not serialize transaction, will skip indexing",
@@ -321,44 +450,75 @@ func serializeBulkTxs(bulk []*Transaction, selfShardID uint32) bytes.Buffer {
 	return buff
 }
 
-func prepareTxUpdate(tx *Transaction) ([]byte, []byte) {
-	var meta, serializedData []byte
+// serializeTxsStatusUpdate prepares the partial updates setting the provided status on the documents of the
+//  transactions. The updates are routed as the inserts, which are done on the source shard
+func serializeTxsStatusUpdate(
+	txs []*Transaction,
+	status string,
+	routingFunc func(shardID uint32) string,
+	fieldNamingFunc func(fieldName string) string,
+) bytes.Buffer {
+	var buff bytes.Buffer
 
-	meta = []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"  } }%s`, tx.Hash, "_doc", "\n"))
+	for _, tx := range txs {
+		routing := formatRouting(routingFunc, tx.SenderShard)
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"%s  } }%s`, tx.Hash, "_doc", routing, "\n"))
+		serializedData, err := marshalDocument(map[string]interface{}{"doc": map[string]interface{}{"status": status}}, fieldNamingFunc)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize transaction status update, will skip indexing",
+				"tx hash", tx.Hash)
+			continue
+		}
 
-	marshalizedLog, err := json.Marshal(tx.Log)
-	if err != nil {
-		log.Debug("indexer: marshal",
-			"error", "could not serialize transaction log, will skip indexing",
-			"tx hash", tx.Hash)
-		return nil, nil
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize tx status update, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize tx status update, write serialized data", "error", err.Error())
+		}
 	}
-	scResults, err := json.Marshal(tx.SmartContractResults)
-	if err != nil {
-		log.Debug("indexer: marshal",
-			"error", "could not serialize smart contract results, will skip indexing",
-			"tx hash", tx.Hash)
-		return nil, nil
+
+	return buff
+}
+
+func prepareTxUpdate(tx *Transaction, routing string, fieldNamingFunc func(fieldName string) string) ([]byte, []byte) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "%s"%s  } }%s`, tx.Hash, "_doc", routing, "\n"))
+
+	// the partial document contains only the fields that carry information, so the update will be merged in the
+	// existing document without erasing fields that were previously indexed (like the status or the scResults)
+	partialDoc := map[string]interface{}{
+		"timestamp": tx.Timestamp,
+	}
+	if tx.Status != "" {
+		partialDoc["status"] = tx.Status
+	}
+	if len(tx.SmartContractResults) > 0 {
+		partialDoc["scResults"] = tx.SmartContractResults
+	}
+	if tx.Log.Address != "" || len(tx.Log.Events) > 0 {
+		partialDoc["log"] = tx.Log
+	}
+	if tx.GasUsed != tx.GasLimit {
+		// update gasUsed only if it was changed (is a smart contract operation)
+		partialDoc["gasUsed"] = tx.GasUsed
+		partialDoc["gasRefunded"] = tx.GasRefunded
 	}
 
-	marshalizedTimestamp, err := json.Marshal(tx.Timestamp)
+	serializedData, err := marshalDocument(map[string]interface{}{"doc": partialDoc}, fieldNamingFunc)
 	if err != nil {
 		log.Debug("indexer: marshal",
-			"error", "could not serialize timestamp, will skip indexing",
+			"error", "could not serialize transaction update, will skip indexing",
 			"tx hash", tx.Hash)
 		return nil, nil
 	}
 
-	if tx.GasUsed == tx.GasLimit {
-		// do not update gasUsed because it is the same with gasUsed when transaction was saved first time in database
-		serializedData = []byte(fmt.Sprintf(`{ "doc" : { "log" : %s, "scResults" : %s, "status": "%s", "timestamp": %s } }`,
-			string(marshalizedLog), string(scResults), tx.Status, string(marshalizedTimestamp)))
-	} else {
-		// update gasUsed because was changed (is a smart contract operation)
-		serializedData = []byte(fmt.Sprintf(`{ "doc" : { "log" : %s, "scResults" : %s, "status": "%s", "timestamp": %s, "gasUsed" : %s } }`,
-			string(marshalizedLog), string(scResults), tx.Status, string(marshalizedTimestamp), fmt.Sprintf("%d", tx.GasUsed)))
-	}
-
 	return meta, serializedData
 }
 
@@ -384,3 +544,229 @@ func computeSizeOfTxs(marshalizer marshal.Marshalizer, txs map[string]data.Trans
 
 	return txsSize
 }
+
+func serializeBulkRoundsInfo(
+	infos []RoundInfo,
+	routingFunc func(shardID uint32) string,
+	fieldNamingFunc func(fieldName string) string,
+) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, info := range infos {
+		serializedData, err := marshalDocument(info, fieldNamingFunc)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize round info, will skip indexing",
+				"round", info.Index)
+			continue
+		}
+
+		id := fmt.Sprintf("%d_%d", info.ShardId, info.Index)
+		routing := formatRouting(routingFunc, info.ShardId)
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s"%s } }%s`, id, "_doc", routing, "\n"))
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk rounds info, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk rounds info, write serialized data", "error", err.Error())
+		}
+	}
+
+	return buff
+}
+
+func serializeBulkAccountsHistory(
+	blockNonce uint64,
+	changes []AccountBalanceChange,
+	addressPubkeyConverter core.PubkeyConverter,
+) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, change := range changes {
+		address := addressPubkeyConverter.Encode(change.Address)
+		oldBalance := big.NewInt(0)
+		if change.OldBalance != nil {
+			oldBalance = change.OldBalance
+		}
+		newBalance := big.NewInt(0)
+		if change.NewBalance != nil {
+			newBalance = change.NewBalance
+		}
+
+		balanceHistory := &AccountBalanceHistory{
+			Address:    address,
+			OldBalance: oldBalance.String(),
+			NewBalance: newBalance.String(),
+			Delta:      big.NewInt(0).Sub(newBalance, oldBalance).String(),
+			BlockNonce: blockNonce,
+		}
+
+		serializedData, err := json.Marshal(balanceHistory)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize account balance history, will skip indexing",
+				"address", address)
+			continue
+		}
+
+		id := fmt.Sprintf("%s_%d", address, blockNonce)
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, id, "_doc", "\n"))
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk accounts history, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk accounts history, write serialized data", "error", err.Error())
+		}
+	}
+
+	return buff
+}
+
+func serializeBulkValidatorsRatingHistory(ratingsHistory []*ValidatorRatingHistory) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, ratingHistory := range ratingsHistory {
+		serializedData, err := json.Marshal(ratingHistory)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize validator rating history, will skip indexing",
+				"public key", ratingHistory.PublicKey)
+			continue
+		}
+
+		// the id of the document is fixed for a validator and an epoch, so re-indexing an epoch overwrites its documents
+		id := fmt.Sprintf("%s_%d", ratingHistory.PublicKey, ratingHistory.Epoch)
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, id, "_doc", "\n"))
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk validators rating history, write meta", "error", err.Error())
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk validators rating history, write serialized data", "error", err.Error())
+		}
+	}
+
+	return buff
+}
+
+func prepareEpochInfo(epoch uint32, econ EpochEconomics) *EpochInfo {
+	return &EpochInfo{
+		Epoch:               epoch,
+		TotalSupply:         bigIntToString(econ.TotalSupply),
+		Inflation:           bigIntToString(econ.Inflation),
+		RewardsDistributed:  bigIntToString(econ.RewardsDistributed),
+		RewardsForCommunity: bigIntToString(econ.RewardsForCommunity),
+		AccumulatedFees:     bigIntToString(econ.AccumulatedFees),
+		NodePrice:           bigIntToString(econ.NodePrice),
+	}
+}
+
+func prepareEpochStartInfo(metaBlock *block.MetaBlock) *EpochStartInfo {
+	lastFinalizedHeaders := make([]EpochStartShardInfo, 0, len(metaBlock.EpochStart.LastFinalizedHeaders))
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		pendingMiniBlocks := make([]EpochStartPendingMiniBlock, 0, len(shardData.PendingMiniBlockHeaders))
+		for _, mbHeader := range shardData.PendingMiniBlockHeaders {
+			pendingMiniBlocks = append(pendingMiniBlocks, EpochStartPendingMiniBlock{
+				Hash:            hex.EncodeToString(mbHeader.Hash),
+				SenderShardID:   mbHeader.SenderShardID,
+				ReceiverShardID: mbHeader.ReceiverShardID,
+				TxCount:         mbHeader.TxCount,
+				Type:            mbHeader.Type.String(),
+			})
+		}
+
+		lastFinalizedHeaders = append(lastFinalizedHeaders, EpochStartShardInfo{
+			ShardID:               shardData.ShardID,
+			Epoch:                 shardData.Epoch,
+			Round:                 shardData.Round,
+			Nonce:                 shardData.Nonce,
+			HeaderHash:            hex.EncodeToString(shardData.HeaderHash),
+			RootHash:              hex.EncodeToString(shardData.RootHash),
+			FirstPendingMetaBlock: hex.EncodeToString(shardData.FirstPendingMetaBlock),
+			LastFinishedMetaBlock: hex.EncodeToString(shardData.LastFinishedMetaBlock),
+			PendingMiniBlocks:     pendingMiniBlocks,
+		})
+	}
+
+	return &EpochStartInfo{
+		Epoch:                metaBlock.GetEpoch(),
+		LastFinalizedHeaders: lastFinalizedHeaders,
+	}
+}
+
+func prepareEpochStartBlockInfo(metaBlock *block.MetaBlock) *EpochStartBlockInfo {
+	shardHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		shardHeadersHashes = append(shardHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return &EpochStartBlockInfo{
+		Epoch:              metaBlock.Epoch,
+		Round:              metaBlock.Round,
+		Timestamp:          time.Duration(metaBlock.TimeStamp),
+		ShardHeadersHashes: shardHeadersHashes,
+	}
+}
+
+func serializeEpochInfo(info *EpochInfo) (bytes.Buffer, error) {
+	return serializeEpochDocumentUpsert(info.Epoch, info)
+}
+
+// serializeEpochStartInfo prepares an upsert of the epoch info document, so that the finalization information will be
+//  merged with the economics of the epoch, which are indexed separately
+func serializeEpochStartInfo(info *EpochStartInfo) (bytes.Buffer, error) {
+	return serializeEpochDocumentUpsert(info.Epoch, info)
+}
+
+func serializeEpochStartBlockInfo(info *EpochStartBlockInfo) (bytes.Buffer, error) {
+	return serializeEpochDocumentUpsert(info.Epoch, info)
+}
+
+// serializeEpochDocumentUpsert merges the provided fields in the document of the epoch, so that the information
+//  indexed at different moments for the same epoch is kept together
+func serializeEpochDocumentUpsert(epoch uint32, document interface{}) (bytes.Buffer, error) {
+	var buff bytes.Buffer
+
+	serializedData, err := json.Marshal(map[string]interface{}{
+		"doc":           document,
+		"doc_as_upsert": true,
+	})
+	if err != nil {
+		return buff, err
+	}
+
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_id" : "%d", "_type" : "%s" } }%s`, epoch, "_doc", "\n"))
+	// append a newline for each element
+	serializedData = append(serializedData, "\n"...)
+
+	buff.Grow(len(meta) + len(serializedData))
+	_, err = buff.Write(meta)
+	if err != nil {
+		return buff, err
+	}
+	_, err = buff.Write(serializedData)
+
+	return buff, err
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -3,9 +3,11 @@ package indexer
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -83,7 +85,9 @@ func TestGetTransactionByType_RewardTx(t *testing.T) {
 		Round:    round,
 		Receiver: hex.EncodeToString(rcvAddr),
 		Status:   status,
-		Value:    "<nil>",
+		TxType:   txTypeReward,
+		Value:    "0",
+		Fee:      "0",
 		Sender:   fmt.Sprintf("%d", core.MetachainShardId),
 		Data:     "",
 	}
@@ -178,3 +182,156 @@ func TestInterpretAsString(t *testing.T) {
 	decodedData = decodeScResultData(data2)
 	require.Equal(t, expectedData2, decodedData)
 }
+
+// applyBulkOnDocuments mimics the elasticsearch bulk API semantics for index and partial update actions
+func applyBulkOnDocuments(t *testing.T, documents map[string]map[string]interface{}, buff bytes.Buffer) {
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 0, len(lines)%2)
+
+	for i := 0; i < len(lines); i += 2 {
+		action := make(map[string]map[string]interface{})
+		require.Nil(t, json.Unmarshal([]byte(lines[i]), &action))
+		content := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal([]byte(lines[i+1]), &content))
+
+		if indexAction, ok := action["index"]; ok {
+			documents[indexAction["_id"].(string)] = content
+			continue
+		}
+
+		updateAction, ok := action["update"]
+		require.True(t, ok)
+		existingDoc, ok := documents[updateAction["_id"].(string)]
+		require.True(t, ok)
+		for field, value := range content["doc"].(map[string]interface{}) {
+			existingDoc[field] = value
+		}
+	}
+}
+
+func TestSerializeBulkTxs_UpdateShouldPreserveExistingFields(t *testing.T) {
+	t.Parallel()
+
+	documents := make(map[string]map[string]interface{})
+	tx := &Transaction{
+		Hash:        "txHash",
+		Sender:      "sender",
+		SenderShard: 0,
+		GasLimit:    100,
+		GasUsed:     100,
+		Status:      txStatusPending,
+	}
+
+	// insert on the source shard
+	applyBulkOnDocuments(t, documents, serializeBulkTxs([]*Transaction{tx}, 0, nil, nil))
+	require.Equal(t, txStatusPending, documents[tx.Hash]["status"])
+
+	// update on the destination shard, with smart contract results
+	scrTx := *tx
+	scrTx.ReceiverShard = 1
+	scrTx.Status = txStatusSuccess
+	scrTx.GasUsed = 80
+	scrTx.SmartContractResults = []ScResult{{Data: "@ok"}}
+	applyBulkOnDocuments(t, documents, serializeBulkTxs([]*Transaction{&scrTx}, 1, nil, nil))
+	require.Equal(t, txStatusSuccess, documents[tx.Hash]["status"])
+
+	// a later re-index update, without smart contract results, should not erase the previously indexed fields
+	reindexedTx := *tx
+	reindexedTx.ReceiverShard = 1
+	reindexedTx.Status = ""
+	reindexedTx.Timestamp = 1234
+	applyBulkOnDocuments(t, documents, serializeBulkTxs([]*Transaction{&reindexedTx}, 1, nil, nil))
+
+	doc := documents[tx.Hash]
+	require.Equal(t, txStatusSuccess, doc["status"])
+	require.Equal(t, float64(80), doc["gasUsed"])
+	require.Equal(t, "sender", doc["sender"])
+	require.Equal(t, float64(1234), doc["timestamp"])
+	require.Equal(t, 1, len(doc["scResults"].([]interface{})))
+}
+
+func getBulkActionsMetadata(t *testing.T, buff bytes.Buffer) []map[string]interface{} {
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 0, len(lines)%2)
+
+	actionsMetadata := make([]map[string]interface{}, 0, len(lines)/2)
+	for i := 0; i < len(lines); i += 2 {
+		action := make(map[string]map[string]interface{})
+		require.Nil(t, json.Unmarshal([]byte(lines[i]), &action))
+		for _, metadata := range action {
+			actionsMetadata = append(actionsMetadata, metadata)
+		}
+	}
+
+	return actionsMetadata
+}
+
+func TestSerializeBulkTxs_ShouldRouteEachDocumentBySourceShard(t *testing.T) {
+	t.Parallel()
+
+	txs := []*Transaction{
+		{Hash: "intraShardTx", SenderShard: 1, ReceiverShard: 1},
+		{Hash: "crossShardDstMeTx", SenderShard: 0, ReceiverShard: 1, Status: txStatusSuccess},
+		{Hash: "crossShardSrcMeTx", SenderShard: 1, ReceiverShard: 2},
+	}
+
+	actionsMetadata := getBulkActionsMetadata(t, serializeBulkTxs(txs, 1, routeByShard, nil))
+	require.Equal(t, 3, len(actionsMetadata))
+	require.Equal(t, "intraShardTx", actionsMetadata[0]["_id"])
+	require.Equal(t, "1", actionsMetadata[0]["routing"])
+	require.Equal(t, "crossShardDstMeTx", actionsMetadata[1]["_id"])
+	require.Equal(t, "0", actionsMetadata[1]["routing"])
+	require.Equal(t, "crossShardSrcMeTx", actionsMetadata[2]["_id"])
+	require.Equal(t, "1", actionsMetadata[2]["routing"])
+}
+
+func TestSerializeBulkMiniBlocks_ShouldRouteEachDocumentBySenderShard(t *testing.T) {
+	t.Parallel()
+
+	miniblocks := []*Miniblock{
+		{Hash: "mbSrcMe", SenderShardID: 1, ReceiverShardID: 2},
+		{Hash: "mbDstMe", SenderShardID: 0, ReceiverShardID: 1, ReceiverBlockHash: "blockHash"},
+	}
+
+	existingMbs := map[string]bool{"mbDstMe": true}
+	actionsMetadata := getBulkActionsMetadata(t, serializeBulkMiniBlocks(1, miniblocks, existingMbs, routeByShard))
+	require.Equal(t, 2, len(actionsMetadata))
+	require.Equal(t, "1", actionsMetadata[0]["routing"])
+	require.Equal(t, "0", actionsMetadata[1]["routing"])
+}
+
+func TestSerializeBulkMiniBlocks_ShouldInsertOrUpdateBasedOnTheExistingMiniblocks(t *testing.T) {
+	t.Parallel()
+
+	miniblocks := []*Miniblock{
+		{Hash: "mbSrcMe", SenderShardID: 1, ReceiverShardID: 2, SenderBlockHash: "blockHash"},
+		{Hash: "mbSrcMeIndexedByReceiver", SenderShardID: 1, ReceiverShardID: 2, SenderBlockHash: "blockHash"},
+		{Hash: "mbDstMe", SenderShardID: 0, ReceiverShardID: 1, ReceiverBlockHash: "blockHash"},
+		{Hash: "mbDstMeNotIndexedBySender", SenderShardID: 0, ReceiverShardID: 1, ReceiverBlockHash: "blockHash"},
+	}
+	existingMbs := map[string]bool{
+		"mbSrcMeIndexedByReceiver": true,
+		"mbDstMe":                  true,
+	}
+
+	buff := serializeBulkMiniBlocks(1, miniblocks, existingMbs, nil)
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 8, len(lines))
+	require.True(t, strings.HasPrefix(lines[0], `{ "index" : { "_id" : "mbSrcMe"`))
+	require.True(t, strings.HasPrefix(lines[2], `{ "update" : { "_id" : "mbSrcMeIndexedByReceiver"`))
+	require.Equal(t, `{ "doc" : { "senderBlockHash" : "blockHash" } }`, lines[3])
+	require.True(t, strings.HasPrefix(lines[4], `{ "update" : { "_id" : "mbDstMe"`))
+	require.Equal(t, `{ "doc" : { "receiverBlockHash" : "blockHash" } }`, lines[5])
+	require.True(t, strings.HasPrefix(lines[6], `{ "index" : { "_id" : "mbDstMeNotIndexedBySender"`))
+}
+
+func TestSerializeBulkTxs_WithoutRoutingFuncShouldNotRoute(t *testing.T) {
+	t.Parallel()
+
+	txs := []*Transaction{{Hash: "txHash", SenderShard: 1, ReceiverShard: 1}}
+
+	actionsMetadata := getBulkActionsMetadata(t, serializeBulkTxs(txs, 1, nil, nil))
+	require.Equal(t, 1, len(actionsMetadata))
+	_, hasRouting := actionsMetadata[0]["routing"]
+	require.False(t, hasRouting)
+}
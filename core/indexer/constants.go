@@ -8,6 +8,14 @@ const tpsIndex = "tps"
 const validatorsIndex = "validators"
 const roundIndex = "rounds"
 const ratingIndex = "rating"
+const ratingHistoryIndex = "ratinghistory"
+const accountsHistoryIndex = "accountshistory"
+const epochInfoIndex = "epochinfo"
+const scResultsIndex = "scresults"
+const logsIndex = "logs"
 
 const metachainTpsDocID = "meta"
 const shardTpsDocIDPrefix = "shard"
+
+const maxContiguityNoncesSpan = 100000
+const contiguityPageSize = 1000
@@ -15,6 +15,9 @@ type Transaction struct {
 	Nonce                uint64        `json:"nonce"`
 	Round                uint64        `json:"round"`
 	Value                string        `json:"value"`
+	ValueNum             float64       `json:"valueNum"`
+	Fee                  string        `json:"fee"`
+	FeeNum               float64       `json:"feeNum"`
 	Receiver             string        `json:"receiver"`
 	Sender               string        `json:"sender"`
 	ReceiverShard        uint32        `json:"receiverShard"`
@@ -22,10 +25,15 @@ type Transaction struct {
 	GasPrice             uint64        `json:"gasPrice"`
 	GasLimit             uint64        `json:"gasLimit"`
 	GasUsed              uint64        `json:"gasUsed"`
-	Data                 string        `json:"data"`
+	GasRefunded          uint64        `json:"gasRefunded"`
+	Data                 string        `json:"data,omitempty"`
+	HasData              bool          `json:"hasData"`
 	Signature            string        `json:"signature"`
 	Timestamp            time.Duration `json:"timestamp"`
 	Status               string        `json:"status"`
+	TxType               string        `json:"type"`
+	Relayer              string        `json:"relayer,omitempty"`
+	RelayedTxHash        string        `json:"relayedTxHash,omitempty"`
 	SmartContractResults []ScResult    `json:"scResults"`
 	Log                  TxLog         `json:"-"`
 }
@@ -44,6 +52,13 @@ type Event struct {
 	Data       string   `json:"data"`
 }
 
+// LogDocument is a structure containing all the fields saved in the logs index for the log of a transaction
+type LogDocument struct {
+	TxHash  string  `json:"txHash"`
+	Address string  `json:"address"`
+	Events  []Event `json:"events"`
+}
+
 // ScResult is a structure containing all the fields that need to be saved for a smart contract result
 type ScResult struct {
 	Nonce         uint64 `json:"nonce"`
@@ -58,28 +73,58 @@ type ScResult struct {
 	CallType      string `json:"callType"`
 	CodeMetadata  string `json:"codeMetaData"`
 	ReturnMessage string `json:"returnMessage"`
+	SenderShard   uint32 `json:"senderShard"`
+	ReceiverShard uint32 `json:"receiverShard"`
+}
+
+// ScResultDocument is a structure containing all the fields saved in the smart contract results index. The document
+//  is linked to the transaction which generated it through the originalTxHash field
+type ScResultDocument struct {
+	Hash           string        `json:"-"`
+	MBHash         string        `json:"miniBlockHash"`
+	Nonce          uint64        `json:"nonce"`
+	GasLimit       uint64        `json:"gasLimit"`
+	GasPrice       uint64        `json:"gasPrice"`
+	Value          string        `json:"value"`
+	Sender         string        `json:"sender"`
+	Receiver       string        `json:"receiver"`
+	Data           string        `json:"data"`
+	PreTxHash      string        `json:"prevTxHash"`
+	OriginalTxHash string        `json:"originalTxHash"`
+	SenderShard    uint32        `json:"senderShard"`
+	ReceiverShard  uint32        `json:"receiverShard"`
+	Timestamp      time.Duration `json:"timestamp"`
 }
 
 // Block is a structure containing all the fields that need
 //  to be saved for a block. It has all the default fields
 //  plus some extra information for ease of search and filter
 type Block struct {
-	Nonce                 uint64        `json:"nonce"`
-	Round                 uint64        `json:"round"`
-	Epoch                 uint32        `json:"epoch"`
-	Hash                  string        `json:"-"`
-	MiniBlocksHashes      []string      `json:"miniBlocksHashes"`
-	NotarizedBlocksHashes []string      `json:"notarizedBlocksHashes"`
-	Proposer              uint64        `json:"proposer"`
-	Validators            []uint64      `json:"validators"`
-	PubKeyBitmap          string        `json:"pubKeyBitmap"`
-	Size                  int64         `json:"size"`
-	SizeTxs               int64         `json:"sizeTxs"`
-	Timestamp             time.Duration `json:"timestamp"`
-	StateRootHash         string        `json:"stateRootHash"`
-	PrevHash              string        `json:"prevHash"`
-	ShardID               uint32        `json:"shardId"`
-	TxCount               uint32        `json:"txCount"`
+	Nonce                 uint64          `json:"nonce"`
+	Round                 uint64          `json:"round"`
+	Epoch                 uint32          `json:"epoch"`
+	Hash                  string          `json:"-"`
+	MiniBlocksHashes      []string        `json:"miniBlocksHashes"`
+	MiniBlocks            []MiniBlockInfo `json:"miniBlocks"`
+	NotarizedBlocksHashes []string        `json:"notarizedBlocksHashes"`
+	Proposer              uint64          `json:"proposer"`
+	ProposerPubKey        string          `json:"proposerPubKey,omitempty"`
+	Validators            []uint64        `json:"validators"`
+	PubKeyBitmap          string          `json:"pubKeyBitmap"`
+	Size                  int64           `json:"size"`
+	IsHeaderSizeOnly      bool            `json:"isHeaderSizeOnly"`
+	SizeTxs               int64           `json:"sizeTxs"`
+	Timestamp             time.Duration   `json:"timestamp"`
+	StateRootHash         string          `json:"stateRootHash"`
+	PrevHash              string          `json:"prevHash"`
+	ShardID               uint32          `json:"shardId"`
+	TxCount               uint32          `json:"txCount"`
+}
+
+// MiniBlockInfo holds the hash of a miniblock included in a block, together with the miniblock type
+type MiniBlockInfo struct {
+	Hash string `json:"hash"`
+	Type string `json:"type"`
 }
 
 //ValidatorsPublicKeys is a structure containing fields for validators public keys
@@ -94,6 +139,10 @@ type RoundInfo struct {
 	BlockWasProposed bool          `json:"blockWasProposed"`
 	ShardId          uint32        `json:"shardId"`
 	Timestamp        time.Duration `json:"timestamp"`
+	Epoch            uint32        `json:"epoch"`
+	Proposer         string        `json:"proposer,omitempty"`
+	ConsensusGroup   []string      `json:"consensusGroup,omitempty"`
+	Randomness       []byte        `json:"-"`
 }
 
 // ValidatorsRatingInfo is a structure containing validators information
@@ -107,6 +156,20 @@ type ValidatorRatingInfo struct {
 	Rating    float32 `json:"rating"`
 }
 
+// ValidatorRatingHistory is a structure containing the rating and the success and failure counters of a validator at
+//  the end of an epoch
+type ValidatorRatingHistory struct {
+	PublicKey           string  `json:"publicKey"`
+	Epoch               uint32  `json:"epoch"`
+	ShardID             uint32  `json:"shardId"`
+	Rating              float32 `json:"rating"`
+	TempRating          float32 `json:"tempRating"`
+	NumLeaderSuccess    uint32  `json:"numLeaderSuccess"`
+	NumLeaderFailure    uint32  `json:"numLeaderFailure"`
+	NumValidatorSuccess uint32  `json:"numValidatorSuccess"`
+	NumValidatorFailure uint32  `json:"numValidatorFailure"`
+}
+
 // Miniblock is a structure containing miniblock information
 type Miniblock struct {
 	Hash              string `json:"-"`
@@ -134,3 +197,77 @@ type TPS struct {
 	LastBlockTxCount      uint32   `json:"lastBlockTxCount"`
 	ShardID               uint32   `json:"shardID"`
 }
+
+// AccountBalanceChange holds the balance of an account before and after a block was committed
+type AccountBalanceChange struct {
+	Address    []byte
+	OldBalance *big.Int
+	NewBalance *big.Int
+}
+
+// AccountBalanceHistory is a structure containing the balance change of an account in a block
+type AccountBalanceHistory struct {
+	Address    string `json:"address"`
+	OldBalance string `json:"oldBalance"`
+	NewBalance string `json:"newBalance"`
+	Delta      string `json:"delta"`
+	BlockNonce uint64 `json:"blockNonce"`
+}
+
+// EpochEconomics holds the economics values computed by the metachain at the start of an epoch
+type EpochEconomics struct {
+	TotalSupply         *big.Int
+	Inflation           *big.Int
+	RewardsDistributed  *big.Int
+	RewardsForCommunity *big.Int
+	AccumulatedFees     *big.Int
+	NodePrice           *big.Int
+}
+
+// EpochInfo is a structure containing the economics information of an epoch
+type EpochInfo struct {
+	Epoch               uint32 `json:"epoch"`
+	TotalSupply         string `json:"totalSupply"`
+	Inflation           string `json:"inflation"`
+	RewardsDistributed  string `json:"rewardsDistributed"`
+	RewardsForCommunity string `json:"rewardsForCommunity"`
+	AccumulatedFees     string `json:"accumulatedFees"`
+	NodePrice           string `json:"nodePrice"`
+}
+
+// EpochStartInfo is a structure containing the finalization information of the shards, as committed by the epoch
+//  start metablock
+type EpochStartInfo struct {
+	Epoch                uint32                `json:"epoch"`
+	LastFinalizedHeaders []EpochStartShardInfo `json:"lastFinalizedHeaders"`
+}
+
+// EpochStartBlockInfo is a structure containing the details of the metablock starting an epoch
+type EpochStartBlockInfo struct {
+	Epoch              uint32        `json:"epoch"`
+	Round              uint64        `json:"startRound"`
+	Timestamp          time.Duration `json:"startTimestamp"`
+	ShardHeadersHashes []string      `json:"shardHeadersHashes"`
+}
+
+// EpochStartShardInfo holds the last finalized header of a shard and the miniblocks still pending at the epoch start
+type EpochStartShardInfo struct {
+	ShardID               uint32                       `json:"shardId"`
+	Epoch                 uint32                       `json:"epoch"`
+	Round                 uint64                       `json:"round"`
+	Nonce                 uint64                       `json:"nonce"`
+	HeaderHash            string                       `json:"headerHash"`
+	RootHash              string                       `json:"rootHash"`
+	FirstPendingMetaBlock string                       `json:"firstPendingMetaBlock"`
+	LastFinishedMetaBlock string                       `json:"lastFinishedMetaBlock"`
+	PendingMiniBlocks     []EpochStartPendingMiniBlock `json:"pendingMiniBlocks"`
+}
+
+// EpochStartPendingMiniBlock holds the header of a miniblock not yet executed by its destination at the epoch start
+type EpochStartPendingMiniBlock struct {
+	Hash            string `json:"hash"`
+	SenderShardID   uint32 `json:"senderShard"`
+	ReceiverShardID uint32 `json:"receiverShard"`
+	TxCount         uint32 `json:"txCount"`
+	Type            string `json:"type"`
+}
@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"math"
+	"math/big"
+)
+
+const defaultDenomination = 18
+
+func denominationOrDefault(denomination int) int {
+	if denomination <= 0 {
+		return defaultDenomination
+	}
+
+	return denomination
+}
+
+// computeDenominatedValue converts the provided integer amount in a float value having the provided number of
+//  decimals. Amounts that do not fit in a float64 are capped to the maximum float64 value
+func computeDenominatedValue(value *big.Int, denomination int) float64 {
+	if value == nil {
+		return 0
+	}
+
+	divisor := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(denomination)), nil)
+	denominatedValue := big.NewFloat(0).SetInt(value)
+	denominatedValue.Quo(denominatedValue, big.NewFloat(0).SetInt(divisor))
+
+	floatValue, _ := denominatedValue.Float64()
+	if math.IsInf(floatValue, 1) {
+		return math.MaxFloat64
+	}
+	if math.IsInf(floatValue, -1) {
+		return -math.MaxFloat64
+	}
+
+	return floatValue
+}
+
+// setTransactionFee sets the fee paid for the gas used by the transaction, as raw integer and denominated values
+func (cm *commonProcessor) setTransactionFee(tx *Transaction) {
+	fee := big.NewInt(0).SetUint64(tx.GasUsed)
+	fee.Mul(fee, big.NewInt(0).SetUint64(tx.GasPrice))
+
+	tx.Fee = fee.String()
+	tx.FeeNum = computeDenominatedValue(fee, cm.denomination)
+}
@@ -2,6 +2,8 @@ package indexer
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -9,6 +11,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -21,15 +25,62 @@ var log = logger.GetOrCreate("core/indexer")
 
 // Options structure holds the indexer's configuration options
 type Options struct {
-	TxIndexingEnabled bool
+	TxIndexingEnabled       bool
+	EnabledMiniBlockTypes   []string
+	IndexTemplatesPath      string
+	MaxInFlightBulkRequests uint32
+	BulkFlushIntervalInSec  uint32
+	BulkLogSamplingRate     uint32
+	Denomination            int
+	IndicesSettings         map[string]IndexSettings
+	IndexedShards           []uint32
+	MetachainIndexingOff    bool
+
+	IndexCreationMaxAttempts        uint32
+	IndexCreationRetryIntervalInSec uint32
+
+	BulkRequestMaxAttempts           uint32
+	BulkRequestRetryDelayInMillisecs uint32
+	BulkRequestMaxSizeInBytes        uint32
+
+	ThrottlingMaxRetries            uint32
+	ThrottlingRetryDelayInMillisecs uint32
+
+	IndexingQueueSize uint32
+	IndexingWorkers   uint32
+
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	UseGzip             bool
+	RequestTimeoutInSec uint32
+
+	ResolveRoundConsensusGroup bool
+
+	RouteDocumentsByShard bool
+
+	TxDataIndexingOff bool
+	MaxTxDataBytes    uint32
+
+	FieldNaming string
+}
+
+// IndexSettings holds the number of shards and replicas applied when an index is created. A 0 number of shards or a
+//  nil number of replicas keeps the value defined by the index template, so that 0 replicas can be requested
+type IndexSettings struct {
+	NumberOfShards   uint32
+	NumberOfReplicas *uint32
 }
 
 //ElasticIndexerArgs is struct that is used to store all components that are needed to create a indexer
 type ElasticIndexerArgs struct {
 	ShardId                  uint32
 	Url                      string
+	FailoverUrls             []string
 	UserName                 string
 	Password                 string
+	MetricsUrl               string
+	MetricsUserName          string
+	MetricsPassword          string
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	EpochStartNotifier       sharding.EpochStartEventNotifier
@@ -37,14 +88,26 @@ type ElasticIndexerArgs struct {
 	AddressPubkeyConverter   core.PubkeyConverter
 	ValidatorPubkeyConverter core.PubkeyConverter
 	Options                  *Options
+	// Storage is optional and is only needed in order to reindex the blocks already saved by the node
+	Storage dataRetriever.StorageService
+	// FeeHandler is optional and is needed in order to compute the gas used by the move balance transactions
+	FeeHandler process.FeeHandler
+	// AppStatusHandler is optional and receives the indexing latency and throughput metrics
+	AppStatusHandler core.AppStatusHandler
 }
 
 type elasticIndexer struct {
-	database     databaseHandler
-	options      *Options
-	coordinator  sharding.NodesCoordinator
-	marshalizer  marshal.Marshalizer
-	isNilIndexer bool
+	database       databaseHandler
+	options        *Options
+	coordinator    sharding.NodesCoordinator
+	marshalizer    marshal.Marshalizer
+	storage        dataRetriever.StorageService
+	shardID        uint32
+	isNilIndexer   bool
+	saveBlockSlots chan struct{}
+	// saveBlockTasks tracks the go routines of the saved blocks, which are waited before reverting a block so that
+	//  their indexing tasks are enqueued before the revert
+	saveBlockTasks sync.WaitGroup
 }
 
 // NewElasticIndexer creates a new elasticIndexer where the server listens on the url, authentication for the server is
@@ -55,14 +118,60 @@ func NewElasticIndexer(arguments ElasticIndexerArgs) (Indexer, error) {
 		return nil, err
 	}
 
+	enabledMiniBlockTypes, err := parseMiniBlockTypes(arguments.Options.EnabledMiniBlockTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldNamingFunc, err := parseFieldNaming(arguments.Options.FieldNaming)
+	if err != nil {
+		return nil, err
+	}
+
 	databaseArguments := elasticSearchDatabaseArgs{
 		addressPubkeyConverter:   arguments.AddressPubkeyConverter,
 		validatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
-		url:                      arguments.Url,
+		urls:                     append([]string{arguments.Url}, arguments.FailoverUrls...),
 		userName:                 arguments.UserName,
 		password:                 arguments.Password,
+		metricsUrl:               arguments.MetricsUrl,
+		metricsUserName:          arguments.MetricsUserName,
+		metricsPassword:          arguments.MetricsPassword,
 		marshalizer:              arguments.Marshalizer,
 		hasher:                   arguments.Hasher,
+		enabledMiniBlockTypes:    enabledMiniBlockTypes,
+		indexedShards:            createIndexedShardsSet(arguments.Options.IndexedShards),
+		metachainIndexingOff:     arguments.Options.MetachainIndexingOff,
+		indexTemplatesPath:       arguments.Options.IndexTemplatesPath,
+		maxInFlightBulkRequests:  arguments.Options.MaxInFlightBulkRequests,
+		bulkFlushInterval:        time.Duration(arguments.Options.BulkFlushIntervalInSec) * time.Second,
+		bulkLogSamplingRate:      arguments.Options.BulkLogSamplingRate,
+		denomination:             arguments.Options.Denomination,
+		indicesSettings:          arguments.Options.IndicesSettings,
+		indexCreationMaxAttempts: arguments.Options.IndexCreationMaxAttempts,
+		indexCreationRetryDelay:  time.Duration(arguments.Options.IndexCreationRetryIntervalInSec) * time.Second,
+		bulkRequestMaxAttempts:   arguments.Options.BulkRequestMaxAttempts,
+		bulkRequestRetryDelay:    time.Duration(arguments.Options.BulkRequestRetryDelayInMillisecs) * time.Millisecond,
+		bulkRequestMaxSize:       arguments.Options.BulkRequestMaxSizeInBytes,
+		throttlingMaxRetries:     arguments.Options.ThrottlingMaxRetries,
+		throttlingRetryDelay:     time.Duration(arguments.Options.ThrottlingRetryDelayInMillisecs) * time.Millisecond,
+		maxIdleConnsPerHost:      arguments.Options.MaxIdleConnsPerHost,
+		maxConnsPerHost:          arguments.Options.MaxConnsPerHost,
+		useGzip:                  arguments.Options.UseGzip,
+		requestTimeout:           time.Duration(arguments.Options.RequestTimeoutInSec) * time.Second,
+		storeTxData:              !arguments.Options.TxDataIndexingOff,
+		maxDataBytes:             arguments.Options.MaxTxDataBytes,
+		fieldNamingFunc:          fieldNamingFunc,
+		feeHandler:               arguments.FeeHandler,
+		appStatusHandler:         arguments.AppStatusHandler,
+		indexingQueueSize:        arguments.Options.IndexingQueueSize,
+		indexingWorkers:          arguments.Options.IndexingWorkers,
+	}
+	if arguments.Options.ResolveRoundConsensusGroup {
+		databaseArguments.nodesCoordinator = arguments.NodesCoordinator
+	}
+	if arguments.Options.RouteDocumentsByShard {
+		databaseArguments.routingFunc = routeByShard
 	}
 	client, err := newElasticSearchDatabase(databaseArguments)
 	if err != nil {
@@ -70,11 +179,14 @@ func NewElasticIndexer(arguments ElasticIndexerArgs) (Indexer, error) {
 	}
 
 	indexer := &elasticIndexer{
-		database:     client,
-		options:      arguments.Options,
-		coordinator:  arguments.NodesCoordinator,
-		marshalizer:  arguments.Marshalizer,
-		isNilIndexer: false,
+		database:       client,
+		options:        arguments.Options,
+		coordinator:    arguments.NodesCoordinator,
+		marshalizer:    arguments.Marshalizer,
+		storage:        arguments.Storage,
+		shardID:        arguments.ShardId,
+		isNilIndexer:   false,
+		saveBlockSlots: createBulkRequestsSlots(arguments.Options.MaxInFlightBulkRequests),
 	}
 
 	if arguments.ShardId == core.MetachainShardId {
@@ -104,17 +216,85 @@ func (ei *elasticIndexer) SaveBlock(
 	}
 
 	txsSizeInBytes := computeSizeOfTxs(ei.marshalizer, txPool)
-	go ei.database.SaveHeader(headerHandler, signersIndexes, body, notarizedHeadersHashes, txsSizeInBytes)
 
-	if len(body.MiniBlocks) == 0 {
+	// the slot is acquired on the caller's go routine, so that the block processing is blocked while the maximum
+	// number of blocks are being indexed, and it is released after all the data of the block was sent
+	ei.acquireSaveBlockSlot()
+	wg := &sync.WaitGroup{}
+	ei.runSaveBlockTask(wg, func() {
+		ei.database.SaveHeader(headerHandler, signersIndexes, body, notarizedHeadersHashes, txsSizeInBytes)
+	})
+
+	if len(body.MiniBlocks) > 0 {
+		ei.runSaveBlockTask(wg, func() {
+			ei.database.SaveMiniblocks(headerHandler, body)
+		})
+
+		if ei.options.TxIndexingEnabled {
+			ei.runSaveBlockTask(wg, func() {
+				ei.database.SaveTransactions(body, headerHandler, txPool, headerHandler.GetShardID())
+			})
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		ei.releaseSaveBlockSlot()
+	}()
+}
+
+func (ei *elasticIndexer) runSaveBlockTask(wg *sync.WaitGroup, task func()) {
+	wg.Add(1)
+	ei.saveBlockTasks.Add(1)
+	go func() {
+		task()
+		wg.Done()
+		ei.saveBlockTasks.Done()
+	}()
+}
+
+func (ei *elasticIndexer) acquireSaveBlockSlot() {
+	if ei.saveBlockSlots != nil {
+		ei.saveBlockSlots <- struct{}{}
+	}
+}
+
+func (ei *elasticIndexer) releaseSaveBlockSlot() {
+	if ei.saveBlockSlots != nil {
+		<-ei.saveBlockSlots
+	}
+}
+
+// RevertIndexedBlock removes the documents of a block which was rolled back, together with the documents of the
+//  transactions it sent. The removal waits for the previously saved blocks to enqueue their indexing tasks and is
+//  ordered after them on the indexing queue, so that a pending save does not create the removed documents again. It
+//  should be called from the go routine which saves the blocks
+func (ei *elasticIndexer) RevertIndexedBlock(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) {
+	if check.IfNil(headerHandler) {
+		log.Debug("indexer: no header", "error", ErrNoHeader.Error())
 		return
 	}
 
-	go ei.database.SaveMiniblocks(headerHandler, body)
+	body, ok := bodyHandler.(*block.Body)
+	if !ok {
+		log.Debug("indexer", "error", ErrBodyTypeAssertion.Error())
+		return
+	}
 
+	ei.saveBlockTasks.Wait()
+	ei.database.RemoveHeader(headerHandler)
 	if ei.options.TxIndexingEnabled {
-		go ei.database.SaveTransactions(body, headerHandler, txPool, headerHandler.GetShardID())
+		ei.database.RemoveTransactions(body, headerHandler)
+	}
+}
+
+// SaveLogs will save the logs generated by the smart contracts, keyed by the hashes of their transactions
+func (ei *elasticIndexer) SaveLogs(logs map[string]data.LogHandler) {
+	if !ei.options.TxIndexingEnabled {
+		return
 	}
+
+	ei.database.SaveLogs(logs)
 }
 
 // SaveRoundInfo will save data about a round on elastic search
@@ -122,6 +302,59 @@ func (ei *elasticIndexer) SaveRoundInfo(roundInfo RoundInfo) {
 	ei.database.SaveRoundInfo(roundInfo)
 }
 
+// SaveRoundsInfo will save data about multiple rounds on elastic search using bulk requests
+func (ei *elasticIndexer) SaveRoundsInfo(roundsInfos []RoundInfo) {
+	if len(roundsInfos) == 0 {
+		return
+	}
+
+	ei.database.SaveRoundsInfo(roundsInfos)
+}
+
+// ReindexBlock loads from the node storage the header with the provided hash together with its miniblocks and
+//  transactions and saves them again on elastic search. The documents are identified by their hashes so the
+//  previously indexed data of the block is overwritten
+func (ei *elasticIndexer) ReindexBlock(headerHash []byte) error {
+	if check.IfNil(ei.storage) {
+		return ErrNilStorageService
+	}
+
+	header, err := ei.getHeaderFromStorage(headerHash)
+	if err != nil {
+		return err
+	}
+
+	body, err := ei.getBodyFromStorage(header)
+	if err != nil {
+		return err
+	}
+
+	txPool, err := ei.getTransactionsFromStorage(body)
+	if err != nil {
+		return err
+	}
+
+	signersIndexes, err := ei.getSignersIndexes(header)
+	if err != nil {
+		return err
+	}
+
+	txsSizeInBytes := computeSizeOfTxs(ei.marshalizer, txPool)
+	ei.database.SaveHeader(header, signersIndexes, body, getNotarizedHeadersHashes(header), txsSizeInBytes)
+
+	if len(body.MiniBlocks) == 0 {
+		return nil
+	}
+
+	ei.database.SaveMiniblocks(header, body)
+
+	if ei.options.TxIndexingEnabled {
+		ei.database.SaveTransactions(body, header, txPool, header.GetShardID())
+	}
+
+	return nil
+}
+
 func (ei *elasticIndexer) epochStartEventHandler() epochStart.ActionHandler {
 	subscribeHandler := notifier.NewHandlerForEpochStart(func(hdr data.HeaderHandler) {
 		currentEpoch := hdr.GetEpoch()
@@ -146,6 +379,46 @@ func (ei *elasticIndexer) SaveValidatorsRating(indexID string, validatorsRatingI
 	}
 }
 
+// SaveValidatorsRatingHistory will send the rating of each validator at the given epoch to elasticsearch. The
+//  validators are keyed by their raw public keys
+func (ei *elasticIndexer) SaveValidatorsRatingHistory(epoch uint32, validators map[string]*state.ValidatorApiResponse) {
+	if len(validators) == 0 {
+		return
+	}
+
+	ei.database.SaveValidatorsRatingHistory(epoch, validators)
+}
+
+// SaveAccountsHistory will send the balance changes of the accounts touched in a block to elasticsearch
+func (ei *elasticIndexer) SaveAccountsHistory(blockNonce uint64, changes []AccountBalanceChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	ei.database.SaveAccountsHistory(blockNonce, changes)
+}
+
+// SaveEpochStartEconomics will send the economics computed at the start of an epoch to elasticsearch
+func (ei *elasticIndexer) SaveEpochStartEconomics(epoch uint32, econ EpochEconomics) {
+	ei.database.SaveEpochInfo(epoch, econ)
+}
+
+// SaveEpochStartInfo will send the shards finalization information committed by an epoch start metablock to
+//  elasticsearch
+func (ei *elasticIndexer) SaveEpochStartInfo(metaBlock *block.MetaBlock) {
+	if metaBlock == nil || !metaBlock.IsStartOfEpochBlock() {
+		return
+	}
+
+	ei.database.SaveEpochStartInfo(metaBlock)
+}
+
+// VerifyContiguity returns the nonces from the inclusive [fromNonce, toNonce] range for which no block of the provided
+//  shard was indexed, so that the gaps can be detected and re-indexed
+func (ei *elasticIndexer) VerifyContiguity(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	return ei.database.VerifyContiguity(fromNonce, toNonce, shardID)
+}
+
 //SaveValidatorsPubKeys will send all validators public keys to elasticsearch
 func (ei *elasticIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
 	for shardID, shardPubKeys := range validatorsPubKeys {
@@ -170,6 +443,16 @@ func (ei *elasticIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogPr
 	ei.database.SetTxLogsProcessor(txLogsProc)
 }
 
+// CheckHealth verifies that the elasticsearch server can be reached and that all the indexes exist
+func (ei *elasticIndexer) CheckHealth() error {
+	return ei.database.CheckHealth()
+}
+
+// Close waits for the asynchronous indexing requests to complete and stops the indexing go routines
+func (ei *elasticIndexer) Close() error {
+	return ei.database.Close()
+}
+
 // IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
 func (ei *elasticIndexer) IsNilIndexer() bool {
 	return ei.isNilIndexer
@@ -4,111 +4,384 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
+	"io"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
 
 // elasticSearchDatabaseArgs is struct that is used to store all parameters that are needed to create a elasticsearch database
 type elasticSearchDatabaseArgs struct {
-	url                      string
+	urls                     []string
 	userName                 string
 	password                 string
+	metricsUrl               string
+	metricsUserName          string
+	metricsPassword          string
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	addressPubkeyConverter   core.PubkeyConverter
 	validatorPubkeyConverter core.PubkeyConverter
+	enabledMiniBlockTypes    map[block.Type]struct{}
+	indexedShards            map[uint32]struct{}
+	metachainIndexingOff     bool
+	indexTemplatesPath       string
+	maxInFlightBulkRequests  uint32
+	bulkFlushInterval        time.Duration
+	bulkLogSamplingRate      uint32
+	denomination             int
+	indicesSettings          map[string]IndexSettings
+	indexCreationMaxAttempts uint32
+	indexCreationRetryDelay  time.Duration
+	bulkRequestMaxAttempts   uint32
+	bulkRequestRetryDelay    time.Duration
+	bulkRequestMaxSize       uint32
+	throttlingMaxRetries     uint32
+	throttlingRetryDelay     time.Duration
+	maxIdleConnsPerHost      int
+	maxConnsPerHost          int
+	useGzip                  bool
+	requestTimeout           time.Duration
+	nodesCoordinator         sharding.NodesCoordinator
+	routingFunc              func(shardID uint32) string
+	storeTxData              bool
+	maxDataBytes             uint32
+	fieldNamingFunc          func(fieldName string) string
+	feeHandler               process.FeeHandler
+	indexingQueueSize        uint32
+	indexingWorkers          uint32
+	appStatusHandler         core.AppStatusHandler
 }
 
 // elasticSearchDatabase object it contains business logic built over databaseWriterHandler glue code wrapper
 type elasticSearchDatabase struct {
 	*txDatabaseProcessor
-	dbWriter    databaseWriterHandler
-	marshalizer marshal.Marshalizer
-	hasher      hashing.Hasher
+	dbWriter              databaseWriterHandler
+	marshalizer           marshal.Marshalizer
+	hasher                hashing.Hasher
+	enabledMiniBlockTypes map[block.Type]struct{}
+	indexedShards         map[uint32]struct{}
+	metachainIndexingOff  bool
+	bulkRequestsSlots     chan struct{}
+	bulkLogSampler        *bulkLogSampler
+	mutTxSubscribers      sync.RWMutex
+	txSubscribers         []*txSubscriber
+	nodesCoordinator      sharding.NodesCoordinator
+	bufferedWriter        *bufferedBulkWriter
+	indexingQueue         *indexingQueue
+	appStatusHandler      core.AppStatusHandler
+	numBulkErrors         atomic.Counter
+	numTxsIndexed         atomic.Counter
+
+	indexCreationMaxAttempts uint32
+	indexCreationRetryDelay  time.Duration
+	bulkRequestMaxAttempts   uint32
+	bulkRequestRetryDelay    time.Duration
+	bulkRequestMaxSize       uint32
+	routingFunc              func(shardID uint32) string
+	fieldNamingFunc          func(fieldName string) string
 }
 
 // newElasticSearchDatabase is method that will create a new elastic search dbWriter
 func newElasticSearchDatabase(arguments elasticSearchDatabaseArgs) (*elasticSearchDatabase, error) {
 	cfg := elasticsearch.Config{
-		Addresses: []string{arguments.url},
+		Addresses: arguments.urls,
 		Username:  arguments.userName,
 		Password:  arguments.password,
+		Transport: createTransport(arguments.maxIdleConnsPerHost, arguments.maxConnsPerHost, arguments.requestTimeout),
 	}
-	es, err := newDatabaseWriter(cfg)
+	primaryWriter, err := newDatabaseWriter(cfg, arguments.useGzip, arguments.requestTimeout)
 	if err != nil {
 		return nil, err
 	}
 
+	var es databaseWriterHandler = newThrottledWriter(primaryWriter, arguments.throttlingMaxRetries, arguments.throttlingRetryDelay)
+
+	if arguments.metricsUrl != "" {
+		metricsCfg := elasticsearch.Config{
+			Addresses: []string{arguments.metricsUrl},
+			Username:  arguments.metricsUserName,
+			Password:  arguments.metricsPassword,
+			Transport: createTransport(arguments.maxIdleConnsPerHost, arguments.maxConnsPerHost, arguments.requestTimeout),
+		}
+		metricsWriter, errMetrics := newDatabaseWriter(metricsCfg, arguments.useGzip, arguments.requestTimeout)
+		if errMetrics != nil {
+			return nil, fmt.Errorf("%w for the metrics cluster", errMetrics)
+		}
+
+		throttledMetricsWriter := newThrottledWriter(metricsWriter, arguments.throttlingMaxRetries, arguments.throttlingRetryDelay)
+		es = newMetricsRoutingWriter(es, throttledMetricsWriter)
+	}
+
 	esdb := &elasticSearchDatabase{
-		dbWriter:    es,
-		marshalizer: arguments.marshalizer,
-		hasher:      arguments.hasher,
+		marshalizer:           arguments.marshalizer,
+		hasher:                arguments.hasher,
+		enabledMiniBlockTypes: arguments.enabledMiniBlockTypes,
+		indexedShards:         arguments.indexedShards,
+		metachainIndexingOff:  arguments.metachainIndexingOff,
+		bulkRequestsSlots:     createBulkRequestsSlots(arguments.maxInFlightBulkRequests),
+		bulkLogSampler:        newBulkLogSampler(arguments.bulkLogSamplingRate),
+		nodesCoordinator:      arguments.nodesCoordinator,
+		appStatusHandler:      arguments.appStatusHandler,
+
+		indexCreationMaxAttempts: arguments.indexCreationMaxAttempts,
+		indexCreationRetryDelay:  arguments.indexCreationRetryDelay,
+		bulkRequestMaxAttempts:   arguments.bulkRequestMaxAttempts,
+		bulkRequestRetryDelay:    arguments.bulkRequestRetryDelay,
+		bulkRequestMaxSize:       arguments.bulkRequestMaxSize,
+		routingFunc:              arguments.routingFunc,
+		fieldNamingFunc:          arguments.fieldNamingFunc,
+	}
+	esdb.dbWriter = es
+	if arguments.bulkFlushInterval > 0 {
+		esdb.bufferBulkRequests(es, arguments.bulkFlushInterval)
 	}
+
 	esdb.txDatabaseProcessor = newTxDatabaseProcessor(
 		arguments.hasher,
 		arguments.marshalizer,
 		arguments.addressPubkeyConverter,
 		arguments.validatorPubkeyConverter,
+		arguments.denomination,
 	)
+	esdb.txDatabaseProcessor.storeTxData = arguments.storeTxData
+	esdb.txDatabaseProcessor.maxDataBytes = arguments.maxDataBytes
+	esdb.txDatabaseProcessor.feeHandler = arguments.feeHandler
+	if check.IfNil(esdb.appStatusHandler) {
+		esdb.appStatusHandler = statusHandler.NewNilStatusHandler()
+	}
 
-	err = esdb.createIndexes()
+	err = esdb.createIndexes(arguments.indexTemplatesPath, arguments.indicesSettings)
 	if err != nil {
 		return nil, err
 	}
 
+	esdb.indexingQueue = newIndexingQueue(arguments.indexingQueueSize, arguments.indexingWorkers)
+
 	return esdb, nil
 }
 
-func (esd *elasticSearchDatabase) createIndexes() error {
-	err := esd.dbWriter.CheckAndCreateIndex(blockIndex, timestampMapping())
+func (esd *elasticSearchDatabase) createIndexes(templatesPath string, indicesSettings map[string]IndexSettings) error {
+	indexes := getIndexesToCreate()
+	templates, err := loadIndexTemplates(templatesPath, indexes)
 	if err != nil {
 		return err
 	}
 
-	err = esd.dbWriter.CheckAndCreateIndex(txIndex, timestampMapping())
-	if err != nil {
-		return err
+	for _, index := range indexes {
+		settings, ok := indicesSettings[index]
+		if ok {
+			templates[index], err = applyIndexSettings(templates[index], settings)
+			if err != nil {
+				return fmt.Errorf("%w for index %s", err, index)
+			}
+		}
+
+		err = esd.checkAndCreateIndexWithRetry(index, templates[index])
+		if err != nil {
+			return err
+		}
 	}
 
-	err = esd.dbWriter.CheckAndCreateIndex(tpsIndex, nil)
-	if err != nil {
-		return err
+	return nil
+}
+
+// checkAndCreateIndexWithRetry tries to check and create the index up to the configured number of attempts, doubling
+//  the waiting time after each failure. The error of the last attempt is returned if all of them failed
+func (esd *elasticSearchDatabase) checkAndCreateIndexWithRetry(index string, template []byte) error {
+	retryDelay := esd.indexCreationRetryDelay
+	for attempt := uint32(1); ; attempt++ {
+		var body io.Reader
+		if len(template) > 0 {
+			body = bytes.NewReader(template)
+		}
+
+		err := esd.dbWriter.CheckAndCreateIndex(index, body)
+		if err == nil || attempt >= esd.indexCreationMaxAttempts {
+			return err
+		}
+
+		log.Warn("indexer: could not check or create index, will retry",
+			"index", index,
+			"attempt", attempt,
+			"retry in", retryDelay,
+			"error", err.Error())
+
+		time.Sleep(retryDelay)
+		retryDelay *= 2
 	}
+}
 
-	err = esd.dbWriter.CheckAndCreateIndex(validatorsIndex, nil)
+// CheckHealth verifies that the elasticsearch server can be reached and that all the required indexes exist.
+//  It will not write anything on the server
+func (esd *elasticSearchDatabase) CheckHealth() error {
+	err := esd.dbWriter.DoPingRequest()
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %s", ErrElasticSearchUnreachable, err.Error())
 	}
 
-	err = esd.dbWriter.CheckAndCreateIndex(roundIndex, timestampMapping())
-	if err != nil {
-		return err
+	for _, index := range getIndexesToCreate() {
+		exists, errExists := esd.dbWriter.DoIndexExistsRequest(index)
+		if errExists != nil {
+			return errExists
+		}
+		if !exists {
+			return fmt.Errorf("%w: %s", ErrMissingIndex, index)
+		}
 	}
 
-	err = esd.dbWriter.CheckAndCreateIndex(ratingIndex, nil)
-	if err != nil {
-		return err
+	return nil
+}
+
+func getIndexesToCreate() []string {
+	return []string{blockIndex, txIndex, tpsIndex, validatorsIndex, roundIndex, ratingIndex, ratingHistoryIndex, miniblocksIndex,
+		accountsHistoryIndex, epochInfoIndex, scResultsIndex, logsIndex}
+}
+
+// createBulkRequestsSlots returns the semaphore used to bound the number of bulk requests in flight.
+//  A zero value for maxInFlightBulkRequests will not limit the bulk requests
+func createBulkRequestsSlots(maxInFlightBulkRequests uint32) chan struct{} {
+	if maxInFlightBulkRequests == 0 {
+		return nil
 	}
 
-	err = esd.dbWriter.CheckAndCreateIndex(miniblocksIndex, nil)
-	if err != nil {
-		return err
+	return make(chan struct{}, maxInFlightBulkRequests)
+}
+
+// doBulkRequest will block the caller while the maximum number of bulk requests are in flight, applying backpressure
+//  when the elasticsearch server ingests data slower than it is produced
+func (esd *elasticSearchDatabase) doBulkRequest(buff *bytes.Buffer, index string) error {
+	if esd.bulkRequestsSlots != nil {
+		esd.bulkRequestsSlots <- struct{}{}
+		defer func() {
+			<-esd.bulkRequestsSlots
+		}()
+	}
+
+	return esd.dbWriter.DoBulkRequest(buff, index)
+}
+
+// runIndexingTask runs the task on the indexing queue workers, if the queue is enabled, or on the caller's go routine
+func (esd *elasticSearchDatabase) runIndexingTask(task func()) {
+	if esd.indexingQueue == nil {
+		task()
+		return
+	}
+
+	esd.indexingQueue.enqueue(task)
+}
+
+// runOrderedIndexingTask runs the task, if the queue is enabled, after the previously enqueued indexing tasks
+//  completed and before the ones enqueued afterwards start, so that it does not race with the indexing of the same
+//  documents. The task is run on the caller's go routine if the queue is disabled
+func (esd *elasticSearchDatabase) runOrderedIndexingTask(task func()) {
+	if esd.indexingQueue == nil {
+		task()
+		return
+	}
+
+	esd.indexingQueue.enqueueOrdered(task)
+}
+
+// Close waits for the enqueued indexing tasks to complete and sends the buffered bulks, if any, before stopping the
+//  indexing go routines
+func (esd *elasticSearchDatabase) Close() error {
+	if esd.indexingQueue != nil {
+		esd.indexingQueue.close()
+	}
+	if esd.bufferedWriter != nil {
+		esd.bufferedWriter.close()
 	}
 
 	return nil
 }
 
+// bufferBulkRequests makes the bulk requests be buffered before being sent, with retries, on the provided writer
+func (esd *elasticSearchDatabase) bufferBulkRequests(writer databaseWriterHandler, flushInterval time.Duration) {
+	sendBufferedBulk := func(buff *bytes.Buffer, index string) error {
+		return esd.retryBulkRequest(writer.DoBulkRequest, buff, index)
+	}
+
+	esd.bufferedWriter = newBufferedBulkWriter(writer, sendBufferedBulk, flushInterval, esd.bulkRequestMaxSize)
+	esd.dbWriter = esd.bufferedWriter
+}
+
+// doBulkRequestWithRetry sends the bulk request with retries. When the bulks are buffered, the data is only appended
+//  to the buffer and the retries are done by the buffered writer, once the buffered bulk is sent
+func (esd *elasticSearchDatabase) doBulkRequestWithRetry(buff *bytes.Buffer, index string) error {
+	if esd.bufferedWriter != nil {
+		return esd.doBulkRequest(buff, index)
+	}
+
+	return esd.retryBulkRequest(esd.doBulkRequest, buff, index)
+}
+
+// retryBulkRequest sends the bulk request up to the configured number of attempts, doubling the waiting time after
+//  each failure. The bulk requests rejected as malformed are not retried, as they would fail again, and neither are the
+//  throttled ones, which were already resent by the throttled writer. The error of the last attempt is returned if all
+//  of them failed
+func (esd *elasticSearchDatabase) retryBulkRequest(
+	sendBulk func(buff *bytes.Buffer, index string) error,
+	buff *bytes.Buffer,
+	index string,
+) error {
+	retryDelay := esd.bulkRequestRetryDelay
+	for attempt := uint32(1); ; attempt++ {
+		err := sendBulk(buff, index)
+		if err == nil {
+			if attempt > 1 {
+				log.Debug("indexer: bulk request succeeded after retry",
+					"index", index,
+					"attempt", attempt)
+			}
+			return nil
+		}
+		isRetryable := !errors.Is(err, ErrBulkRequestRejected) && !errors.Is(err, ErrTooManyRequests)
+		if !isRetryable || attempt >= esd.bulkRequestMaxAttempts {
+			return err
+		}
+
+		log.Debug("indexer: bulk request failed, will retry",
+			"index", index,
+			"attempt", attempt,
+			"retry in", retryDelay,
+			"error", err.Error())
+
+		time.Sleep(retryDelay)
+		retryDelay *= 2
+	}
+}
+
+// doMeasuredBulkRequest sends the bulk request, with retries, and updates the indexing metrics of the app status handler
+func (esd *elasticSearchDatabase) doMeasuredBulkRequest(buff *bytes.Buffer, index string) error {
+	startTime := time.Now()
+	err := esd.doBulkRequestWithRetry(buff, index)
+	esd.appStatusHandler.SetUInt64Value(core.MetricIndexerBulkDurationMs, uint64(time.Since(startTime).Milliseconds()))
+	if err != nil {
+		numBulkErrors := esd.numBulkErrors.Increment()
+		esd.appStatusHandler.SetUInt64Value(core.MetricIndexerBulkErrorsTotal, uint64(numBulkErrors))
+	}
+
+	return err
+}
+
 // SaveHeader will prepare and save information about a header in elasticsearch server
 func (esd *elasticSearchDatabase) SaveHeader(
 	header data.HeaderHandler,
@@ -117,9 +390,42 @@ func (esd *elasticSearchDatabase) SaveHeader(
 	notarizedHeadersHashes []string,
 	txsSize int,
 ) {
+	if !esd.isShardIndexed(header.GetShardID()) {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(esd.marshalizer, esd.hasher, header)
+	if err != nil {
+		log.Debug("indexer: compute header hash", "error", err)
+		return
+	}
+
+	var miniBlocksHashes [][]byte
+	if body != nil {
+		miniBlocksHashes = esd.computeMiniblocksHashes(body)
+	}
+
+	esd.SaveHeaderWithHashes(header, signersIndexes, body, miniBlocksHashes, notarizedHeadersHashes, headerHash, txsSize)
+}
+
+// SaveHeaderWithHashes will save information about a header in elasticsearch server using the provided header and
+//  miniblocks hashes, already computed by the caller, instead of computing them again
+func (esd *elasticSearchDatabase) SaveHeaderWithHashes(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	miniBlocksHashes [][]byte,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+	txsSize int,
+) {
+	if !esd.isShardIndexed(header.GetShardID()) {
+		return
+	}
+
 	var buff bytes.Buffer
 
-	serializedBlock, headerHash := esd.getSerializedElasticBlockAndHeaderHash(header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	serializedBlock := esd.getSerializedElasticBlock(header, signersIndexes, body, miniBlocksHashes, notarizedHeadersHashes, headerHash, txsSize)
 
 	buff.Grow(len(serializedBlock))
 	_, err := buff.Write(serializedBlock)
@@ -134,59 +440,94 @@ func (esd *elasticSearchDatabase) SaveHeader(
 		Refresh:    "true",
 	}
 
-	err = esd.dbWriter.DoRequest(req)
+	esd.runIndexingTask(func() {
+		errRequest := esd.dbWriter.DoRequest(req)
+		if errRequest != nil {
+			log.Warn("indexer: could not index block header",
+				"error", errRequest.Error(),
+				"index", blockIndex,
+				"nonce", header.GetNonce(),
+				"shardID", header.GetShardID(),
+				"numDocs", 1)
+		}
+	})
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if ok && metaBlock.IsStartOfEpochBlock() {
+		esd.saveEpochStartBlockInfo(metaBlock)
+	}
+}
+
+func (esd *elasticSearchDatabase) saveEpochStartBlockInfo(metaBlock *block.MetaBlock) {
+	epochStartBlockInfo := prepareEpochStartBlockInfo(metaBlock)
+
+	buff, err := serializeEpochStartBlockInfo(epochStartBlockInfo)
 	if err != nil {
-		log.Warn("indexer: could not index block header", "error", err.Error())
+		log.Debug("indexer: marshal", "error", "could not serialize epoch start block info", "epoch", epochStartBlockInfo.Epoch)
 		return
 	}
+
+	esd.runIndexingTask(func() {
+		errRequest := esd.doBulkRequest(&buff, epochInfoIndex)
+		if errRequest != nil {
+			log.Warn("indexer: can not index epoch start block info",
+				"error", errRequest.Error(),
+				"index", epochInfoIndex,
+				"epoch", epochStartBlockInfo.Epoch,
+				"round", epochStartBlockInfo.Round,
+				"numDocs", 1)
+		}
+	})
 }
 
-func (esd *elasticSearchDatabase) getSerializedElasticBlockAndHeaderHash(
+func (esd *elasticSearchDatabase) getSerializedElasticBlock(
 	header data.HeaderHandler,
 	signersIndexes []uint64,
 	body *block.Body,
+	miniBlocksHashes [][]byte,
 	notarizedHeadersHashes []string,
+	headerHash []byte,
 	sizeTxs int,
-) ([]byte, []byte) {
+) []byte {
 	headerBytes, err := esd.marshalizer.Marshal(header)
 	if err != nil {
 		log.Debug("indexer: marshal header", "error", err)
-		return nil, nil
-	}
-	bodyBytes, err := esd.marshalizer.Marshal(body)
-	if err != nil {
-		log.Debug("indexer: marshal body", "error", err)
-		return nil, nil
+		return nil
 	}
 
-	blockSizeInBytes := len(headerBytes) + len(bodyBytes)
-
-	miniblocksHashes := make([]string, 0)
-	for _, miniblock := range body.MiniBlocks {
-		mbHash, errComputeHash := core.CalculateHash(esd.marshalizer, esd.hasher, miniblock)
-		if errComputeHash != nil {
-			log.Warn("internal error computing hash", "error", errComputeHash)
-
-			continue
+	isHeaderSizeOnly := body == nil
+	blockSizeInBytes := len(headerBytes)
+	encodedMiniBlocksHashes := make([]string, 0, len(miniBlocksHashes))
+	var miniBlocksInfo []MiniBlockInfo
+	if !isHeaderSizeOnly {
+		bodyBytes, errMarshal := esd.marshalizer.Marshal(body)
+		if errMarshal != nil {
+			log.Debug("indexer: marshal body", "error", errMarshal)
+			return nil
 		}
 
-		encodedMbHash := hex.EncodeToString(mbHash)
-		miniblocksHashes = append(miniblocksHashes, encodedMbHash)
+		blockSizeInBytes += len(bodyBytes)
+		for _, mbHash := range miniBlocksHashes {
+			encodedMiniBlocksHashes = append(encodedMiniBlocksHashes, hex.EncodeToString(mbHash))
+		}
+		miniBlocksInfo = prepareMiniBlocksInfo(header, body, miniBlocksHashes)
 	}
 
-	headerHash := esd.hasher.Compute(string(headerBytes))
 	elasticBlock := Block{
 		Nonce:                 header.GetNonce(),
 		Round:                 header.GetRound(),
 		Epoch:                 header.GetEpoch(),
 		ShardID:               header.GetShardID(),
 		Hash:                  hex.EncodeToString(headerHash),
-		MiniBlocksHashes:      miniblocksHashes,
+		MiniBlocksHashes:      encodedMiniBlocksHashes,
+		MiniBlocks:            miniBlocksInfo,
 		NotarizedBlocksHashes: notarizedHeadersHashes,
 		Proposer:              signersIndexes[0],
+		ProposerPubKey:        esd.resolveBlockProposer(header),
 		Validators:            signersIndexes,
 		PubKeyBitmap:          hex.EncodeToString(header.GetPubKeysBitmap()),
 		Size:                  int64(blockSizeInBytes),
+		IsHeaderSizeOnly:      isHeaderSizeOnly,
 		SizeTxs:               int64(sizeTxs),
 		Timestamp:             time.Duration(header.GetTimeStamp()),
 		TxCount:               header.GetTxCount(),
@@ -194,13 +535,59 @@ func (esd *elasticSearchDatabase) getSerializedElasticBlockAndHeaderHash(
 		PrevHash:              hex.EncodeToString(header.GetPrevHash()),
 	}
 
-	serializedBlock, err := json.Marshal(elasticBlock)
+	serializedBlock, err := marshalDocument(elasticBlock, esd.fieldNamingFunc)
 	if err != nil {
 		log.Debug("indexer: marshal", "error", "could not marshal elastic header")
-		return nil, nil
+		return nil
+	}
+
+	return serializedBlock
+}
+
+func (esd *elasticSearchDatabase) computeMiniblocksHashes(body *block.Body) [][]byte {
+	miniblocksHashes := make([][]byte, 0, len(body.MiniBlocks))
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, errComputeHash := core.CalculateHash(esd.marshalizer, esd.hasher, miniblock)
+		if errComputeHash != nil {
+			log.Warn("internal error computing hash", "error", errComputeHash)
+
+			continue
+		}
+
+		miniblocksHashes = append(miniblocksHashes, mbHash)
+	}
+
+	return miniblocksHashes
+}
+
+// prepareMiniBlocksInfo pairs each miniblock hash with the miniblock type, keeping the order of the hashes. The type
+//  is taken from the body when the hashes were computed for all its miniblocks, otherwise it is looked up in the
+//  miniblock headers of the header
+func prepareMiniBlocksInfo(header data.HeaderHandler, body *block.Body, miniBlocksHashes [][]byte) []MiniBlockInfo {
+	miniBlocksInfo := make([]MiniBlockInfo, 0, len(miniBlocksHashes))
+	if len(body.MiniBlocks) == len(miniBlocksHashes) {
+		for i, mbHash := range miniBlocksHashes {
+			miniBlocksInfo = append(miniBlocksInfo, MiniBlockInfo{
+				Hash: hex.EncodeToString(mbHash),
+				Type: body.MiniBlocks[i].Type.String(),
+			})
+		}
+
+		return miniBlocksInfo
+	}
+
+	typesByHash := make(map[string]string)
+	for _, mbHeader := range getMiniBlockHeaders(header) {
+		typesByHash[string(mbHeader.Hash)] = mbHeader.Type.String()
+	}
+	for _, mbHash := range miniBlocksHashes {
+		miniBlocksInfo = append(miniBlocksInfo, MiniBlockInfo{
+			Hash: hex.EncodeToString(mbHash),
+			Type: typesByHash[string(mbHash)],
+		})
 	}
 
-	return serializedBlock, headerHash
+	return miniBlocksInfo
 }
 
 //SaveTransactions will prepare and save information about a transactions in elasticsearch server
@@ -210,18 +597,246 @@ func (esd *elasticSearchDatabase) SaveTransactions(
 	txPool map[string]data.TransactionHandler,
 	selfShardID uint32,
 ) {
-	bulks := esd.buildTransactionBulks(body, header, txPool, selfShardID)
-	for _, bulk := range bulks {
-		buff := serializeBulkTxs(bulk, selfShardID)
+	if !esd.isShardIndexed(header.GetShardID()) {
+		return
+	}
+
+	body = esd.filterEnabledMiniBlocks(body)
+	txs := esd.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	for _, bulk := range esd.serializeTransactionsBulks(txs, selfShardID) {
+		if bulk.buff.Len() == 0 {
+			continue
+		}
+
+		currentBulk := bulk
+		esd.runIndexingTask(func() {
+			esd.saveTransactionsBulk(&currentBulk.buff, currentBulk.txs, header)
+		})
+	}
+
+	esd.saveScResults(body, header, txPool)
+}
+
+func (esd *elasticSearchDatabase) saveTransactionsBulk(buff *bytes.Buffer, bulk []*Transaction, header data.HeaderHandler) {
+	sizeInBytes := buff.Len()
+	err := esd.doMeasuredBulkRequest(buff, txIndex)
+	if err != nil {
+		log.Warn("indexer: error indexing bulk of transactions",
+			"error", err.Error(),
+			"index", txIndex,
+			"nonce", header.GetNonce(),
+			"shardID", header.GetShardID(),
+			"numDocs", len(bulk))
+		return
+	}
+	if esd.bulkLogSampler.shouldLog() {
+		log.Trace("indexer: indexed bulk of transactions",
+			"index", txIndex,
+			"nonce", header.GetNonce(),
+			"shardID", header.GetShardID(),
+			"numDocs", len(bulk),
+			"sizeInBytes", sizeInBytes)
+	}
+
+	numTxsIndexed := esd.numTxsIndexed.Add(int64(len(bulk)))
+	esd.appStatusHandler.SetUInt64Value(core.MetricIndexerTxsIndexedTotal, uint64(numTxsIndexed))
+	esd.notifyTxSubscribers(bulk)
+}
+
+// saveScResults indexes the smart contract results of the block in their dedicated index. They are also kept in the
+//  documents of the transactions which generated them
+func (esd *elasticSearchDatabase) saveScResults(body *block.Body, header data.HeaderHandler, txPool map[string]data.TransactionHandler) {
+	scResults := esd.prepareScResultsForDatabase(body, header, txPool)
+	for start := 0; start < len(scResults); start += txBulkSize {
+		end := start + txBulkSize
+		if end > len(scResults) {
+			end = len(scResults)
+		}
+
+		buff := serializeScResults(scResults[start:end], esd.routingFunc, esd.fieldNamingFunc)
 		if buff.Len() == 0 {
 			continue
 		}
 
-		err := esd.dbWriter.DoBulkRequest(&buff, txIndex)
-		if err != nil {
-			log.Warn("indexer", "error", "indexing bulk of transactions")
+		numDocs := end - start
+		esd.runIndexingTask(func() {
+			err := esd.doBulkRequestWithRetry(&buff, scResultsIndex)
+			if err != nil {
+				log.Warn("indexer: error indexing bulk of smart contract results",
+					"error", err.Error(),
+					"index", scResultsIndex,
+					"nonce", header.GetNonce(),
+					"shardID", header.GetShardID(),
+					"numDocs", numDocs)
+			}
+		})
+	}
+}
+
+// SaveLogs will prepare and save the logs emitted by the smart contracts, keyed by the hashes of their transactions,
+//  in elasticsearch server. The bulks are sent on the indexing queue workers, if the queue is enabled
+func (esd *elasticSearchDatabase) SaveLogs(logs map[string]data.LogHandler) {
+	if len(logs) == 0 {
+		return
+	}
+
+	logDocuments := esd.prepareLogsForDatabase(logs)
+	for start := 0; start < len(logDocuments); start += txBulkSize {
+		end := start + txBulkSize
+		if end > len(logDocuments) {
+			end = len(logDocuments)
+		}
+
+		buff := serializeLogs(logDocuments[start:end], esd.fieldNamingFunc)
+		if buff.Len() == 0 {
 			continue
 		}
+
+		numDocs := end - start
+		esd.runIndexingTask(func() {
+			err := esd.doBulkRequestWithRetry(&buff, logsIndex)
+			if err != nil {
+				log.Warn("indexer: error indexing bulk of logs",
+					"error", err.Error(),
+					"index", logsIndex,
+					"numDocs", numDocs)
+			}
+		})
+	}
+}
+
+// RemoveHeader will remove the document of the provided header from elasticsearch server. It should be called when
+//  an indexed block is reverted. The removal is ordered after the indexing tasks already enqueued, so that a pending
+//  save of the header does not create the document again
+func (esd *elasticSearchDatabase) RemoveHeader(header data.HeaderHandler) {
+	if !esd.isShardIndexed(header.GetShardID()) {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(esd.marshalizer, esd.hasher, header)
+	if err != nil {
+		log.Debug("indexer: compute header hash", "error", err)
+		return
+	}
+
+	esd.runOrderedIndexingTask(func() {
+		errDelete := esd.dbWriter.DoDeleteRequest(blockIndex, []string{hex.EncodeToString(headerHash)})
+		if errDelete != nil {
+			log.Warn("indexer: could not remove block header",
+				"error", errDelete.Error(),
+				"index", blockIndex,
+				"nonce", header.GetNonce(),
+				"shardID", header.GetShardID())
+		}
+	})
+}
+
+// RemoveTransactions will remove from elasticsearch server the documents of the transactions contained in the
+//  miniblocks sent by the shard of the provided header. The documents of the received cross shard transactions were
+//  created by their source shard, so only their status is set back to pending. It should be called when an indexed
+//  block is reverted. The requests are ordered after the indexing tasks already enqueued, so that a pending save of
+//  the transactions does not create their documents again
+func (esd *elasticSearchDatabase) RemoveTransactions(body *block.Body, header data.HeaderHandler) {
+	if body == nil || !esd.isShardIndexed(header.GetShardID()) {
+		return
+	}
+
+	txHashes := make([]string, 0)
+	scResultsHashes := make([]string, 0)
+	receivedTxs := make([]*Transaction, 0)
+	for _, miniBlock := range body.MiniBlocks {
+		isReceivedMiniBlock := miniBlock.SenderShardID != header.GetShardID()
+		for _, txHash := range miniBlock.TxHashes {
+			encodedTxHash := hex.EncodeToString(txHash)
+			if isReceivedMiniBlock {
+				if miniBlock.Type == block.TxBlock || miniBlock.Type == block.RewardsBlock {
+					receivedTxs = append(receivedTxs, &Transaction{Hash: encodedTxHash, SenderShard: miniBlock.SenderShardID})
+				}
+				continue
+			}
+
+			txHashes = append(txHashes, encodedTxHash)
+			if miniBlock.Type == block.SmartContractResultBlock {
+				scResultsHashes = append(scResultsHashes, encodedTxHash)
+			}
+		}
+	}
+
+	esd.runOrderedIndexingTask(func() {
+		esd.removeDocuments(txIndex, txHashes, header)
+		esd.removeDocuments(scResultsIndex, scResultsHashes, header)
+		esd.removeDocuments(logsIndex, txHashes, header)
+		esd.revertTxsStatus(receivedTxs, header)
+	})
+}
+
+func (esd *elasticSearchDatabase) revertTxsStatus(txs []*Transaction, header data.HeaderHandler) {
+	for start := 0; start < len(txs); start += txBulkSize {
+		end := start + txBulkSize
+		if end > len(txs) {
+			end = len(txs)
+		}
+
+		buff := serializeTxsStatusUpdate(txs[start:end], txStatusPending, esd.routingFunc, esd.fieldNamingFunc)
+		err := esd.doBulkRequestWithRetry(&buff, txIndex)
+		if err != nil {
+			log.Warn("indexer: could not revert the status of the received transactions",
+				"error", err.Error(),
+				"index", txIndex,
+				"nonce", header.GetNonce(),
+				"shardID", header.GetShardID(),
+				"numDocs", end-start)
+		}
+	}
+}
+
+func (esd *elasticSearchDatabase) removeDocuments(index string, ids []string, header data.HeaderHandler) {
+	for start := 0; start < len(ids); start += txBulkSize {
+		end := start + txBulkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		err := esd.dbWriter.DoDeleteRequest(index, ids[start:end])
+		if err != nil {
+			log.Warn("indexer: could not remove documents",
+				"error", err.Error(),
+				"index", index,
+				"nonce", header.GetNonce(),
+				"shardID", header.GetShardID(),
+				"numDocs", end-start)
+		}
+	}
+}
+
+// RegisterTxSubscriber registers a handler that will be called, on a separate go routine, for each transaction
+//  right after it was successfully indexed. The provided transactions should be treated as read only
+func (esd *elasticSearchDatabase) RegisterTxSubscriber(handler func(tx *Transaction)) {
+	if handler == nil {
+		log.Error("indexer: attempt to register a nil tx subscriber")
+		return
+	}
+
+	esd.mutTxSubscribers.Lock()
+	esd.txSubscribers = append(esd.txSubscribers, newTxSubscriber(handler))
+	esd.mutTxSubscribers.Unlock()
+}
+
+func (esd *elasticSearchDatabase) notifyTxSubscribers(txs []*Transaction) {
+	esd.mutTxSubscribers.RLock()
+	defer esd.mutTxSubscribers.RUnlock()
+
+	numDropped := 0
+	for _, subscriber := range esd.txSubscribers {
+		for _, tx := range txs {
+			if !subscriber.notify(tx) {
+				numDropped++
+			}
+		}
+	}
+
+	if numDropped > 0 {
+		log.Debug("indexer: tx subscribers queues are full, transactions dropped", "num dropped", numDropped)
 	}
 }
 
@@ -230,16 +845,51 @@ func (esd *elasticSearchDatabase) SetTxLogsProcessor(txLogsProc process.Transact
 	esd.txLogsProcessor = txLogsProc
 }
 
+// serializedTxsBulk holds the transactions of a bulk request, together with their serialized bulk actions
+type serializedTxsBulk struct {
+	txs  []*Transaction
+	buff bytes.Buffer
+}
+
+// serializeTransactionsBulks splits the transactions in bulks of maximum bulkRequestMaxSize serialized bytes, or of
+//  maximum txBulkSize transactions if no size limit was set
+func (esd *elasticSearchDatabase) serializeTransactionsBulks(txs []*Transaction, selfShardID uint32) []*serializedTxsBulk {
+	if esd.bulkRequestMaxSize == 0 {
+		bulks := buildTransactionBulks(txs)
+		serializedBulks := make([]*serializedTxsBulk, 0, len(bulks))
+		for _, bulk := range bulks {
+			serializedBulks = append(serializedBulks, &serializedTxsBulk{
+				txs:  bulk,
+				buff: serializeBulkTxs(bulk, selfShardID, esd.routingFunc, esd.fieldNamingFunc),
+			})
+		}
+
+		return serializedBulks
+	}
+
+	serializedBulks := make([]*serializedTxsBulk, 0)
+	currentBulk := &serializedTxsBulk{}
+	for _, tx := range txs {
+		serializedTx := serializeBulkTxs([]*Transaction{tx}, selfShardID, esd.routingFunc, esd.fieldNamingFunc)
+		isBulkFull := currentBulk.buff.Len() > 0 && currentBulk.buff.Len()+serializedTx.Len() > int(esd.bulkRequestMaxSize)
+		if isBulkFull {
+			serializedBulks = append(serializedBulks, currentBulk)
+			currentBulk = &serializedTxsBulk{}
+		}
+
+		currentBulk.txs = append(currentBulk.txs, tx)
+		_, _ = currentBulk.buff.Write(serializedTx.Bytes())
+	}
+	if len(currentBulk.txs) > 0 {
+		serializedBulks = append(serializedBulks, currentBulk)
+	}
+
+	return serializedBulks
+}
+
 // buildTransactionBulks creates bulks of maximum txBulkSize transactions to be indexed together
 //  using the elastic search bulk API
-func (esd *elasticSearchDatabase) buildTransactionBulks(
-	body *block.Body,
-	header data.HeaderHandler,
-	txPool map[string]data.TransactionHandler,
-	selfShardId uint32,
-) [][]*Transaction {
-	txs := esd.prepareTransactionsForDatabase(body, header, txPool, selfShardId)
-
+func buildTransactionBulks(txs []*Transaction) [][]*Transaction {
 	bulks := make([][]*Transaction, (len(txs)/txBulkSize)+1)
 	for i := 0; i < len(bulks); i++ {
 		if i == len(bulks)-1 {
@@ -255,20 +905,94 @@ func (esd *elasticSearchDatabase) buildTransactionBulks(
 
 // SaveMiniblocks will prepare and save information about miniblocks in elasticsearch server
 func (esd *elasticSearchDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) {
-	miniblocks := esd.getMiniblocks(header, body)
+	if !esd.isShardIndexed(header.GetShardID()) {
+		return
+	}
+
+	miniblocks := esd.getMiniblocks(header, esd.filterEnabledMiniBlocks(body))
 	if miniblocks == nil {
-		log.Warn("indexer: could not index miniblocks")
+		log.Warn("indexer: could not index miniblocks",
+			"index", miniblocksIndex,
+			"nonce", header.GetNonce(),
+			"shardID", header.GetShardID())
 		return
 	}
 	if len(miniblocks) == 0 {
 		return
 	}
 
-	buff := serializeBulkMiniBlocks(header.GetShardID(), miniblocks)
-	err := esd.dbWriter.DoBulkRequest(&buff, miniblocksIndex)
+	existingMbs := esd.getExistingMiniblocks(header.GetShardID(), miniblocks)
+	buff := serializeBulkMiniBlocks(header.GetShardID(), miniblocks, existingMbs, esd.routingFunc)
+	err := esd.doBulkRequest(&buff, miniblocksIndex)
 	if err != nil {
-		log.Warn("indexing bulk of miniblocks", "error", err.Error())
+		log.Warn("indexer: error indexing bulk of miniblocks",
+			"error", err.Error(),
+			"index", miniblocksIndex,
+			"nonce", header.GetNonce(),
+			"shardID", header.GetShardID(),
+			"numDocs", len(miniblocks))
+	}
+}
+
+// getExistingMiniblocks checks which of the cross shard miniblocks were already indexed by the other shard. If the
+//  check fails, the miniblock is considered indexed only if it was received, as the sender shard indexes it first
+func (esd *elasticSearchDatabase) getExistingMiniblocks(hdrShardID uint32, miniblocks []*Miniblock) map[string]bool {
+	existingMbs := make(map[string]bool)
+	for _, mb := range miniblocks {
+		if mb.SenderShardID == mb.ReceiverShardID {
+			continue
+		}
+
+		exists, err := esd.dbWriter.DoExistsRequest(miniblocksIndex, mb.Hash)
+		if err != nil {
+			log.Debug("indexer: could not check the miniblock existence",
+				"error", err.Error(),
+				"index", miniblocksIndex,
+				"mb hash", mb.Hash)
+			exists = mb.SenderShardID != hdrShardID
+		}
+
+		existingMbs[mb.Hash] = exists
 	}
+
+	return existingMbs
+}
+
+// isShardIndexed returns true if the block data of the provided shard should be indexed. An empty indexed shards set
+// allows all the shards while the metachain can be disabled regardless of the set
+func (esd *elasticSearchDatabase) isShardIndexed(shardID uint32) bool {
+	if shardID == core.MetachainShardId {
+		return !esd.metachainIndexingOff
+	}
+	if len(esd.indexedShards) == 0 {
+		return true
+	}
+
+	_, ok := esd.indexedShards[shardID]
+
+	return ok
+}
+
+// filterEnabledMiniBlocks returns a body containing only the miniblocks whose type is enabled for indexing.
+//  If no miniblock types were configured, all of them are considered enabled
+func (esd *elasticSearchDatabase) filterEnabledMiniBlocks(body *block.Body) *block.Body {
+	if len(esd.enabledMiniBlockTypes) == 0 {
+		return body
+	}
+
+	filteredBody := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(body.MiniBlocks)),
+	}
+	for _, miniblock := range body.MiniBlocks {
+		_, isEnabled := esd.enabledMiniBlockTypes[miniblock.Type]
+		if !isEnabled {
+			continue
+		}
+
+		filteredBody.MiniBlocks = append(filteredBody.MiniBlocks, miniblock)
+	}
+
+	return filteredBody
 }
 
 func (esd *elasticSearchDatabase) getMiniblocks(header data.HeaderHandler, body *block.Body) []*Miniblock {
@@ -314,35 +1038,115 @@ func (esd *elasticSearchDatabase) getMiniblocks(header data.HeaderHandler, body
 	return miniblocks
 }
 
-// SaveRoundInfo will prepare and save information about a round in elasticsearch server
+// SaveRoundInfo will prepare and save information about a round in elasticsearch server. The index is refreshed
+//  right away, so the round can be searched as soon as it ends
 func (esd *elasticSearchDatabase) SaveRoundInfo(info RoundInfo) {
-	var buff bytes.Buffer
-
-	marshalizedRoundInfo, err := json.Marshal(&info)
-	if err != nil {
-		log.Debug("indexer: marshal", "error", "could not marshal signers indexes")
-		return
-	}
+	esd.resolveRoundConsensusGroup(&info)
 
-	buff.Grow(len(marshalizedRoundInfo))
-	_, err = buff.Write(marshalizedRoundInfo)
+	marshalizedRoundInfo, err := marshalDocument(&info, esd.fieldNamingFunc)
 	if err != nil {
-		log.Warn("elastic search: save round info, write", "error", err.Error())
+		log.Debug("indexer: marshal", "error", "could not marshal round info", "round", info.Index)
 		return
 	}
 
 	req := &esapi.IndexRequest{
 		Index:      roundIndex,
-		DocumentID: strconv.FormatUint(uint64(info.ShardId), 10) + "_" + strconv.FormatUint(info.Index, 10),
-		Body:       bytes.NewReader(buff.Bytes()),
+		DocumentID: fmt.Sprintf("%d_%d", info.ShardId, info.Index),
+		Body:       bytes.NewReader(marshalizedRoundInfo),
 		Refresh:    "true",
 	}
+	if esd.routingFunc != nil {
+		req.Routing = esd.routingFunc(info.ShardId)
+	}
 
 	err = esd.dbWriter.DoRequest(req)
 	if err != nil {
-		log.Warn("indexer: can not index round info", "error", err.Error())
+		log.Warn("indexer: can not index round info",
+			"error", err.Error(),
+			"index", roundIndex,
+			"round", info.Index,
+			"shardID", info.ShardId,
+			"numDocs", 1)
+	}
+}
+
+// SaveRoundsInfo will prepare and save information about multiple rounds in elasticsearch server using bulk requests
+func (esd *elasticSearchDatabase) SaveRoundsInfo(infos []RoundInfo) {
+	for i := range infos {
+		esd.resolveRoundConsensusGroup(&infos[i])
+	}
+
+	for i := 0; i < len(infos); i += txBulkSize {
+		end := i + txBulkSize
+		if end > len(infos) {
+			end = len(infos)
+		}
+
+		buff := serializeBulkRoundsInfo(infos[i:end], esd.routingFunc, esd.fieldNamingFunc)
+		if buff.Len() == 0 {
+			continue
+		}
+
+		err := esd.doBulkRequest(&buff, roundIndex)
+		if err != nil {
+			log.Warn("indexer: can not index rounds info",
+				"error", err.Error(),
+				"index", roundIndex,
+				"first round", infos[i].Index,
+				"shardID", infos[i].ShardId,
+				"numDocs", end-i)
+		}
+	}
+}
+
+// resolveRoundConsensusGroup will fill the expected proposer and consensus group of the round, if a nodes coordinator
+// was provided. The fields are left empty when the group can not be computed, for example when the epoch is pruned
+func (esd *elasticSearchDatabase) resolveRoundConsensusGroup(info *RoundInfo) {
+	if check.IfNil(esd.nodesCoordinator) || len(info.Randomness) == 0 {
 		return
 	}
+
+	publicKeys, err := esd.nodesCoordinator.GetConsensusValidatorsPublicKeys(info.Randomness, info.Index, info.ShardId, info.Epoch)
+	if err != nil || len(publicKeys) == 0 {
+		log.Trace("indexer: can not resolve round consensus group",
+			"round", info.Index,
+			"shardID", info.ShardId,
+			"epoch", info.Epoch,
+			"error", err)
+		return
+	}
+
+	info.ConsensusGroup = make([]string, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		info.ConsensusGroup = append(info.ConsensusGroup, esd.validatorPubkeyConverter.Encode([]byte(publicKey)))
+	}
+	info.Proposer = info.ConsensusGroup[0]
+}
+
+// resolveBlockProposer returns the encoded public key of the block proposer, which is the leader of the consensus group
+// of the block's round. An empty string is returned if no nodes coordinator was provided or if the consensus group can
+// not be computed
+func (esd *elasticSearchDatabase) resolveBlockProposer(header data.HeaderHandler) string {
+	if check.IfNil(esd.nodesCoordinator) {
+		return ""
+	}
+
+	publicKeys, err := esd.nodesCoordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		header.GetEpoch(),
+	)
+	if err != nil || len(publicKeys) == 0 {
+		log.Trace("indexer: can not resolve block proposer",
+			"round", header.GetRound(),
+			"shardID", header.GetShardID(),
+			"epoch", header.GetEpoch(),
+			"error", err)
+		return ""
+	}
+
+	return esd.validatorPubkeyConverter.Encode([]byte(publicKeys[0]))
 }
 
 // SaveShardValidatorsPubKeys will prepare and save information about a shard validators public keys in elasticsearch server
@@ -356,8 +1160,10 @@ func (esd *elasticSearchDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint
 		strValidatorPk := esd.validatorPubkeyConverter.Encode(validatorPk)
 		shardValPubKeys.PublicKeys = append(shardValPubKeys.PublicKeys, strValidatorPk)
 	}
+	// the keys keep the order of the coordinator's eligible list, as the validators indexes of the blocks and rounds
+	// documents are positions in this list
 
-	marshalizedValidatorPubKeys, err := json.Marshal(shardValPubKeys)
+	marshalizedValidatorPubKeys, err := marshalDocument(shardValPubKeys, esd.fieldNamingFunc)
 	if err != nil {
 		log.Debug("indexer: marshal", "error", "could not marshal validators public keys")
 		return
@@ -378,7 +1184,12 @@ func (esd *elasticSearchDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint
 
 	err = esd.dbWriter.DoRequest(req)
 	if err != nil {
-		log.Warn("indexer: can not index validators pubkey", "error", err.Error())
+		log.Warn("indexer: can not index validators pubkey",
+			"error", err.Error(),
+			"index", validatorsIndex,
+			"epoch", epoch,
+			"shardID", shardID,
+			"numDocs", 1)
 		return
 	}
 }
@@ -389,7 +1200,7 @@ func (esd *elasticSearchDatabase) SaveValidatorsRating(index string, validatorsR
 
 	infosRating := ValidatorsRatingInfo{ValidatorsInfos: validatorsRatingInfo}
 
-	marshalizedInfoRating, err := json.Marshal(&infosRating)
+	marshalizedInfoRating, err := marshalDocument(&infosRating, esd.fieldNamingFunc)
 	if err != nil {
 		log.Debug("indexer: marshal", "error", "could not marshal validators rating")
 		return
@@ -410,11 +1221,282 @@ func (esd *elasticSearchDatabase) SaveValidatorsRating(index string, validatorsR
 
 	err = esd.dbWriter.DoRequest(req)
 	if err != nil {
-		log.Warn("indexer: can not index validators rating", "error", err.Error())
+		log.Warn("indexer: can not index validators rating",
+			"error", err.Error(),
+			"index", ratingIndex,
+			"id", index,
+			"numDocs", 1)
 		return
 	}
 }
 
+// SaveValidatorsRatingHistory will save in the rating history index a rating document for each of the provided
+//  validators, keyed by the raw public key, for the given epoch. Indexing again the same epoch will overwrite the
+//  documents of its validators
+func (esd *elasticSearchDatabase) SaveValidatorsRatingHistory(epoch uint32, validators map[string]*state.ValidatorApiResponse) {
+	ratingsHistory := esd.prepareValidatorsRatingHistory(epoch, validators)
+	for i := 0; i < len(ratingsHistory); i += txBulkSize {
+		end := i + txBulkSize
+		if end > len(ratingsHistory) {
+			end = len(ratingsHistory)
+		}
+
+		buff := serializeBulkValidatorsRatingHistory(ratingsHistory[i:end])
+		if buff.Len() == 0 {
+			continue
+		}
+
+		err := esd.doBulkRequest(&buff, ratingHistoryIndex)
+		if err != nil {
+			log.Warn("indexer: error indexing bulk of validators rating history",
+				"error", err.Error(),
+				"index", ratingHistoryIndex,
+				"epoch", epoch,
+				"numDocs", end-i)
+		}
+	}
+}
+
+func (esd *elasticSearchDatabase) prepareValidatorsRatingHistory(
+	epoch uint32,
+	validators map[string]*state.ValidatorApiResponse,
+) []*ValidatorRatingHistory {
+	ratingsHistory := make([]*ValidatorRatingHistory, 0, len(validators))
+	for pubKey, validator := range validators {
+		if validator == nil {
+			continue
+		}
+
+		ratingsHistory = append(ratingsHistory, &ValidatorRatingHistory{
+			PublicKey:           esd.validatorPubkeyConverter.Encode([]byte(pubKey)),
+			Epoch:               epoch,
+			ShardID:             validator.ShardId,
+			Rating:              validator.Rating,
+			TempRating:          validator.TempRating,
+			NumLeaderSuccess:    validator.NumLeaderSuccess,
+			NumLeaderFailure:    validator.NumLeaderFailure,
+			NumValidatorSuccess: validator.NumValidatorSuccess,
+			NumValidatorFailure: validator.NumValidatorFailure,
+		})
+	}
+	// the documents are sorted so re-indexing the same epoch will produce identical bulks
+	sort.Slice(ratingsHistory, func(i, j int) bool {
+		return ratingsHistory[i].PublicKey < ratingsHistory[j].PublicKey
+	})
+
+	return ratingsHistory
+}
+
+// SaveAccountsHistory will prepare and save a balance history document for each of the provided account changes
+func (esd *elasticSearchDatabase) SaveAccountsHistory(blockNonce uint64, changes []AccountBalanceChange) {
+	for i := 0; i < len(changes); i += txBulkSize {
+		end := i + txBulkSize
+		if end > len(changes) {
+			end = len(changes)
+		}
+
+		buff := serializeBulkAccountsHistory(blockNonce, changes[i:end], esd.addressPubkeyConverter)
+		if buff.Len() == 0 {
+			continue
+		}
+
+		err := esd.doBulkRequest(&buff, accountsHistoryIndex)
+		if err != nil {
+			log.Warn("indexer: error indexing bulk of accounts history",
+				"error", err.Error(),
+				"index", accountsHistoryIndex,
+				"nonce", blockNonce,
+				"numDocs", end-i)
+		}
+	}
+}
+
+// SaveEpochInfo will prepare and save the economics information of an epoch in elasticsearch server. The information
+//  is merged in the epoch document, which also holds the epoch start information indexed separately
+func (esd *elasticSearchDatabase) SaveEpochInfo(epoch uint32, econ EpochEconomics) {
+	epochInfo := prepareEpochInfo(epoch, econ)
+
+	buff, err := serializeEpochInfo(epochInfo)
+	if err != nil {
+		log.Debug("indexer: marshal", "error", "could not serialize epoch info", "epoch", epoch)
+		return
+	}
+
+	err = esd.doBulkRequest(&buff, epochInfoIndex)
+	if err != nil {
+		log.Warn("indexer: can not index epoch info",
+			"error", err.Error(),
+			"index", epochInfoIndex,
+			"epoch", epoch,
+			"numDocs", 1)
+		return
+	}
+}
+
+// SaveEpochStartInfo will prepare and save the shards finalization information of an epoch start metablock in
+//  elasticsearch server
+func (esd *elasticSearchDatabase) SaveEpochStartInfo(metaBlock *block.MetaBlock) {
+	epochStartInfo := prepareEpochStartInfo(metaBlock)
+
+	buff, err := serializeEpochStartInfo(epochStartInfo)
+	if err != nil {
+		log.Debug("indexer: marshal", "error", "could not serialize epoch start info", "epoch", epochStartInfo.Epoch)
+		return
+	}
+
+	err = esd.doBulkRequest(&buff, epochInfoIndex)
+	if err != nil {
+		log.Warn("indexer: can not index epoch start info",
+			"error", err.Error(),
+			"index", epochInfoIndex,
+			"epoch", epochStartInfo.Epoch,
+			"numDocs", 1)
+		return
+	}
+}
+
+// GetTransactionByHash returns the transaction indexed with the provided hash. An error wrapping ErrDocumentNotFound
+//  is returned if the transaction was not indexed
+func (esd *elasticSearchDatabase) GetTransactionByHash(hash string) (*Transaction, error) {
+	tx := &Transaction{}
+	err := esd.getDocument(txIndex, hash, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Hash = hash
+	return tx, nil
+}
+
+// GetBlockByHash returns the block indexed with the provided hex encoded hash. An error wrapping ErrDocumentNotFound
+//  is returned if the block was not indexed
+func (esd *elasticSearchDatabase) GetBlockByHash(hash string) (*Block, error) {
+	elasticBlock := &Block{}
+	err := esd.getDocument(blockIndex, hash, elasticBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	elasticBlock.Hash = hash
+	return elasticBlock, nil
+}
+
+// getDocument reads back a document by its id. The documents indexed with a custom routing are stored on the shard
+//  selected by the routing value, which is not known here, so a missed get request is followed by an ids query
+//  searching all the shards of the index
+func (esd *elasticSearchDatabase) getDocument(index string, id string, document interface{}) error {
+	source, err := esd.dbWriter.DoGetRequest(index, id)
+	if errors.Is(err, ErrDocumentNotFound) && esd.routingFunc != nil {
+		source, err = esd.searchDocumentByID(index, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = unmarshalDocument(source, document, esd.fieldNamingFunc)
+	if err != nil {
+		return fmt.Errorf("%w, index: %s, id: %s", err, index, id)
+	}
+
+	return nil
+}
+
+func (esd *elasticSearchDatabase) searchDocumentByID(index string, id string) (json.RawMessage, error) {
+	query, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"ids": map[string]interface{}{
+				"values": []string{id},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var source json.RawMessage
+	err = esd.dbWriter.DoScrollRequest(index, query, func(sources []json.RawMessage) error {
+		source = sources[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, fmt.Errorf("%w, index: %s, id: %s", ErrDocumentNotFound, index, id)
+	}
+
+	return source, nil
+}
+
+// VerifyContiguity returns, in ascending order, the nonces from the inclusive [fromNonce, toNonce] range for which no
+// block of the provided shard is found on the elasticsearch server. The blocks still buffered by the node are
+// reported as missing. The range is searched page by page and can not span more than maxContiguityNoncesSpan nonces
+func (esd *elasticSearchDatabase) VerifyContiguity(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	if fromNonce > toNonce {
+		return nil, fmt.Errorf("%w, from nonce %d is greater than to nonce %d", ErrInvalidNoncesRange, fromNonce, toNonce)
+	}
+	if toNonce-fromNonce >= maxContiguityNoncesSpan {
+		return nil, fmt.Errorf("%w, the range can not span more than %d nonces", ErrInvalidNoncesRange, maxContiguityNoncesSpan)
+	}
+
+	missingNonces := make([]uint64, 0)
+	for pageStart := fromNonce; ; {
+		pageEnd := toNonce
+		if toNonce-pageStart >= contiguityPageSize {
+			pageEnd = pageStart + contiguityPageSize - 1
+		}
+
+		pageMissingNonces, err := esd.getMissingNonces(pageStart, pageEnd, shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		missingNonces = append(missingNonces, pageMissingNonces...)
+		if pageEnd == toNonce {
+			break
+		}
+		pageStart = pageEnd + 1
+	}
+
+	return missingNonces, nil
+}
+
+func (esd *elasticSearchDatabase) getMissingNonces(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	query := fmt.Sprintf(`{"_source":["nonce"],"query":{"bool":{"filter":[{"term":{"%s":%d}},{"range":{"nonce":{"gte":%d,"lte":%d}}}]}}}`,
+		formatFieldName(esd.fieldNamingFunc, "shardId"), shardID, fromNonce, toNonce)
+	indexedNonces := make(map[uint64]struct{})
+	err := esd.dbWriter.DoScrollRequest(blockIndex, []byte(query), func(sources []json.RawMessage) error {
+		for _, source := range sources {
+			indexedBlock := struct {
+				Nonce uint64 `json:"nonce"`
+			}{}
+			errUnmarshal := json.Unmarshal(source, &indexedBlock)
+			if errUnmarshal != nil {
+				return errUnmarshal
+			}
+
+			indexedNonces[indexedBlock.Nonce] = struct{}{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	missingNonces := make([]uint64, 0)
+	for nonce := fromNonce; ; nonce++ {
+		if _, isIndexed := indexedNonces[nonce]; !isIndexed {
+			missingNonces = append(missingNonces, nonce)
+		}
+		if nonce == toNonce {
+			break
+		}
+	}
+
+	return missingNonces, nil
+}
+
 // SaveShardStatistics will prepare and save information about a shard statistics in elasticsearch server
 func (esd *elasticSearchDatabase) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) {
 	buff := prepareGeneralInfo(tpsBenchmark)
@@ -435,9 +1517,13 @@ func (esd *elasticSearchDatabase) SaveShardStatistics(tpsBenchmark statistics.TP
 			log.Warn("elastic search: update TPS write serialized data", "error", err.Error())
 		}
 
-		err = esd.dbWriter.DoBulkRequest(&buff, tpsIndex)
+		err = esd.doMeasuredBulkRequest(&buff, tpsIndex)
 		if err != nil {
-			log.Warn("indexer: error indexing tps information", "error", err.Error())
+			log.Warn("indexer: error indexing tps information",
+				"error", err.Error(),
+				"index", tpsIndex,
+				"nonce", shardInfo.CurrentBlockNonce(),
+				"shardID", shardInfo.ShardID())
 			continue
 		}
 	}
@@ -2,26 +2,80 @@ package indexer
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
 
+const defaultMaxIdleConnsPerHost = 10
+
+const scrollPageSize = 1000
+const scrollKeepAlive = time.Minute
+
+// scrollResponse holds the fields of a search or scroll response needed to go through all the matched documents
+type scrollResponse struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []struct {
+			Source json.RawMessage `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// getResponse holds the fields of a get response needed to read back an indexed document
+type getResponse struct {
+	Found  bool            `json:"found"`
+	Source json.RawMessage `json:"_source"`
+}
+
 type databaseWriter struct {
-	dbWriter *elasticsearch.Client
+	dbWriter       *elasticsearch.Client
+	useGzip        bool
+	requestTimeout time.Duration
 }
 
-func newDatabaseWriter(cfg elasticsearch.Config) (*databaseWriter, error) {
-	es, err := elasticsearch.NewClient(cfg)
+// newDatabaseWriter creates the writer for the configured elasticsearch nodes. If useGzip is set, the bodies of the
+//  bulk requests are gzip compressed. A 0 value for requestTimeout will not set a deadline on the index and bulk requests
+func newDatabaseWriter(cfg elasticsearch.Config, useGzip bool, requestTimeout time.Duration) (*databaseWriter, error) {
+	es, err := newElasticsearchClient(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return &databaseWriter{dbWriter: es}, nil
+	return &databaseWriter{
+		dbWriter:       es,
+		useGzip:        useGzip,
+		requestTimeout: requestTimeout,
+	}, nil
+}
+
+// createTransport returns a copy of the default HTTP transport having the provided connection pool limits. A 0 value
+//  for maxIdleConnsPerHost will keep defaultMaxIdleConnsPerHost idle connections, while a 0 value for maxConnsPerHost
+//  will not limit the number of connections. A non zero responseTimeout limits the time waited for the response headers
+func createTransport(maxIdleConnsPerHost int, maxConnsPerHost int, responseTimeout time.Duration) *http.Transport {
+	if maxIdleConnsPerHost <= 0 {
+		maxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	if maxConnsPerHost < 0 {
+		maxConnsPerHost = 0
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.MaxConnsPerHost = maxConnsPerHost
+	if responseTimeout > 0 {
+		transport.ResponseHeaderTimeout = responseTimeout
+	}
+
+	return transport
 }
 
 // CheckAndCreateIndex will check if a index exits and if dont will create a new one
@@ -54,6 +108,49 @@ func (dw *databaseWriter) CheckAndCreateIndex(index string, body io.Reader) erro
 	return nil
 }
 
+// DoIndexExistsRequest will check if the provided index exists
+func (dw *databaseWriter) DoIndexExistsRequest(index string) (bool, error) {
+	var res *esapi.Response
+	var err error
+	defer func() {
+		closeESResponseBody(res)
+	}()
+
+	res, err = dw.dbWriter.Indices.Exists([]string{index})
+	if err != nil {
+		return false, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w, index: %s, status code: %d", ErrIndexExistsCheck, index, res.StatusCode)
+	}
+}
+
+// DoPingRequest will check that the elastic server can be reached
+func (dw *databaseWriter) DoPingRequest() error {
+	var res *esapi.Response
+	var err error
+	defer func() {
+		closeESResponseBody(res)
+	}()
+
+	res, err = dw.dbWriter.Ping()
+	if err != nil {
+		return err
+	}
+
+	if res.IsError() {
+		return fmt.Errorf("ping request failed: %s", res.String())
+	}
+
+	return nil
+}
+
 func (dw *databaseWriter) createDatabaseIndex(index string, body io.Reader) error {
 	var err error
 	var res *esapi.Response
@@ -84,19 +181,34 @@ func (dw *databaseWriter) createDatabaseIndex(index string, body io.Reader) erro
 	return nil
 }
 
+// createRequestContext returns the context of an index or bulk request, having a deadline if a request timeout is set
+func (dw *databaseWriter) createRequestContext() (context.Context, context.CancelFunc) {
+	if dw.requestTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+
+	return context.WithTimeout(context.Background(), dw.requestTimeout)
+}
+
 // DoRequest will do a request to elastic server
 func (dw *databaseWriter) DoRequest(req *esapi.IndexRequest) error {
+	ctx, cancel := dw.createRequestContext()
+	defer cancel()
+
 	var err error
 	var res *esapi.Response
 	defer func() {
 		closeESResponseBody(res)
 	}()
 
-	res, err = req.Do(context.Background(), dw.dbWriter)
+	res, err = req.Do(ctx, dw.dbWriter)
 	if err != nil {
 		return err
 	}
 
+	if res.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w, index: %s", ErrTooManyRequests, req.Index)
+	}
 	if res.IsError() {
 		log.Warn("indexer", "error", res.String())
 	}
@@ -104,9 +216,34 @@ func (dw *databaseWriter) DoRequest(req *esapi.IndexRequest) error {
 	return nil
 }
 
+// DoExistsRequest will check if a document with the provided id exists in the given index
+func (dw *databaseWriter) DoExistsRequest(index string, id string) (bool, error) {
+	var err error
+	var res *esapi.Response
+	defer func() {
+		closeESResponseBody(res)
+	}()
+
+	// Exists actually does a HEAD request on the document
+	res, err = dw.dbWriter.Exists(index, id)
+	if err != nil {
+		return false, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w, index: %s, id: %s, status code: %d", ErrDocumentExistsCheck, index, id, res.StatusCode)
+	}
+}
+
 // DoBulkRequest will do a bulk of request to elastic server
 func (dw *databaseWriter) DoBulkRequest(buff *bytes.Buffer, index string) error {
-	reader := bytes.NewReader(buff.Bytes())
+	ctx, cancel := dw.createRequestContext()
+	defer cancel()
 
 	var err error
 	var res *esapi.Response
@@ -114,19 +251,218 @@ func (dw *databaseWriter) DoBulkRequest(buff *bytes.Buffer, index string) error
 		closeESResponseBody(res)
 	}()
 
-	res, err = dw.dbWriter.Bulk(reader, dw.dbWriter.Bulk.WithIndex(index))
+	if dw.useGzip {
+		res, err = dw.doGzipBulkRequest(ctx, buff, index)
+	} else {
+		res, err = dw.dbWriter.Bulk(
+			bytes.NewReader(buff.Bytes()),
+			dw.dbWriter.Bulk.WithIndex(index),
+			dw.dbWriter.Bulk.WithContext(ctx),
+		)
+	}
 	if err != nil {
 		return err
 	}
 
+	if res.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w, index: %s", ErrTooManyRequests, index)
+	}
 	if res.IsError() {
 		log.Warn("indexer", "error", res.String())
+		if res.StatusCode == http.StatusBadRequest {
+			return fmt.Errorf("%w, index: %s, response: %s", ErrBulkRequestRejected, index, res.String())
+		}
 		return fmt.Errorf("do bulk requrest %s", res.String())
 	}
 
+	return checkBulkResponseItems(buff.Bytes(), res.Body, index)
+}
+
+// doGzipBulkRequest sends the bulk request with a gzip compressed body. The elasticsearch bulk API does not allow
+//  setting the request headers, so the request is built here and sent through the client's transport
+func (dw *databaseWriter) doGzipBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) (*esapi.Response, error) {
+	var compressedBuff bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressedBuff)
+	_, err := gzipWriter.Write(buff.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/"+index+"/_bulk", &compressedBuff)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+
+	res, err := dw.dbWriter.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &esapi.Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}, nil
+}
+
+// DoScrollRequest will search the given index with the provided query and will call the handler with the sources of
+//  the matched documents, one page at a time, until all the documents were scrolled through
+func (dw *databaseWriter) DoScrollRequest(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+	res, err := dw.dbWriter.Search(
+		dw.dbWriter.Search.WithIndex(index),
+		dw.dbWriter.Search.WithBody(bytes.NewReader(query)),
+		dw.dbWriter.Search.WithScroll(scrollKeepAlive),
+		dw.dbWriter.Search.WithSize(scrollPageSize),
+	)
+
+	scrollID := ""
+	defer func() {
+		dw.clearScroll(scrollID)
+	}()
+
+	for {
+		page, errRead := readScrollResponse(res, err)
+		if errRead != nil {
+			return fmt.Errorf("%w, index: %s", errRead, index)
+		}
+
+		scrollID = page.ScrollID
+		if len(page.Hits.Hits) == 0 {
+			return nil
+		}
+
+		sources := make([]json.RawMessage, 0, len(page.Hits.Hits))
+		for _, hit := range page.Hits.Hits {
+			sources = append(sources, hit.Source)
+		}
+
+		err = handleSources(sources)
+		if err != nil {
+			return err
+		}
+
+		res, err = dw.dbWriter.Scroll(
+			dw.dbWriter.Scroll.WithScrollID(scrollID),
+			dw.dbWriter.Scroll.WithScroll(scrollKeepAlive),
+		)
+	}
+}
+
+// DoDeleteRequest will delete the documents having the provided ids from the given index
+func (dw *databaseWriter) DoDeleteRequest(index string, ids []string) error {
+	query, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"ids": map[string]interface{}{
+				"values": ids,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var res *esapi.Response
+	defer func() {
+		closeESResponseBody(res)
+	}()
+
+	res, err = dw.dbWriter.DeleteByQuery(
+		[]string{index},
+		bytes.NewReader(query),
+		dw.dbWriter.DeleteByQuery.WithRefresh(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.IsError() {
+		return fmt.Errorf("%w, index: %s, status code: %d", ErrDeleteRequest, index, res.StatusCode)
+	}
+
 	return nil
 }
 
+// DoGetRequest will return the source of the document having the provided id from the given index
+func (dw *databaseWriter) DoGetRequest(index string, id string) (json.RawMessage, error) {
+	var err error
+	var res *esapi.Response
+	defer func() {
+		closeESResponseBody(res)
+	}()
+
+	res, err = dw.dbWriter.Get(index, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w, index: %s, id: %s", ErrDocumentNotFound, index, id)
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("%w, index: %s, id: %s, status code: %d", ErrGetRequest, index, id, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &getResponse{}
+	err = json.Unmarshal(body, document)
+	if err != nil {
+		return nil, err
+	}
+	if !document.Found {
+		return nil, fmt.Errorf("%w, index: %s, id: %s", ErrDocumentNotFound, index, id)
+	}
+
+	return document.Source, nil
+}
+
+func readScrollResponse(res *esapi.Response, err error) (*scrollResponse, error) {
+	defer func() {
+		closeESResponseBody(res)
+	}()
+
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("%w, status code: %d", ErrSearchRequest, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &scrollResponse{}
+	err = json.Unmarshal(body, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (dw *databaseWriter) clearScroll(scrollID string) {
+	if scrollID == "" {
+		return
+	}
+
+	res, err := dw.dbWriter.ClearScroll(dw.dbWriter.ClearScroll.WithScrollID(scrollID))
+	closeESResponseBody(res)
+	if err != nil {
+		log.Debug("indexer: clear scroll", "error", err.Error())
+	}
+}
+
 func closeESResponseBody(res *esapi.Response) {
 	if res != nil && res.Body != nil {
 		err := res.Body.Close()
@@ -0,0 +1,442 @@
+package indexer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDatabaseWriter(t *testing.T, ts *httptest.Server) *databaseWriter {
+	dw, err := newDatabaseWriter(elasticsearch.Config{Addresses: []string{ts.URL}}, false, 0)
+	require.Nil(t, err)
+
+	return dw
+}
+
+func TestDatabaseWriter_DoExistsRequestDocumentExists(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		require.Equal(t, "/"+txIndex+"/_doc/id", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	exists, err := dw.DoExistsRequest(txIndex, "id")
+	require.Nil(t, err)
+	require.True(t, exists)
+}
+
+func TestDatabaseWriter_DoExistsRequestDocumentDoesNotExist(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	exists, err := dw.DoExistsRequest(txIndex, "id")
+	require.Nil(t, err)
+	require.False(t, exists)
+}
+
+func TestDatabaseWriter_DoExistsRequestUnexpectedStatusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	exists, err := dw.DoExistsRequest(txIndex, "id")
+	require.True(t, errors.Is(err, ErrDocumentExistsCheck))
+	require.False(t, exists)
+}
+
+func TestDatabaseWriter_DoIndexExistsRequest(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		if r.URL.Path == "/"+txIndex {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	exists, err := dw.DoIndexExistsRequest(txIndex)
+	require.Nil(t, err)
+	require.True(t, exists)
+
+	exists, err = dw.DoIndexExistsRequest(blockIndex)
+	require.Nil(t, err)
+	require.False(t, exists)
+}
+
+func TestDatabaseWriter_DoPingRequest(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		require.Equal(t, "/", r.URL.Path)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+	require.Nil(t, dw.DoPingRequest())
+
+	ts.Close()
+	require.NotNil(t, dw.DoPingRequest())
+}
+
+func TestCreateTransport_ShouldSetTheConnectionLimits(t *testing.T) {
+	t.Parallel()
+
+	transport := createTransport(50, 20, 0)
+	require.Equal(t, 50, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 20, transport.MaxConnsPerHost)
+	require.False(t, transport == http.DefaultTransport)
+}
+
+func TestCreateTransport_UnsetLimitsShouldUseTheDefaults(t *testing.T) {
+	t.Parallel()
+
+	transport := createTransport(0, 0, 0)
+	require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 0, transport.MaxConnsPerHost)
+
+	transport = createTransport(-1, -1, 0)
+	require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 0, transport.MaxConnsPerHost)
+}
+
+func TestCreateTransport_ShouldSetTheResponseTimeout(t *testing.T) {
+	t.Parallel()
+
+	transport := createTransport(0, 0, 0)
+	require.Equal(t, http.DefaultTransport.(*http.Transport).ResponseHeaderTimeout, transport.ResponseHeaderTimeout)
+
+	transport = createTransport(0, 0, 5*time.Second)
+	require.Equal(t, 5*time.Second, transport.ResponseHeaderTimeout)
+}
+
+func createHungServer() (*httptest.Server, func()) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+
+	return ts, func() {
+		close(release)
+		ts.Close()
+	}
+}
+
+func TestDatabaseWriter_DoBulkRequestHungServerShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	ts, closeServer := createHungServer()
+	defer closeServer()
+
+	for _, useGzip := range []bool{false, true} {
+		dw, err := newDatabaseWriter(elasticsearch.Config{Addresses: []string{ts.URL}}, useGzip, 50*time.Millisecond)
+		require.Nil(t, err)
+
+		err = dw.DoBulkRequest(bytes.NewBufferString("{}\n"), txIndex)
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+	}
+}
+
+func TestDatabaseWriter_DoRequestHungServerShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	ts, closeServer := createHungServer()
+	defer closeServer()
+
+	dw, err := newDatabaseWriter(elasticsearch.Config{Addresses: []string{ts.URL}}, false, 50*time.Millisecond)
+	require.Nil(t, err)
+
+	err = dw.DoRequest(&esapi.IndexRequest{
+		Index:      txIndex,
+		DocumentID: "hash",
+		Body:       bytes.NewReader([]byte("{}")),
+	})
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestDatabaseWriter_DoScrollRequestShouldGoThroughAllThePages(t *testing.T) {
+	t.Parallel()
+
+	numScrollRequests := 0
+	scrollCleared := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/"+blockIndex+"/_search":
+			_, _ = w.Write([]byte(`{"_scroll_id":"scroll1","hits":{"hits":[{"_source":{"nonce":1}},{"_source":{"nonce":2}}]}}`))
+		case r.Method == http.MethodDelete:
+			scrollCleared = true
+			_, _ = w.Write([]byte(`{}`))
+		case r.URL.Path == "/_search/scroll":
+			numScrollRequests++
+			if numScrollRequests == 1 {
+				_, _ = w.Write([]byte(`{"_scroll_id":"scroll1","hits":{"hits":[{"_source":{"nonce":3}}]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"_scroll_id":"scroll1","hits":{"hits":[]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	receivedSources := make([]string, 0)
+	err := dw.DoScrollRequest(blockIndex, []byte(`{}`), func(sources []json.RawMessage) error {
+		for _, source := range sources {
+			receivedSources = append(receivedSources, string(source))
+		}
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{`{"nonce":1}`, `{"nonce":2}`, `{"nonce":3}`}, receivedSources)
+	require.Equal(t, 2, numScrollRequests)
+	require.True(t, scrollCleared)
+}
+
+func TestDatabaseWriter_DoScrollRequestErrorStatusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	err := dw.DoScrollRequest(blockIndex, []byte(`{}`), func(_ []json.RawMessage) error {
+		require.Fail(t, "should have not been called")
+		return nil
+	})
+	require.True(t, errors.Is(err, ErrSearchRequest))
+}
+
+func TestDatabaseWriter_DoBulkRequestBadRequestShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	err := dw.DoBulkRequest(bytes.NewBufferString("{}\n"), txIndex)
+	require.True(t, errors.Is(err, ErrBulkRequestRejected))
+}
+
+func TestDatabaseWriter_DoBulkRequestServerErrorShouldNotBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	err := dw.DoBulkRequest(bytes.NewBufferString("{}\n"), txIndex)
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrBulkRequestRejected))
+}
+
+func TestDatabaseWriter_DoBulkRequestWithGzipShouldCompressTheBody(t *testing.T) {
+	t.Parallel()
+
+	bulkBody := `{ "index" : { "_id" : "hash", "_type" : "_doc" } }` + "\n" + `{"nonce":1}` + "\n"
+	var receivedBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/"+txIndex+"/_bulk", r.URL.Path)
+		require.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+
+		gzipReader, err := gzip.NewReader(r.Body)
+		require.Nil(t, err)
+		body, err := ioutil.ReadAll(gzipReader)
+		require.Nil(t, err)
+		receivedBody = string(body)
+	}))
+	defer ts.Close()
+
+	dw, err := newDatabaseWriter(elasticsearch.Config{Addresses: []string{ts.URL}}, true, 0)
+	require.Nil(t, err)
+
+	err = dw.DoBulkRequest(bytes.NewBufferString(bulkBody), txIndex)
+	require.Nil(t, err)
+	require.Equal(t, bulkBody, receivedBody)
+}
+
+func TestDatabaseWriter_DoBulkRequestWithoutGzipShouldSendThePlainBody(t *testing.T) {
+	t.Parallel()
+
+	bulkBody := `{ "index" : { "_id" : "hash", "_type" : "_doc" } }` + "\n" + `{"nonce":1}` + "\n"
+	var receivedBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.Header.Get("Content-Encoding"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		receivedBody = string(body)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	err := dw.DoBulkRequest(bytes.NewBufferString(bulkBody), txIndex)
+	require.Nil(t, err)
+	require.Equal(t, bulkBody, receivedBody)
+}
+
+func TestDatabaseWriter_DoBulkRequestWithGzipBadRequestShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	dw, err := newDatabaseWriter(elasticsearch.Config{Addresses: []string{ts.URL}}, true, 0)
+	require.Nil(t, err)
+
+	err = dw.DoBulkRequest(bytes.NewBufferString("{}\n"), txIndex)
+	require.True(t, errors.Is(err, ErrBulkRequestRejected))
+}
+
+func TestDatabaseWriter_DoDeleteRequestShouldDeleteByIds(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/"+txIndex+"/_delete_by_query", r.URL.Path)
+
+		query := make(map[string]map[string]map[string][]string)
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+		require.Equal(t, []string{"id1", "id2"}, query["query"]["ids"]["values"])
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"deleted":2}`))
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	err := dw.DoDeleteRequest(txIndex, []string{"id1", "id2"})
+	require.Nil(t, err)
+}
+
+func TestDatabaseWriter_DoDeleteRequestErrorStatusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	err := dw.DoDeleteRequest(blockIndex, []string{"id"})
+	require.True(t, errors.Is(err, ErrDeleteRequest))
+}
+
+func TestDatabaseWriter_DoGetRequestShouldReturnTheSource(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/"+txIndex+"/_doc/id", r.URL.Path)
+		_, _ = w.Write([]byte(`{"_index":"transactions","_id":"id","found":true,"_source":{"nonce":5}}`))
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	source, err := dw.DoGetRequest(txIndex, "id")
+	require.Nil(t, err)
+	require.JSONEq(t, `{"nonce":5}`, string(source))
+}
+
+func TestDatabaseWriter_DoGetRequestMissingDocumentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"_index":"transactions","_id":"id","found":false}`))
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	source, err := dw.DoGetRequest(txIndex, "id")
+	require.Nil(t, source)
+	require.True(t, errors.Is(err, ErrDocumentNotFound))
+}
+
+func TestDatabaseWriter_DoGetRequestErrorStatusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	dw := createTestDatabaseWriter(t, ts)
+
+	source, err := dw.DoGetRequest(txIndex, "id")
+	require.Nil(t, source)
+	require.True(t, errors.Is(err, ErrGetRequest))
+}
+
+func TestDatabaseWriter_UnreachableAddressShouldFailoverToTheNextOne(t *testing.T) {
+	t.Parallel()
+
+	unreachableServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	unreachableServer.Close()
+
+	numPings := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numPings++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	dw, err := newDatabaseWriter(elasticsearch.Config{Addresses: []string{unreachableServer.URL, ts.URL}}, false, 0)
+	require.Nil(t, err)
+
+	numRequests := 5
+	for i := 0; i < numRequests; i++ {
+		require.Nil(t, dw.DoPingRequest())
+	}
+	require.Equal(t, numRequests, numPings)
+}
@@ -7,22 +7,29 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/stretchr/testify/require"
 )
@@ -34,10 +41,21 @@ func newTestElasticSearchDatabase(elasticsearchWriter databaseWriterHandler, arg
 			arguments.marshalizer,
 			arguments.addressPubkeyConverter,
 			arguments.validatorPubkeyConverter,
+			arguments.denomination,
 		),
-		dbWriter:    elasticsearchWriter,
-		marshalizer: arguments.marshalizer,
-		hasher:      arguments.hasher,
+		dbWriter:              elasticsearchWriter,
+		marshalizer:           arguments.marshalizer,
+		hasher:                arguments.hasher,
+		enabledMiniBlockTypes: arguments.enabledMiniBlockTypes,
+		indexedShards:         arguments.indexedShards,
+		metachainIndexingOff:  arguments.metachainIndexingOff,
+		bulkRequestsSlots:     createBulkRequestsSlots(arguments.maxInFlightBulkRequests),
+		bulkLogSampler:        newBulkLogSampler(arguments.bulkLogSamplingRate),
+
+		bulkRequestMaxAttempts: arguments.bulkRequestMaxAttempts,
+		bulkRequestRetryDelay:  arguments.bulkRequestRetryDelay,
+		bulkRequestMaxSize:     arguments.bulkRequestMaxSize,
+		appStatusHandler:       statusHandler.NewNilStatusHandler(),
 	}
 }
 
@@ -45,11 +63,12 @@ func createMockElasticsearchDatabaseArgs() elasticSearchDatabaseArgs {
 	return elasticSearchDatabaseArgs{
 		addressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
 		validatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
-		url:                      "url",
+		urls:                     []string{"url"},
 		userName:                 "username",
 		password:                 "password",
 		hasher:                   &mock.HasherMock{},
 		marshalizer:              &mock.MarshalizerMock{},
+		storeTxData:              true,
 	}
 }
 
@@ -100,7 +119,7 @@ func newTestBlockBody() *dataBlock.Body {
 }
 
 func TestNewElasticSearchDatabase_IndexesError(t *testing.T) {
-	indexes := []string{txIndex, blockIndex, tpsIndex, validatorsIndex, roundIndex}
+	indexes := []string{txIndex, blockIndex, tpsIndex, validatorsIndex, roundIndex, scResultsIndex, logsIndex}
 
 	for _, index := range indexes {
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -110,7 +129,7 @@ func TestNewElasticSearchDatabase_IndexesError(t *testing.T) {
 		}))
 
 		arguments := createMockElasticsearchDatabaseArgs()
-		arguments.url = ts.URL
+		arguments.urls = []string{ts.URL}
 
 		elasticDatabase, err := newElasticSearchDatabase(arguments)
 		require.Nil(t, elasticDatabase)
@@ -118,6 +137,195 @@ func TestNewElasticSearchDatabase_IndexesError(t *testing.T) {
 	}
 }
 
+func TestNewElasticSearchDatabase_IndexCreationShouldRetryUntilItSucceeds(t *testing.T) {
+	numTxIndexCreateRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Path == ("/" + txIndex) {
+			numTxIndexCreateRequests++
+			if numTxIndexCreateRequests <= 2 {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.indexCreationMaxAttempts = 3
+	arguments.indexCreationRetryDelay = time.Millisecond
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+	require.NotNil(t, elasticDatabase)
+	require.Equal(t, 3, numTxIndexCreateRequests)
+}
+
+func TestNewElasticSearchDatabase_IndexCreationShouldErrAfterTheAttemptsAreExhausted(t *testing.T) {
+	numTxIndexCreateRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Path == ("/" + txIndex) {
+			numTxIndexCreateRequests++
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.indexCreationMaxAttempts = 2
+	arguments.indexCreationRetryDelay = time.Millisecond
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, elasticDatabase)
+	require.Equal(t, ErrCannotCreateIndex, err)
+	require.Equal(t, 2, numTxIndexCreateRequests)
+}
+
+func TestNewElasticSearchDatabase_UnreachableFirstUrlShouldUseTheFailoverUrl(t *testing.T) {
+	unreachableServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	unreachableServer.Close()
+
+	numRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{unreachableServer.URL, ts.URL}
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+	require.NotNil(t, elasticDatabase)
+	require.Equal(t, len(getIndexesToCreate()), numRequests)
+}
+
+func TestNewElasticSearchDatabase_IndexCreationShouldSendMapping(t *testing.T) {
+	createBodies := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		createBodies[strings.TrimPrefix(r.URL.Path, "/")] = string(body)
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+	require.NotNil(t, elasticDatabase)
+	require.Equal(t, len(getIndexesToCreate()), len(createBodies))
+
+	txTemplate := make(map[string]interface{})
+	err = json.Unmarshal([]byte(createBodies[txIndex]), &txTemplate)
+	require.Nil(t, err)
+	mappings := txTemplate["mappings"].(map[string]interface{})["_doc"].(map[string]interface{})
+	valueMapping := mappings["properties"].(map[string]interface{})["value"].(map[string]interface{})
+	require.Equal(t, "keyword", valueMapping["type"])
+}
+
+func TestNewElasticSearchDatabase_IndexCreationShouldApplyIndexSettings(t *testing.T) {
+	createBodies := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		createBodies[strings.TrimPrefix(r.URL.Path, "/")] = string(body)
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	numReplicas := uint32(2)
+	arguments.indicesSettings = map[string]IndexSettings{
+		txIndex: {NumberOfShards: 3, NumberOfReplicas: &numReplicas},
+	}
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+	require.NotNil(t, elasticDatabase)
+
+	txTemplate := make(map[string]interface{})
+	err = json.Unmarshal([]byte(createBodies[txIndex]), &txTemplate)
+	require.Nil(t, err)
+	indexSettings := txTemplate["settings"].(map[string]interface{})["index"].(map[string]interface{})
+	require.Equal(t, float64(3), indexSettings["number_of_shards"])
+	require.Equal(t, float64(2), indexSettings["number_of_replicas"])
+	require.Equal(t, "timestamp", indexSettings["sort.field"])
+	require.NotNil(t, txTemplate["mappings"])
+
+	blockTemplate := make(map[string]interface{})
+	err = json.Unmarshal([]byte(createBodies[blockIndex]), &blockTemplate)
+	require.Nil(t, err)
+	indexSettings = blockTemplate["settings"].(map[string]interface{})["index"].(map[string]interface{})
+	require.Equal(t, float64(1), indexSettings["number_of_shards"])
+	require.Equal(t, float64(1), indexSettings["number_of_replicas"])
+}
+
+func TestElasticsearchDatabase_CheckHealthAllIndexesExistShouldWork(t *testing.T) {
+	checkedIndexes := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoIndexExistsRequestCalled: func(index string) (bool, error) {
+			checkedIndexes = append(checkedIndexes, index)
+			return true, nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	err := elasticDatabase.CheckHealth()
+	require.Nil(t, err)
+	require.Equal(t, getIndexesToCreate(), checkedIndexes)
+}
+
+func TestElasticsearchDatabase_CheckHealthMissingIndexShouldErr(t *testing.T) {
+	dbWriter := &mock.DatabaseWriterStub{
+		DoIndexExistsRequestCalled: func(index string) (bool, error) {
+			return index != roundIndex, nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	err := elasticDatabase.CheckHealth()
+	require.True(t, errors.Is(err, ErrMissingIndex))
+	require.True(t, strings.Contains(err.Error(), roundIndex))
+}
+
+func TestElasticsearchDatabase_CheckHealthPingFailureShouldErr(t *testing.T) {
+	localErr := errors.New("connection refused")
+	dbWriter := &mock.DatabaseWriterStub{
+		DoPingRequestCalled: func() error {
+			return localErr
+		},
+		DoIndexExistsRequestCalled: func(index string) (bool, error) {
+			require.Fail(t, "should have not checked the indexes")
+			return false, nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	err := elasticDatabase.CheckHealth()
+	require.True(t, errors.Is(err, ErrElasticSearchUnreachable))
+	require.True(t, strings.Contains(err.Error(), localErr.Error()))
+}
+
 func TestElasticseachDatabaseSaveHeader_RequestError(t *testing.T) {
 	output := &bytes.Buffer{}
 	_ = logger.SetLogLevel("core/indexer:TRACE")
@@ -144,6 +352,90 @@ func TestElasticseachDatabaseSaveHeader_RequestError(t *testing.T) {
 	require.True(t, strings.Contains(output.String(), localErr.Error()))
 }
 
+func TestElasticseachDatabaseSaveHeader_RequestErrorShouldLogStructuredFields(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:TRACE")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	localErr := errors.New("localErr")
+	header := &dataBlock.Header{Nonce: 37, ShardID: 2}
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			return localErr
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveHeader(header, []uint64{0}, &dataBlock.Body{}, nil, 1)
+
+	logOutput := output.String()
+	require.True(t, strings.Contains(logOutput, "index = "+blockIndex))
+	require.True(t, strings.Contains(logOutput, "nonce = 37"))
+	require.True(t, strings.Contains(logOutput, "shardID = 2"))
+	require.True(t, strings.Contains(logOutput, "numDocs = 1"))
+}
+
+func TestElasticseachDatabaseSaveRoundInfo_RequestErrorShouldLogStructuredFields(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:TRACE")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	localErr := errors.New("localErr")
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			return localErr
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveRoundInfo(RoundInfo{Index: 41, ShardId: 2})
+
+	logOutput := output.String()
+	require.True(t, strings.Contains(logOutput, "error = "+localErr.Error()))
+	require.True(t, strings.Contains(logOutput, "index = "+roundIndex))
+	require.True(t, strings.Contains(logOutput, "round = 41"))
+	require.True(t, strings.Contains(logOutput, "shardID = 2"))
+	require.True(t, strings.Contains(logOutput, "numDocs = 1"))
+}
+
+func TestElasticseachDatabaseSaveTransactions_RequestErrorShouldLogStructuredFields(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:TRACE")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	localErr := errors.New("localErr")
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return localErr
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 38, ShardID: 1}, newTestTxPool(), 1)
+
+	logOutput := output.String()
+	require.True(t, strings.Contains(logOutput, localErr.Error()))
+	require.True(t, strings.Contains(logOutput, "index = "+txIndex))
+	require.True(t, strings.Contains(logOutput, "nonce = 38"))
+	require.True(t, strings.Contains(logOutput, "shardID = 1"))
+	require.True(t, strings.Contains(logOutput, "numDocs = 3"))
+}
+
 func TestElasticseachDatabaseSaveHeader_CheckRequestBody(t *testing.T) {
 	header := &dataBlock.Header{Nonce: 1}
 	signerIndexes := []uint64{0, 1}
@@ -179,6 +471,121 @@ func TestElasticseachDatabaseSaveHeader_CheckRequestBody(t *testing.T) {
 	elasticDatabase.SaveHeader(header, signerIndexes, blockBody, nil, 1)
 }
 
+func TestElasticseachDatabaseSaveHeaderWithHashes_ShouldUseTheProvidedHashes(t *testing.T) {
+	header := &dataBlock.Header{Nonce: 1}
+	signerIndexes := []uint64{0, 1}
+	blockBody := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{Type: dataBlock.TxBlock},
+			{Type: dataBlock.SmartContractResultBlock},
+		},
+	}
+	miniBlocksHashes := [][]byte{[]byte("mb hash 1"), []byte("mb hash 2")}
+	headerHash := []byte("header hash")
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	numRequests := 0
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			numRequests++
+			require.Equal(t, hex.EncodeToString(headerHash), req.DocumentID)
+
+			var block Block
+			blockBytes, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(blockBytes, &block)
+			require.Equal(t, []string{hex.EncodeToString(miniBlocksHashes[0]), hex.EncodeToString(miniBlocksHashes[1])}, block.MiniBlocksHashes)
+			require.Equal(t, []string{"notarized"}, block.NotarizedBlocksHashes)
+
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveHeaderWithHashes(header, signerIndexes, blockBody, miniBlocksHashes, []string{"notarized"}, headerHash, 1)
+	require.Equal(t, 1, numRequests)
+}
+
+func TestElasticseachDatabaseSaveHeaderWithHashes_ShouldIndexTheMiniBlocksTypes(t *testing.T) {
+	t.Parallel()
+
+	miniBlocksHashes := [][]byte{[]byte("mb hash 1"), []byte("mb hash 2"), []byte("mb hash 3")}
+	header := &dataBlock.Header{
+		Nonce: 1,
+		MiniBlockHeaders: []dataBlock.MiniBlockHeader{
+			{Hash: miniBlocksHashes[1], Type: dataBlock.ReceiptBlock},
+			{Hash: miniBlocksHashes[0], Type: dataBlock.RewardsBlock},
+		},
+	}
+	blockBodyWithAllMiniBlocks := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{Type: dataBlock.TxBlock},
+			{Type: dataBlock.SmartContractResultBlock},
+			{Type: dataBlock.RewardsBlock},
+		},
+	}
+	blockBodyWithOneMiniBlock := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{{Type: dataBlock.TxBlock}},
+	}
+
+	var block Block
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			blockBytes, _ := ioutil.ReadAll(req.Body)
+			block = Block{}
+			return json.Unmarshal(blockBytes, &block)
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+
+	elasticDatabase.SaveHeaderWithHashes(header, []uint64{0}, blockBodyWithAllMiniBlocks, miniBlocksHashes, nil, []byte("hash"), 1)
+	require.Equal(t, []MiniBlockInfo{
+		{Hash: hex.EncodeToString(miniBlocksHashes[0]), Type: "TxBlock"},
+		{Hash: hex.EncodeToString(miniBlocksHashes[1]), Type: "SmartContractResultBlock"},
+		{Hash: hex.EncodeToString(miniBlocksHashes[2]), Type: "RewardsBlock"},
+	}, block.MiniBlocks)
+	require.Equal(t, 3, len(block.MiniBlocksHashes))
+
+	elasticDatabase.SaveHeaderWithHashes(header, []uint64{0}, blockBodyWithOneMiniBlock, miniBlocksHashes[:2], nil, []byte("hash"), 1)
+	require.Equal(t, []MiniBlockInfo{
+		{Hash: hex.EncodeToString(miniBlocksHashes[0]), Type: "RewardsBlock"},
+		{Hash: hex.EncodeToString(miniBlocksHashes[1]), Type: "ReceiptBlock"},
+	}, block.MiniBlocks)
+}
+
+func TestElasticseachDatabaseSaveHeader_ShouldSetSize(t *testing.T) {
+	header := &dataBlock.Header{Nonce: 1, RootHash: []byte("root hash")}
+	signerIndexes := []uint64{0, 1}
+	blockBody := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{Type: dataBlock.TxBlock, TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}},
+		},
+	}
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	headerBytes, _ := arguments.marshalizer.Marshal(header)
+	bodyBytes, _ := arguments.marshalizer.Marshal(blockBody)
+
+	var block Block
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			blockBytes, _ := ioutil.ReadAll(req.Body)
+			return json.Unmarshal(blockBytes, &block)
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveHeader(header, signerIndexes, blockBody, nil, 1)
+	require.Equal(t, int64(len(headerBytes)+len(bodyBytes)), block.Size)
+	require.False(t, block.IsHeaderSizeOnly)
+
+	block = Block{}
+	elasticDatabase.SaveHeader(header, signerIndexes, nil, nil, 1)
+	require.Equal(t, int64(len(headerBytes)), block.Size)
+	require.True(t, block.IsHeaderSizeOnly)
+	require.Equal(t, 0, len(block.MiniBlocksHashes))
+}
+
 func TestElasticseachSaveTransactions(t *testing.T) {
 	output := &bytes.Buffer{}
 	_ = logger.SetLogLevel("core/indexer:TRACE")
@@ -206,6 +613,48 @@ func TestElasticseachSaveTransactions(t *testing.T) {
 	require.True(t, strings.Contains(output.String(), "indexing bulk of transactions"))
 }
 
+func TestElasticsearchSaveTransactions_SuccessfulBulkShouldLogTheNumberOfDocs(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:TRACE")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	elasticDatabase := newTestElasticSearchDatabase(&mock.DatabaseWriterStub{}, arguments)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1, TxCount: 3}, newTestTxPool(), 0)
+
+	logged := output.String()
+	require.True(t, strings.Contains(logged, "indexed bulk of transactions"))
+	require.True(t, strings.Contains(logged, "numDocs = 3"))
+	require.True(t, strings.Contains(logged, "sizeInBytes"))
+}
+
+func TestElasticsearchSaveTransactions_SamplingShouldReduceTheNumberOfLoggedBulks(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:TRACE")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkLogSamplingRate = 3
+	elasticDatabase := newTestElasticSearchDatabase(&mock.DatabaseWriterStub{}, arguments)
+
+	numBulks := 7
+	for i := 0; i < numBulks; i++ {
+		elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: uint64(i)}, newTestTxPool(), 0)
+	}
+
+	require.Equal(t, 3, strings.Count(output.String(), "indexed bulk of transactions"))
+}
+
 func TestElasticsearch_saveShardValidatorsPubKeys_RequestError(t *testing.T) {
 	output := &bytes.Buffer{}
 	_ = logger.SetLogLevel("core/indexer:TRACE")
@@ -247,6 +696,29 @@ func TestElasticsearch_saveShardValidatorsPubKeys(t *testing.T) {
 	elasticDatabase.SaveShardValidatorsPubKeys(shardId, epoch, valPubKeys)
 }
 
+func TestElasticsearch_saveShardValidatorsPubKeysShouldKeepTheEligibleListOrder(t *testing.T) {
+	shardId := uint32(1)
+	epoch := uint32(2)
+	valPubKeys := [][]byte{[]byte("key2"), []byte("key3"), []byte("key1")}
+	arguments := createMockElasticsearchDatabaseArgs()
+	var indexedPubKeys ValidatorsPublicKeys
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			body, err := ioutil.ReadAll(req.Body)
+			require.Nil(t, err)
+			return json.Unmarshal(body, &indexedPubKeys)
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveShardValidatorsPubKeys(shardId, epoch, valPubKeys)
+
+	require.Equal(t, len(valPubKeys), len(indexedPubKeys.PublicKeys))
+	for i, valPubKey := range valPubKeys {
+		require.Equal(t, arguments.validatorPubkeyConverter.Encode(valPubKey), indexedPubKeys.PublicKeys[i])
+	}
+}
+
 func TestElasticsearch_saveShardStatistics_reqError(t *testing.T) {
 	output := &bytes.Buffer{}
 	_ = logger.SetLogLevel("core/indexer:TRACE")
@@ -298,217 +770,2017 @@ func TestElasticsearch_saveShardStatistics(t *testing.T) {
 	elasticDatabase.SaveShardStatistics(tpsBenchmark)
 }
 
-func TestElasticsearch_saveRoundInfo(t *testing.T) {
-	roundInfo := RoundInfo{
-		Index: 1, ShardId: 0, BlockWasProposed: true,
-	}
+func TestElasticsearchSaveTransactions_FailedBulkShouldBeRetried(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:DEBUG")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	numCalls := 0
 	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 3
+	arguments.bulkRequestRetryDelay = time.Millisecond
 	dbWriter := &mock.DatabaseWriterStub{
-		DoRequestCalled: func(req *esapi.IndexRequest) error {
-			require.Equal(t, strconv.FormatUint(uint64(roundInfo.ShardId), 10)+"_"+strconv.FormatUint(roundInfo.Index, 10), req.DocumentID)
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numCalls++
+			if numCalls < 3 {
+				return errors.New("service unavailable")
+			}
 			return nil
 		},
 	}
 
 	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
-	elasticDatabase.SaveRoundInfo(roundInfo)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 3, numCalls)
+	require.True(t, strings.Contains(output.String(), "bulk request succeeded after retry"))
+	require.True(t, strings.Contains(output.String(), "attempt = 3"))
+	require.False(t, strings.Contains(output.String(), "error indexing bulk of transactions"))
 }
 
-func TestElasticsearch_saveRoundInfoRequestError(t *testing.T) {
-	output := &bytes.Buffer{}
-	_ = logger.SetLogLevel("core/indexer:TRACE")
-	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+func TestElasticsearchDatabase_BufferedBulkShouldBeRetriedWhenFlushed(t *testing.T) {
+	t.Parallel()
 
-	roundInfo := RoundInfo{}
-	localError := errors.New("local err")
+	numCalls := 0
 	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 3
+	arguments.bulkRequestRetryDelay = time.Millisecond
 	dbWriter := &mock.DatabaseWriterStub{
-		DoRequestCalled: func(req *esapi.IndexRequest) error {
-			return localError
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numCalls++
+			if numCalls < 3 {
+				return errors.New("service unavailable")
+			}
+			return nil
 		},
 	}
 
 	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
-	elasticDatabase.SaveRoundInfo(roundInfo)
-
-	defer func() {
-		_ = logger.RemoveLogObserver(output)
-		_ = logger.SetLogLevel("core/indexer:INFO")
-	}()
+	elasticDatabase.bufferBulkRequests(dbWriter, time.Hour)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+	require.Equal(t, 0, numCalls)
 
-	require.True(t, strings.Contains(output.String(), localError.Error()))
+	_ = elasticDatabase.Close()
+	require.Equal(t, 3, numCalls)
 }
 
-func TestUpdateMiniBlock(t *testing.T) {
-	t.Skip("test must run only if you have an elasticsearch server on address http://localhost:9200")
+func TestElasticsearchSaveTransactions_RejectedBulkShouldNotBeRetried(t *testing.T) {
+	t.Parallel()
 
-	args := elasticSearchDatabaseArgs{
-		url:         "http://localhost:9200",
-		userName:    "basic_auth_username",
-		password:    "basic_auth_password",
-		marshalizer: &mock.MarshalizerMock{},
+	numCalls := 0
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 3
+	arguments.bulkRequestRetryDelay = time.Millisecond
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numCalls++
+			return ErrBulkRequestRejected
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 1, numCalls)
+}
+
+func TestElasticsearch_saveShardStatisticsShouldStopRetryingAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark := &mock.TpsBenchmarkMock{}
+	metaBlock := &dataBlock.MetaBlock{
+		TxCount: 2, Nonce: 1,
+		ShardInfo: []dataBlock.ShardData{{HeaderHash: []byte("hash")}},
+	}
+	tpsBenchmark.UpdateWithShardStats(metaBlock)
+
+	numCalls := 0
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 2
+	arguments.bulkRequestRetryDelay = time.Millisecond
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numCalls++
+			return errors.New("service unavailable")
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveShardStatistics(tpsBenchmark)
+
+	require.Equal(t, 2*len(tpsBenchmark.ShardStatistics()), numCalls)
+}
+
+func TestElasticsearchSaveTransactions_ThrottledBulkShouldBeResent(t *testing.T) {
+	numBulkRequests := 0
+	bulkBodies := make([]string, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+txIndex+"/_bulk" {
+			return
+		}
+
+		numBulkRequests++
+		body, _ := ioutil.ReadAll(r.Body)
+		bulkBodies = append(bulkBodies, string(body))
+		if numBulkRequests <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.bulkRequestMaxAttempts = 1
+	arguments.throttlingMaxRetries = 3
+	arguments.throttlingRetryDelay = time.Millisecond
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 3, numBulkRequests)
+	require.NotEmpty(t, bulkBodies[0])
+	require.Equal(t, bulkBodies[0], bulkBodies[2])
+}
+
+func TestElasticsearchSaveTransactions_ThrottledBulkShouldStopAfterMaxRetries(t *testing.T) {
+	numBulkRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+txIndex+"/_bulk" {
+			numBulkRequests++
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.bulkRequestMaxAttempts = 1
+	arguments.throttlingMaxRetries = 2
+	arguments.throttlingRetryDelay = time.Millisecond
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 3, numBulkRequests)
+}
+
+func TestElasticsearchSaveTransactions_ThrottledBulkItemsShouldBeResentAlone(t *testing.T) {
+	bulkBodies := make([][]byte, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+txIndex+"/_bulk" {
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bulkBodies = append(bulkBodies, body)
+		if len(bulkBodies) > 1 {
+			_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+			return
+		}
+
+		items, _ := splitBulkItems(body)
+		response := bulkResponse{Errors: true}
+		for idx := range items {
+			status := http.StatusCreated
+			if idx == 1 {
+				status = http.StatusTooManyRequests
+			}
+			response.Items = append(response.Items, map[string]bulkResponseItem{"index": {Status: status}})
+		}
+		responseBytes, _ := json.Marshal(response)
+		_, _ = w.Write(responseBytes)
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.bulkRequestMaxAttempts = 1
+	arguments.throttlingMaxRetries = 3
+	arguments.throttlingRetryDelay = time.Millisecond
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 2, len(bulkBodies))
+	items, err := splitBulkItems(bulkBodies[0])
+	require.Nil(t, err)
+	require.True(t, len(items) > 1)
+	require.Equal(t, string(items[1]), string(bulkBodies[1]))
+}
+
+func TestElasticsearchSaveTransactions_ThrottledBulkShouldNotBeRetriedByTheRetryLoop(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 3
+	arguments.bulkRequestRetryDelay = time.Millisecond
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numCalls++
+			return fmt.Errorf("%w, index: %s", ErrTooManyRequests, index)
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 1, numCalls)
+}
+
+func TestElasticsearchSaveHeader_ThrottledRequestShouldBeResent(t *testing.T) {
+	headerBodies := make([]string, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/"+blockIndex+"/_doc/") {
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		headerBodies = append(headerBodies, string(body))
+		if len(headerBodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.throttlingMaxRetries = 1
+	arguments.throttlingRetryDelay = time.Millisecond
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+
+	elasticDatabase.SaveHeader(&dataBlock.Header{Nonce: 1}, []uint64{0}, &dataBlock.Body{}, nil, 0)
+
+	require.Equal(t, 2, len(headerBodies))
+	require.NotEmpty(t, headerBodies[0])
+	require.Equal(t, headerBodies[0], headerBodies[1])
+}
+
+func TestElasticsearchSaveTransactions_ThrottledBulkShouldBeResentByTheWriter(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	retryDelays := make([]time.Duration, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numCalls++
+			if numCalls <= 3 {
+				return fmt.Errorf("%w, index: %s", ErrTooManyRequests, index)
+			}
+			return nil
+		},
+	}
+	throttledDbWriter := newThrottledWriter(dbWriter, 5, time.Millisecond)
+	throttledDbWriter.sleep = func(duration time.Duration) {
+		retryDelays = append(retryDelays, duration)
+	}
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 1
+	elasticDatabase := newTestElasticSearchDatabase(throttledDbWriter, arguments)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	require.Equal(t, 4, numCalls)
+	require.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond}, retryDelays)
+}
+
+func TestElasticsearchSaveTransactions_ScResultsShouldBeIndexedInTheirIndex(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	scHash := []byte("scHash")
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{TxHashes: [][]byte{txHash}, Type: dataBlock.TxBlock},
+			{TxHashes: [][]byte{scHash}, Type: dataBlock.SmartContractResultBlock},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash): &transaction.Transaction{Value: big.NewInt(0)},
+		string(scHash): &smartContractResult.SmartContractResult{Value: big.NewInt(0), OriginalTxHash: txHash},
+	}
+
+	bulks := make(map[string]string)
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulks[index] += buff.String()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveTransactions(body, &dataBlock.Header{Nonce: 1}, txPool, 0)
+
+	require.Contains(t, bulks[txIndex], hex.EncodeToString(txHash))
+	require.Contains(t, bulks[scResultsIndex], `"_id" : "`+hex.EncodeToString(scHash)+`"`)
+	require.Contains(t, bulks[scResultsIndex], `"originalTxHash":"`+hex.EncodeToString(txHash)+`"`)
+}
+
+func TestElasticsearchDatabase_SaveLogsShouldIndexTheLogsDocuments(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	logs := map[string]data.LogHandler{
+		string(txHash): &transaction.Log{
+			Address: []byte("scAddr"),
+			Events: []*transaction.Event{
+				{
+					Address:    []byte("addr"),
+					Identifier: []byte("transfer"),
+					Topics:     [][]byte{[]byte("t1"), []byte("t2")},
+					Data:       []byte("dt"),
+				},
+			},
+		},
+	}
+
+	bulks := make(map[string]string)
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulks[index] += buff.String()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveLogs(logs)
+
+	lines := strings.Split(strings.TrimSpace(bulks[logsIndex]), "\n")
+	require.Equal(t, 2, len(lines))
+	require.Contains(t, lines[0], `"_id" : "`+hex.EncodeToString(txHash)+`"`)
+
+	logDocument := &LogDocument{}
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), logDocument))
+	expectedLogDocument := &LogDocument{
+		TxHash:  hex.EncodeToString(txHash),
+		Address: arguments.addressPubkeyConverter.Encode([]byte("scAddr")),
+		Events: []Event{
+			{
+				Address:    arguments.addressPubkeyConverter.Encode([]byte("addr")),
+				Identifier: "transfer",
+				Topics:     []string{hex.EncodeToString([]byte("t1")), hex.EncodeToString([]byte("t2"))},
+				Data:       hex.EncodeToString([]byte("dt")),
+			},
+		},
+	}
+	require.Equal(t, expectedLogDocument, logDocument)
+}
+
+func TestElasticsearchDatabase_SaveLogsEmptyMapShouldNotIndex(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveLogs(nil)
+	elasticDatabase.SaveLogs(make(map[string]data.LogHandler))
+}
+
+func TestElasticsearchDatabase_SaveLogsIndexingQueueShouldSendTheBulksOnTheWorkers(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	numBulkRequests := 0
+	releaseRequests := make(chan struct{})
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			<-releaseRequests
+
+			mut.Lock()
+			numBulkRequests++
+			mut.Unlock()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.indexingQueue = newIndexingQueue(10, 1)
+
+	// the call returns while the bulk request is still pending
+	elasticDatabase.SaveLogs(map[string]data.LogHandler{
+		"txHash": &transaction.Log{Address: []byte("scAddr")},
+	})
+	mut.Lock()
+	require.Equal(t, 0, numBulkRequests)
+	mut.Unlock()
+
+	close(releaseRequests)
+	require.Nil(t, elasticDatabase.Close())
+	mut.Lock()
+	require.Equal(t, 1, numBulkRequests)
+	mut.Unlock()
+}
+
+func TestElasticsearchSaveTransactions_IndexingQueueShouldSendTheBulksOnTheWorkers(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	numBulkRequests := 0
+	releaseRequests := make(chan struct{})
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			<-releaseRequests
+
+			mut.Lock()
+			numBulkRequests++
+			mut.Unlock()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.indexingQueue = newIndexingQueue(10, 1)
+
+	// the call returns while the bulk request is still pending
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+	mut.Lock()
+	require.Equal(t, 0, numBulkRequests)
+	mut.Unlock()
+
+	close(releaseRequests)
+	require.Nil(t, elasticDatabase.Close())
+	mut.Lock()
+	require.Equal(t, 1, numBulkRequests)
+	mut.Unlock()
+}
+
+func TestElasticsearchDatabase_RemoveHeaderShouldDeleteByTheHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	header := &dataBlock.Header{Nonce: 1, ShardID: 0}
+	arguments := createMockElasticsearchDatabaseArgs()
+	expectedHash, _ := core.CalculateHash(arguments.marshalizer, arguments.hasher, header)
+
+	called := false
+	dbWriter := &mock.DatabaseWriterStub{
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			called = true
+			require.Equal(t, blockIndex, index)
+			require.Equal(t, []string{hex.EncodeToString(expectedHash)}, ids)
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.RemoveHeader(header)
+	require.True(t, called)
+}
+
+func TestElasticsearchDatabase_RemoveHeaderShouldRunAfterTheQueuedSave(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	requests := make([]string, 0)
+	releaseSave := make(chan struct{})
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			<-releaseSave
+
+			mut.Lock()
+			requests = append(requests, "save")
+			mut.Unlock()
+			return nil
+		},
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			mut.Lock()
+			requests = append(requests, "delete")
+			mut.Unlock()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.indexingQueue = newIndexingQueue(10, 2)
+
+	header := &dataBlock.Header{Nonce: 1, ShardID: 0}
+	elasticDatabase.SaveHeader(header, []uint64{0}, &dataBlock.Body{}, nil, 1)
+	elasticDatabase.RemoveHeader(header)
+
+	// the second worker is free, but the removal waits for the pending save
+	time.Sleep(time.Millisecond * 50)
+	mut.Lock()
+	require.Equal(t, 0, len(requests))
+	mut.Unlock()
+
+	close(releaseSave)
+	require.Nil(t, elasticDatabase.Close())
+	mut.Lock()
+	require.Equal(t, []string{"save", "delete"}, requests)
+	mut.Unlock()
+}
+
+func TestElasticsearchDatabase_RemoveTransactionsShouldDeleteTheSentTxsAndRevertTheReceivedOnes(t *testing.T) {
+	t.Parallel()
+
+	deletedIds := make(map[string][]string)
+	updatesBuff := ""
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			deletedIds[index] = append(deletedIds[index], ids...)
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, txIndex, index)
+			updatesBuff += buff.String()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.RemoveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1, ShardID: 2})
+
+	expectedIds := []string{
+		hex.EncodeToString([]byte("tx1")),
+		hex.EncodeToString([]byte("tx2")),
+	}
+	require.Equal(t, expectedIds, deletedIds[txIndex])
+	require.Equal(t, expectedIds, deletedIds[logsIndex])
+	require.Equal(t, 0, len(deletedIds[scResultsIndex]))
+
+	// tx3 was received from shard 1, so its document is kept and only its status is reverted
+	expectedUpdates := fmt.Sprintf(`{ "update" : { "_id" : "%s", "_type" : "_doc"  } }`, hex.EncodeToString([]byte("tx3"))) +
+		"\n" + `{"doc":{"status":"Pending"}}` + "\n"
+	require.Equal(t, expectedUpdates, updatesBuff)
+}
+
+func TestElasticsearchDatabase_RemoveTransactionsShouldRunAfterTheQueuedSave(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	requests := make([]string, 0)
+	releaseSave := make(chan struct{})
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			if strings.Contains(buff.String(), `"update"`) {
+				return nil
+			}
+			<-releaseSave
+
+			mut.Lock()
+			requests = append(requests, "save "+index)
+			mut.Unlock()
+			return nil
+		},
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			mut.Lock()
+			requests = append(requests, "delete "+index)
+			mut.Unlock()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.indexingQueue = newIndexingQueue(10, 2)
+
+	header := &dataBlock.Header{Nonce: 1, ShardID: 2}
+	elasticDatabase.SaveTransactions(newTestBlockBody(), header, newTestTxPool(), 2)
+	elasticDatabase.RemoveTransactions(newTestBlockBody(), header)
+
+	// the second worker is free, but the removal waits for the pending save
+	time.Sleep(time.Millisecond * 50)
+	mut.Lock()
+	require.Equal(t, 0, len(requests))
+	mut.Unlock()
+
+	close(releaseSave)
+	require.Nil(t, elasticDatabase.Close())
+	mut.Lock()
+	require.Equal(t, []string{"save " + txIndex, "delete " + txIndex, "delete " + logsIndex}, requests)
+	mut.Unlock()
+}
+
+func TestElasticsearchDatabase_RemoveTransactionsNotIndexedShardShouldNotDelete(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.indexedShards = map[uint32]struct{}{1: {}}
+	dbWriter := &mock.DatabaseWriterStub{
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.RemoveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1, ShardID: 0})
+	elasticDatabase.RemoveHeader(&dataBlock.Header{Nonce: 1, ShardID: 0})
+}
+
+func TestElasticsearch_saveRoundInfo(t *testing.T) {
+	roundInfo := RoundInfo{
+		Index: 1, ShardId: 0, BlockWasProposed: true,
+	}
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			require.Equal(t, roundIndex, req.Index)
+			require.Equal(t, strconv.FormatUint(uint64(roundInfo.ShardId), 10)+"_"+strconv.FormatUint(roundInfo.Index, 10), req.DocumentID)
+			require.Equal(t, "true", req.Refresh)
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveRoundInfo(roundInfo)
+}
+
+func TestElasticsearch_saveRoundInfoRequestError(t *testing.T) {
+	output := &bytes.Buffer{}
+	_ = logger.SetLogLevel("core/indexer:TRACE")
+	_ = logger.AddLogObserver(output, &logger.PlainFormatter{})
+
+	roundInfo := RoundInfo{}
+	localError := errors.New("local err")
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			return localError
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveRoundInfo(roundInfo)
+
+	defer func() {
+		_ = logger.RemoveLogObserver(output)
+		_ = logger.SetLogLevel("core/indexer:INFO")
+	}()
+
+	require.True(t, strings.Contains(output.String(), localError.Error()))
+}
+
+func TestElasticsearch_MetricsWriterShouldReceiveTheMetricsIndexes(t *testing.T) {
+	primaryIndexes := make([]string, 0)
+	primaryWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			primaryIndexes = append(primaryIndexes, index)
+			return nil
+		},
+	}
+	metricsIndexes := make([]string, 0)
+	metricsWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			metricsIndexes = append(metricsIndexes, index)
+			return nil
+		},
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			metricsIndexes = append(metricsIndexes, req.Index)
+			return nil
+		},
+	}
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	elasticDatabase := newTestElasticSearchDatabase(newMetricsRoutingWriter(primaryWriter, metricsWriter), arguments)
+
+	elasticDatabase.SaveRoundInfo(RoundInfo{Index: 1})
+
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(1)}
+	txHash := "txHash"
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{Type: dataBlock.TxBlock, TxHashes: [][]byte{[]byte(txHash)}},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{txHash: tx}
+	elasticDatabase.SaveTransactions(body, &dataBlock.Header{}, txPool, 0)
+
+	require.Equal(t, []string{roundIndex}, metricsIndexes)
+	require.Equal(t, []string{txIndex}, primaryIndexes)
+}
+
+func TestElasticsearch_saveRoundsInfoShouldDoOneBulkRequest(t *testing.T) {
+	roundsInfos := []RoundInfo{
+		{Index: 5, ShardId: 1, BlockWasProposed: false},
+		{Index: 6, ShardId: 1, BlockWasProposed: false},
+		{Index: 7, ShardId: 1, BlockWasProposed: true},
+	}
+	arguments := createMockElasticsearchDatabaseArgs()
+	numBulkRequests := 0
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numBulkRequests++
+			require.Equal(t, roundIndex, index)
+			for _, info := range roundsInfos {
+				expectedID := fmt.Sprintf("%d_%d", info.ShardId, info.Index)
+				require.True(t, strings.Contains(buff.String(), `"_id" : "`+expectedID+`"`))
+			}
+			return nil
+		},
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			require.Fail(t, "round info should not be indexed one by one")
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveRoundsInfo(roundsInfos)
+
+	require.Equal(t, 1, numBulkRequests)
+}
+
+func TestElasticsearch_saveRoundInfoMissedRoundShouldRecordExpectedProposer(t *testing.T) {
+	roundInfo := RoundInfo{
+		Index:            10,
+		BlockWasProposed: false,
+		ShardId:          1,
+		Epoch:            2,
+		Randomness:       []byte("randomness"),
+	}
+	consensusGroup := []string{"proposer", "validator"}
+	arguments := createMockElasticsearchDatabaseArgs()
+	var indexedRoundInfo RoundInfo
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			roundInfoBytes, _ := ioutil.ReadAll(req.Body)
+			return json.Unmarshal(roundInfoBytes, &indexedRoundInfo)
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.nodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			require.Equal(t, roundInfo.Randomness, randomness)
+			require.Equal(t, roundInfo.Index, round)
+			require.Equal(t, roundInfo.ShardId, shardId)
+			require.Equal(t, roundInfo.Epoch, epoch)
+			return consensusGroup, nil
+		},
+	}
+	elasticDatabase.SaveRoundInfo(roundInfo)
+
+	expectedProposer := arguments.validatorPubkeyConverter.Encode([]byte(consensusGroup[0]))
+	require.Equal(t, expectedProposer, indexedRoundInfo.Proposer)
+	require.Equal(t, 2, len(indexedRoundInfo.ConsensusGroup))
+	require.False(t, indexedRoundInfo.BlockWasProposed)
+}
+
+func TestElasticsearch_saveRoundInfoUnresolvedConsensusGroupShouldOmitFields(t *testing.T) {
+	roundInfo := RoundInfo{
+		Index:      10,
+		Randomness: []byte("randomness"),
+	}
+	arguments := createMockElasticsearchDatabaseArgs()
+	var body []byte
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			body, _ = ioutil.ReadAll(req.Body)
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.nodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			return nil, errors.New("epoch not found")
+		},
+	}
+	elasticDatabase.SaveRoundInfo(roundInfo)
+
+	require.NotEmpty(t, body)
+	require.False(t, strings.Contains(string(body), "proposer"))
+	require.False(t, strings.Contains(string(body), "consensusGroup"))
+}
+
+func TestElasticsearchSaveHeader_ShouldIndexTheConsensusLeaderAsProposerPubKey(t *testing.T) {
+	header := &dataBlock.Header{Nonce: 1, Round: 5, ShardID: 1, Epoch: 2, PrevRandSeed: []byte("prev rand seed")}
+	consensusGroup := []string{"validator0", "validator1", "validator2"}
+	arguments := createMockElasticsearchDatabaseArgs()
+	var indexedBlock Block
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			blockBytes, _ := ioutil.ReadAll(req.Body)
+			return json.Unmarshal(blockBytes, &indexedBlock)
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.nodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			require.Equal(t, header.PrevRandSeed, randomness)
+			require.Equal(t, header.Round, round)
+			require.Equal(t, header.ShardID, shardId)
+			require.Equal(t, header.Epoch, epoch)
+			return consensusGroup, nil
+		},
+	}
+	// the first signer differs from the leader of the consensus group
+	elasticDatabase.SaveHeader(header, []uint64{1, 0, 2}, &dataBlock.Body{}, nil, 0)
+
+	expectedProposer := arguments.validatorPubkeyConverter.Encode([]byte(consensusGroup[0]))
+	require.Equal(t, expectedProposer, indexedBlock.ProposerPubKey)
+	require.Equal(t, uint64(1), indexedBlock.Proposer)
+}
+
+func TestElasticsearchSaveHeader_UnresolvedProposerShouldOmitTheProposerPubKey(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+	var body []byte
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			body, _ = ioutil.ReadAll(req.Body)
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.nodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			return nil, errors.New("epoch not found")
+		},
+	}
+	elasticDatabase.SaveHeader(&dataBlock.Header{Nonce: 1}, []uint64{0}, &dataBlock.Body{}, nil, 0)
+
+	require.NotEmpty(t, body)
+	require.False(t, strings.Contains(string(body), "proposerPubKey"))
+}
+
+func TestElasticsearch_SaveBlockDataOnlyIndexedShardsShouldBeWritten(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.indexedShards = map[uint32]struct{}{0: {}}
+	arguments.metachainIndexingOff = true
+	writtenIndexes := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			writtenIndexes = append(writtenIndexes, req.Index)
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			writtenIndexes = append(writtenIndexes, index)
+			return nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+
+	txHash := "txHash"
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{Type: dataBlock.TxBlock, TxHashes: [][]byte{[]byte(txHash)}, SenderShardID: 1, ReceiverShardID: 1},
+		},
+	}
+	createTxPool := func() map[string]data.TransactionHandler {
+		return map[string]data.TransactionHandler{txHash: &transaction.Transaction{Value: big.NewInt(1)}}
+	}
+	saveBlockData := func(header data.HeaderHandler) {
+		elasticDatabase.SaveHeader(header, []uint64{0}, body, nil, 0)
+		elasticDatabase.SaveMiniblocks(header, body)
+		elasticDatabase.SaveTransactions(body, header, createTxPool(), header.GetShardID())
+	}
+
+	saveBlockData(&dataBlock.Header{ShardID: 1})
+	saveBlockData(&dataBlock.MetaBlock{})
+	require.Equal(t, 0, len(writtenIndexes))
+
+	body.MiniBlocks[0].SenderShardID = 0
+	body.MiniBlocks[0].ReceiverShardID = 0
+	saveBlockData(&dataBlock.Header{ShardID: 0})
+	require.Equal(t, []string{blockIndex, miniblocksIndex, txIndex}, writtenIndexes)
+}
+
+func TestElasticsearch_SaveMiniblocksOnlyEnabledTypesShouldBeIndexed(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.enabledMiniBlockTypes = map[dataBlock.Type]struct{}{
+		dataBlock.TxBlock: {},
+	}
+
+	txMiniBlock := &dataBlock.MiniBlock{Type: dataBlock.TxBlock, TxHashes: [][]byte{[]byte("tx1")}}
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			txMiniBlock,
+			{Type: dataBlock.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr1")}},
+			{Type: dataBlock.ReceiptBlock, TxHashes: [][]byte{[]byte("rec1")}},
+		},
+	}
+	txMbHash, _ := core.CalculateHash(arguments.marshalizer, arguments.hasher, txMiniBlock)
+
+	numBulkRequests := 0
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numBulkRequests++
+			require.Equal(t, miniblocksIndex, index)
+
+			bulkContent := buff.String()
+			require.Equal(t, 1, strings.Count(bulkContent, `"index"`))
+			require.True(t, strings.Contains(bulkContent, hex.EncodeToString(txMbHash)))
+			require.False(t, strings.Contains(bulkContent, dataBlock.SmartContractResultBlock.String()))
+			require.False(t, strings.Contains(bulkContent, dataBlock.ReceiptBlock.String()))
+
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveMiniblocks(&dataBlock.Header{}, body)
+	require.Equal(t, 1, numBulkRequests)
+}
+
+func TestElasticsearch_SaveMiniblocksShouldCheckTheCrossShardMiniblocksExistence(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+
+	sentMiniBlock := &dataBlock.MiniBlock{SenderShardID: 0, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("tx1")}}
+	receivedMiniBlock := &dataBlock.MiniBlock{SenderShardID: 1, ReceiverShardID: 0, TxHashes: [][]byte{[]byte("tx2")}}
+	intraShardMiniBlock := &dataBlock.MiniBlock{SenderShardID: 0, ReceiverShardID: 0, TxHashes: [][]byte{[]byte("tx3")}}
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{sentMiniBlock, receivedMiniBlock, intraShardMiniBlock},
+	}
+	sentMbHash, _ := core.CalculateHash(arguments.marshalizer, arguments.hasher, sentMiniBlock)
+	receivedMbHash, _ := core.CalculateHash(arguments.marshalizer, arguments.hasher, receivedMiniBlock)
+
+	checkedMbs := make([]string, 0)
+	numBulkRequests := 0
+	dbWriter := &mock.DatabaseWriterStub{
+		DoExistsRequestCalled: func(index string, id string) (bool, error) {
+			require.Equal(t, miniblocksIndex, index)
+			checkedMbs = append(checkedMbs, id)
+
+			return id == hex.EncodeToString(sentMbHash), nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numBulkRequests++
+
+			bulkContent := buff.String()
+			require.Equal(t, 2, strings.Count(bulkContent, `"index"`))
+			require.Equal(t, 1, strings.Count(bulkContent, `"update"`))
+			require.True(t, strings.Contains(bulkContent, `{ "doc" : { "senderBlockHash"`))
+
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveMiniblocks(&dataBlock.Header{}, body)
+	require.Equal(t, 1, numBulkRequests)
+	require.Equal(t, []string{hex.EncodeToString(sentMbHash), hex.EncodeToString(receivedMbHash)}, checkedMbs)
+}
+
+func TestElasticsearch_SaveTransactionsDisabledTypeShouldNotBeIndexed(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.enabledMiniBlockTypes = map[dataBlock.Type]struct{}{
+		dataBlock.TxBlock: {},
+	}
+
+	rTxHash := []byte("rTx1")
+	body := newTestBlockBody()
+	body.MiniBlocks = append(body.MiniBlocks, &dataBlock.MiniBlock{
+		Type:     dataBlock.RewardsBlock,
+		TxHashes: [][]byte{rTxHash},
+	})
+	txPool := newTestTxPool()
+	txPool[string(rTxHash)] = &rewardTx.RewardTx{Value: big.NewInt(10)}
+
+	txsBulks := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			if index == txIndex {
+				txsBulks = append(txsBulks, buff.String())
+			}
+
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveTransactions(body, &dataBlock.Header{}, txPool, 0)
+
+	require.Equal(t, 1, len(txsBulks))
+	require.True(t, strings.Contains(txsBulks[0], hex.EncodeToString([]byte("tx1"))))
+	require.False(t, strings.Contains(txsBulks[0], hex.EncodeToString(rTxHash)))
+}
+
+func TestElasticsearch_SaveTransactionsShouldBlockPastInFlightLimit(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.maxInFlightBulkRequests = 2
+
+	mutInFlight := sync.Mutex{}
+	numInFlight := 0
+	maxNumInFlight := 0
+	chRequestStarted := make(chan struct{}, 3)
+	releaseRequests := make(chan struct{})
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			mutInFlight.Lock()
+			numInFlight++
+			if numInFlight > maxNumInFlight {
+				maxNumInFlight = numInFlight
+			}
+			mutInFlight.Unlock()
+
+			chRequestStarted <- struct{}{}
+			<-releaseRequests
+
+			mutInFlight.Lock()
+			numInFlight--
+			mutInFlight.Unlock()
+
+			return nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+
+	numCallers := 3
+	numCallersDone := int32(0)
+	wg := sync.WaitGroup{}
+	wg.Add(numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func() {
+			elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{}, newTestTxPool(), 0)
+			atomic.AddInt32(&numCallersDone, 1)
+			wg.Done()
+		}()
+	}
+
+	// the requests are held until released, so all the slots are taken once the first requests started
+	for i := uint32(0); i < arguments.maxInFlightBulkRequests; i++ {
+		<-chRequestStarted
+	}
+	require.Equal(t, cap(elasticDatabase.bulkRequestsSlots), len(elasticDatabase.bulkRequestsSlots))
+
+	// the next request must wait for a free slot
+	select {
+	case <-chRequestStarted:
+		require.Fail(t, "the request past the in flight limit should have been pending")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.Equal(t, int32(0), atomic.LoadInt32(&numCallersDone))
+
+	// releasing one request frees the slot for the pending one
+	releaseRequests <- struct{}{}
+	select {
+	case <-chRequestStarted:
+	case <-time.After(time.Second):
+		require.Fail(t, "the pending request should have started after a slot was freed")
+	}
+
+	close(releaseRequests)
+	wg.Wait()
+	require.Equal(t, int(arguments.maxInFlightBulkRequests), maxNumInFlight)
+	require.Equal(t, int32(numCallers), atomic.LoadInt32(&numCallersDone))
+}
+
+func TestElasticIndexer_SaveBlockShouldBlockTheCallerPastInFlightLimit(t *testing.T) {
+	arguments := createMockElasticsearchDatabaseArgs()
+	chHeaderRequested := make(chan struct{}, 2)
+	releaseRequests := make(chan struct{})
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			chHeaderRequested <- struct{}{}
+			<-releaseRequests
+			return nil
+		},
+	}
+	ei := &elasticIndexer{
+		database:       newTestElasticSearchDatabase(dbWriter, arguments),
+		options:        &Options{},
+		marshalizer:    arguments.marshalizer,
+		saveBlockSlots: createBulkRequestsSlots(1),
+	}
+
+	ei.SaveBlock(&dataBlock.Body{}, &dataBlock.Header{Nonce: 1}, nil, []uint64{0}, nil)
+
+	// the slot is held by the first block until its header is indexed, so the next caller will block on acquiring it
+	<-chHeaderRequested
+	require.Equal(t, cap(ei.saveBlockSlots), len(ei.saveBlockSlots))
+
+	chSecondBlockSaved := make(chan struct{})
+	go func() {
+		ei.SaveBlock(&dataBlock.Body{}, &dataBlock.Header{Nonce: 2}, nil, []uint64{0}, nil)
+		close(chSecondBlockSaved)
+	}()
+
+	select {
+	case <-chSecondBlockSaved:
+		require.Fail(t, "the second block should have been pending on the save block slot")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.Equal(t, 0, len(chHeaderRequested))
+
+	close(releaseRequests)
+	<-chSecondBlockSaved
+	<-chHeaderRequested
+}
+
+func TestElasticIndexer_RevertIndexedBlockShouldRemoveTheBlockAndItsTxs(t *testing.T) {
+	t.Parallel()
+
+	deletedIndexes := make(map[string]bool)
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoDeleteRequestCalled: func(index string, ids []string) error {
+			deletedIndexes[index] = true
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return nil
+		},
+	}
+	ei := &elasticIndexer{
+		database:    newTestElasticSearchDatabase(dbWriter, arguments),
+		options:     &Options{TxIndexingEnabled: true},
+		marshalizer: arguments.marshalizer,
+	}
+
+	ei.RevertIndexedBlock(&dataBlock.Header{Nonce: 1, ShardID: 2}, newTestBlockBody())
+
+	require.True(t, deletedIndexes[blockIndex])
+	require.True(t, deletedIndexes[txIndex])
+}
+
+func TestElasticsearch_SaveTransactionsShouldNotifyTxSubscribers(t *testing.T) {
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+
+	receivedHashes := make(chan string, 10)
+	elasticDatabase.RegisterTxSubscriber(func(tx *Transaction) {
+		receivedHashes <- tx.Hash
+	})
+
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{}, newTestTxPool(), 0)
+
+	expectedHashes := map[string]struct{}{
+		hex.EncodeToString([]byte("tx1")): {},
+		hex.EncodeToString([]byte("tx2")): {},
+		hex.EncodeToString([]byte("tx3")): {},
+	}
+	for i := 0; i < len(expectedHashes); i++ {
+		select {
+		case hash := <-receivedHashes:
+			_, ok := expectedHashes[hash]
+			require.True(t, ok)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout while waiting for the indexed transactions")
+		}
+	}
+}
+
+func TestElasticsearch_SaveTransactionsFailedBulkShouldNotNotifyTxSubscribers(t *testing.T) {
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return errors.New("local err")
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+
+	numNotified := int32(0)
+	elasticDatabase.RegisterTxSubscriber(func(tx *Transaction) {
+		atomic.AddInt32(&numNotified, 1)
+	})
+
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{}, newTestTxPool(), 0)
+
+	time.Sleep(time.Millisecond * 100)
+	require.Equal(t, int32(0), atomic.LoadInt32(&numNotified))
+}
+
+func TestUpdateMiniBlock(t *testing.T) {
+	t.Skip("test must run only if you have an elasticsearch server on address http://localhost:9200")
+
+	args := elasticSearchDatabaseArgs{
+		urls:        []string{"http://localhost:9200"},
+		userName:    "basic_auth_username",
+		password:    "basic_auth_password",
+		marshalizer: &mock.MarshalizerMock{},
 		hasher:      &mock.HasherMock{},
 	}
 
-	esDatabase, _ := newElasticSearchDatabase(args)
+	esDatabase, _ := newElasticSearchDatabase(args)
+
+	header1 := &dataBlock.Header{
+		ShardID: 0,
+	}
+	body1 := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{SenderShardID: 1, ReceiverShardID: 0, TxHashes: [][]byte{[]byte("hash12")}},
+			{SenderShardID: 0, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("hash1")}},
+		},
+	}
+
+	header2 := &dataBlock.Header{
+		ShardID: 1,
+	}
+
+	// insert
+	esDatabase.SaveMiniblocks(header1, body1)
+	// update
+	esDatabase.SaveMiniblocks(header2, body1)
+}
+
+func TestUpdateTransaction(t *testing.T) {
+	t.Skip("test must run only if you have an elasticsearch server on address http://localhost:9200")
+
+	args := elasticSearchDatabaseArgs{
+		urls:                   []string{"http://localhost:9200"},
+		userName:               "basic_auth_username",
+		password:               "basic_auth_password",
+		marshalizer:            &mock.MarshalizerMock{},
+		hasher:                 &mock.HasherMock{},
+		addressPubkeyConverter: &mock.PubkeyConverterMock{},
+	}
+
+	esDatabase, _ := newElasticSearchDatabase(args)
+
+	txHash1 := []byte("txHash1")
+	tx1 := &transaction.Transaction{
+		GasPrice: 10,
+		GasLimit: 500,
+	}
+	txHash2 := []byte("txHash2")
+	sndAddr := []byte("snd")
+	tx2 := &transaction.Transaction{
+		GasPrice: 10,
+		GasLimit: 500,
+		SndAddr:  sndAddr,
+	}
+	txHash3 := []byte("txHash3")
+	tx3 := &transaction.Transaction{}
+
+	recHash1 := []byte("recHash1")
+	rec1 := &receipt.Receipt{
+		Value:  big.NewInt(100),
+		TxHash: txHash1,
+	}
+
+	scHash1 := []byte("scHash1")
+	scResult1 := &smartContractResult.SmartContractResult{
+		OriginalTxHash: txHash1,
+	}
+
+	scHash2 := []byte("scHash2")
+	scResult2 := &smartContractResult.SmartContractResult{
+		OriginalTxHash: txHash2,
+		RcvAddr:        sndAddr,
+		GasLimit:       500,
+		GasPrice:       1,
+		Value:          big.NewInt(150),
+	}
+
+	rTx1Hash := []byte("rTxHash1")
+	rTx1 := &rewardTx.RewardTx{
+		Round: 1113,
+	}
+	rTx2Hash := []byte("rTxHash2")
+	rTx2 := &rewardTx.RewardTx{
+		Round: 1114,
+	}
+
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash1, txHash2},
+				Type:     dataBlock.TxBlock,
+			},
+			{
+				TxHashes: [][]byte{txHash3},
+				Type:     dataBlock.TxBlock,
+			},
+			{
+				Type:     dataBlock.RewardsBlock,
+				TxHashes: [][]byte{rTx1Hash, rTx2Hash},
+			},
+			{
+				TxHashes: [][]byte{recHash1},
+				Type:     dataBlock.ReceiptBlock,
+			},
+			{
+				TxHashes: [][]byte{scHash1, scHash2},
+				Type:     dataBlock.SmartContractResultBlock,
+			},
+		},
+	}
+	header := &dataBlock.Header{}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash1):  tx1,
+		string(txHash2):  tx2,
+		string(txHash3):  tx3,
+		string(recHash1): rec1,
+		string(rTx1Hash): rTx1,
+		string(rTx2Hash): rTx2,
+	}
+
+	body.MiniBlocks[0].ReceiverShardID = 1
+	// insert
+	esDatabase.SaveTransactions(body, header, txPool, 0)
+
+	header.TimeStamp = 1234
+	txPool = map[string]data.TransactionHandler{
+		string(txHash1): tx1,
+		string(txHash2): tx2,
+		string(scHash1): scResult1,
+		string(scHash2): scResult2,
+	}
+
+	// update
+	esDatabase.SaveTransactions(body, header, txPool, 1)
+
+	indexedTx, err := esDatabase.GetTransactionByHash(hex.EncodeToString(txHash2))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(indexedTx.SmartContractResults))
+	require.Equal(t, "150", indexedTx.SmartContractResults[0].Value)
+}
+
+func TestTrimSliceInBulks(t *testing.T) {
+	t.Parallel()
+
+	sliceSize := 9500
+	bulkSize := 1000
+
+	testSlice := make([]int, sliceSize)
+	bulks := make([][]int, sliceSize/bulkSize+1)
+	bulksBigCapacity1 := make([][]int, sliceSize/bulkSize+1)
+	bulksBigCapacity2 := make([][]int, sliceSize/bulkSize+1)
+
+	for i := 0; i < sliceSize; i++ {
+		testSlice[i] = i
+	}
+
+	for i := 0; i < len(bulks); i++ {
+		if i == len(bulks)-1 {
+			bulks[i] = append([]int(nil), testSlice[i*bulkSize:]...)
+			bulksBigCapacity1[i] = append(bulksBigCapacity1[i], testSlice[i*bulkSize:]...)
+			bulksBigCapacity2[i] = testSlice[i*bulkSize:]
+			continue
+		}
+
+		bulks[i] = append([]int(nil), testSlice[i*bulkSize:(i+1)*bulkSize]...)
+		bulksBigCapacity1[i] = append(bulksBigCapacity1[i], testSlice[i*bulkSize:(i+1)*bulkSize]...)
+		bulksBigCapacity2[i] = testSlice[i*bulkSize : (i+1)*bulkSize]
+	}
+
+	require.Equal(t, len(bulks), sliceSize/bulkSize+1)
+	require.Equal(t, len(bulksBigCapacity1), sliceSize/bulkSize+1)
+	require.Equal(t, len(bulksBigCapacity2), sliceSize/bulkSize+1)
+}
+
+func saveTestTransactionsInBulks(t *testing.T, bulkRequestMaxSize uint32) []*bytes.Buffer {
+	bulks := make([]*bytes.Buffer, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, txIndex, index)
+			bulks = append(bulks, bytes.NewBuffer(buff.Bytes()))
+			return nil
+		},
+	}
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxSize = bulkRequestMaxSize
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+
+	return bulks
+}
+
+func TestElasticsearchSaveTransactions_UnsetBulkRequestMaxSizeShouldSendAllTheTransactionsTogether(t *testing.T) {
+	t.Parallel()
+
+	bulks := saveTestTransactionsInBulks(t, 0)
+	require.Equal(t, 1, len(bulks))
+	require.Equal(t, 6, len(strings.Split(strings.TrimSpace(bulks[0].String()), "\n")))
+}
+
+func TestElasticsearchSaveTransactions_BulkRequestMaxSizeShouldSplitTheBulks(t *testing.T) {
+	t.Parallel()
+
+	allTxsBulk := saveTestTransactionsInBulks(t, 0)[0]
+	bulkRequestMaxSize := allTxsBulk.Len() - 1
+
+	bulks := saveTestTransactionsInBulks(t, uint32(bulkRequestMaxSize))
+	require.Equal(t, 2, len(bulks))
+	require.True(t, bulks[0].Len() <= bulkRequestMaxSize)
+	require.True(t, bulks[1].Len() <= bulkRequestMaxSize)
+	require.Equal(t, allTxsBulk.Len(), bulks[0].Len()+bulks[1].Len())
+	require.Equal(t, 4, len(strings.Split(strings.TrimSpace(bulks[0].String()), "\n")))
+}
+
+func TestElasticsearchSaveTransactions_TransactionLargerThanTheBulkRequestMaxSizeShouldBeSentAlone(t *testing.T) {
+	t.Parallel()
+
+	bulks := saveTestTransactionsInBulks(t, 1)
+	require.Equal(t, 3, len(bulks))
+	for _, bulk := range bulks {
+		require.Equal(t, 2, len(strings.Split(strings.TrimSpace(bulk.String()), "\n")))
+	}
+}
+
+func TestElasticsearch_SaveAccountsHistory(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(37)
+	changes := []AccountBalanceChange{
+		{Address: []byte("addr1"), OldBalance: big.NewInt(100), NewBalance: big.NewInt(250)},
+		{Address: []byte("addr2"), OldBalance: big.NewInt(80), NewBalance: big.NewInt(30)},
+	}
+
+	documents := make(map[string]map[string]interface{})
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, accountsHistoryIndex, index)
+			applyBulkOnDocuments(t, documents, *buff)
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveAccountsHistory(blockNonce, changes)
+
+	require.Equal(t, len(changes), len(documents))
+
+	encodedAddress := hex.EncodeToString([]byte("addr1"))
+	doc := documents[fmt.Sprintf("%s_%d", encodedAddress, blockNonce)]
+	require.Equal(t, encodedAddress, doc["address"])
+	require.Equal(t, "100", doc["oldBalance"])
+	require.Equal(t, "250", doc["newBalance"])
+	require.Equal(t, "150", doc["delta"])
+	require.Equal(t, float64(blockNonce), doc["blockNonce"])
+
+	doc = documents[fmt.Sprintf("%s_%d", hex.EncodeToString([]byte("addr2")), blockNonce)]
+	require.Equal(t, "80", doc["oldBalance"])
+	require.Equal(t, "30", doc["newBalance"])
+	require.Equal(t, "-50", doc["delta"])
+	require.Equal(t, float64(blockNonce), doc["blockNonce"])
+}
+
+func TestElasticsearch_SaveEpochInfo(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	econ := EpochEconomics{
+		TotalSupply:         big.NewInt(20000000),
+		Inflation:           big.NewInt(1000),
+		RewardsDistributed:  big.NewInt(1500),
+		RewardsForCommunity: big.NewInt(100),
+		AccumulatedFees:     big.NewInt(500),
+	}
+
+	var indexedEpochInfoBuff string
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, epochInfoIndex, index)
+			indexedEpochInfoBuff = buff.String()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveEpochInfo(epoch, econ)
+
+	// the economics are merged in the epoch document so the epoch start information indexed separately is kept
+	lines := strings.Split(strings.TrimSpace(indexedEpochInfoBuff), "\n")
+	require.Equal(t, 2, len(lines))
+	require.Equal(t, `{ "update" : { "_id" : "5", "_type" : "_doc" } }`, lines[0])
+
+	update := struct {
+		Doc         map[string]interface{} `json:"doc"`
+		DocAsUpsert bool                   `json:"doc_as_upsert"`
+	}{}
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &update))
+	require.True(t, update.DocAsUpsert)
+
+	expectedEpochInfo := map[string]interface{}{
+		"epoch":               float64(epoch),
+		"totalSupply":         "20000000",
+		"inflation":           "1000",
+		"rewardsDistributed":  "1500",
+		"rewardsForCommunity": "100",
+		"accumulatedFees":     "500",
+		"nodePrice":           "0",
+	}
+	require.Equal(t, expectedEpochInfo, update.Doc)
+}
+
+func TestElasticsearchDatabase_VerifyContiguityShouldReportTheMissingNonces(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(2)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+			require.Equal(t, blockIndex, index)
+			require.True(t, strings.Contains(string(query), `{"term":{"shardId":2}}`))
+			require.True(t, strings.Contains(string(query), `{"nonce":{"gte":10,"lte":20}}`))
+
+			pages := [][]uint64{{10, 11, 12, 14, 15}, {16, 18, 19, 20}}
+			for _, nonces := range pages {
+				sources := make([]json.RawMessage, 0, len(nonces))
+				for _, nonce := range nonces {
+					sources = append(sources, json.RawMessage(fmt.Sprintf(`{"nonce":%d}`, nonce)))
+				}
+
+				err := handleSources(sources)
+				require.Nil(t, err)
+			}
+
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	missingNonces, err := elasticDatabase.VerifyContiguity(10, 20, shardID)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{13, 17}, missingNonces)
+}
+
+func TestElasticsearchDatabase_VerifyContiguityInvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elasticDatabase := newTestElasticSearchDatabase(&mock.DatabaseWriterStub{}, createMockElasticsearchDatabaseArgs())
+	missingNonces, err := elasticDatabase.VerifyContiguity(20, 10, 0)
+	require.Nil(t, missingNonces)
+	require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+}
+
+func TestElasticsearchDatabase_VerifyContiguityTooLargeRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(_ string, _ []byte, _ func(sources []json.RawMessage) error) error {
+			require.Fail(t, "the blocks should not be searched")
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	missingNonces, err := elasticDatabase.VerifyContiguity(0, math.MaxUint64, 0)
+	require.Nil(t, missingNonces)
+	require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+}
+
+func TestElasticsearchDatabase_VerifyContiguityShouldSearchThePagesOfTheRange(t *testing.T) {
+	t.Parallel()
+
+	fromNonce := uint64(math.MaxUint64 - contiguityPageSize - 10)
+	searchedRanges := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(_ string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+			searchedRanges = append(searchedRanges, string(query))
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	missingNonces, err := elasticDatabase.VerifyContiguity(fromNonce, math.MaxUint64, 0)
+	require.Nil(t, err)
+	require.Equal(t, contiguityPageSize+11, len(missingNonces))
+	require.Equal(t, uint64(math.MaxUint64), missingNonces[len(missingNonces)-1])
+	require.Equal(t, 2, len(searchedRanges))
+	require.True(t, strings.Contains(searchedRanges[0], fmt.Sprintf(`{"gte":%d,"lte":%d}`, fromNonce, fromNonce+contiguityPageSize-1)))
+	require.True(t, strings.Contains(searchedRanges[1], fmt.Sprintf(`{"gte":%d,"lte":%d}`, fromNonce+contiguityPageSize, uint64(math.MaxUint64))))
+}
 
-	header1 := &dataBlock.Header{
-		ShardID: 0,
+func TestElasticsearchDatabase_VerifyContiguitySearchErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(_ string, _ []byte, _ func(sources []json.RawMessage) error) error {
+			return expectedErr
+		},
 	}
-	body1 := &dataBlock.Body{
-		MiniBlocks: []*dataBlock.MiniBlock{
-			{SenderShardID: 1, ReceiverShardID: 0, TxHashes: [][]byte{[]byte("hash12")}},
-			{SenderShardID: 0, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("hash1")}},
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	missingNonces, err := elasticDatabase.VerifyContiguity(10, 20, 0)
+	require.Nil(t, missingNonces)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestElasticsearchDatabase_SaveEpochStartInfoShouldIndexTheFinalizationData(t *testing.T) {
+	t.Parallel()
+
+	metaBlock := &dataBlock.MetaBlock{
+		Epoch: 4,
+		EpochStart: dataBlock.EpochStart{
+			LastFinalizedHeaders: []dataBlock.EpochStartShardData{
+				{
+					ShardID:               1,
+					Epoch:                 3,
+					Round:                 120,
+					Nonce:                 100,
+					HeaderHash:            []byte("hdr hash"),
+					RootHash:              []byte("root hash"),
+					FirstPendingMetaBlock: []byte("first pending"),
+					LastFinishedMetaBlock: []byte("last finished"),
+					PendingMiniBlockHeaders: []dataBlock.MiniBlockHeader{
+						{
+							Hash:            []byte("mb hash"),
+							SenderShardID:   0,
+							ReceiverShardID: 1,
+							TxCount:         5,
+							Type:            dataBlock.TxBlock,
+						},
+					},
+				},
+			},
 		},
 	}
 
-	header2 := &dataBlock.Header{
-		ShardID: 1,
+	var indexedEpochStartBuff string
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, epochInfoIndex, index)
+			indexedEpochStartBuff = buff.String()
+			return nil
+		},
 	}
 
-	// insert
-	esDatabase.SaveMiniblocks(header1, body1)
-	// update
-	esDatabase.SaveMiniblocks(header2, body1)
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.SaveEpochStartInfo(metaBlock)
+
+	lines := strings.Split(strings.TrimSpace(indexedEpochStartBuff), "\n")
+	require.Equal(t, 2, len(lines))
+	require.Equal(t, `{ "update" : { "_id" : "4", "_type" : "_doc" } }`, lines[0])
+
+	update := struct {
+		Doc         EpochStartInfo `json:"doc"`
+		DocAsUpsert bool           `json:"doc_as_upsert"`
+	}{}
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &update))
+	require.True(t, update.DocAsUpsert)
+
+	expectedInfo := EpochStartInfo{
+		Epoch: 4,
+		LastFinalizedHeaders: []EpochStartShardInfo{
+			{
+				ShardID:               1,
+				Epoch:                 3,
+				Round:                 120,
+				Nonce:                 100,
+				HeaderHash:            hex.EncodeToString([]byte("hdr hash")),
+				RootHash:              hex.EncodeToString([]byte("root hash")),
+				FirstPendingMetaBlock: hex.EncodeToString([]byte("first pending")),
+				LastFinishedMetaBlock: hex.EncodeToString([]byte("last finished")),
+				PendingMiniBlocks: []EpochStartPendingMiniBlock{
+					{
+						Hash:            hex.EncodeToString([]byte("mb hash")),
+						SenderShardID:   0,
+						ReceiverShardID: 1,
+						TxCount:         5,
+						Type:            dataBlock.TxBlock.String(),
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedInfo, update.Doc)
 }
 
-func TestUpdateTransaction(t *testing.T) {
-	t.Skip("test must run only if you have an elasticsearch server on address http://localhost:9200")
+func TestElasticsearchSaveHeader_EpochStartMetaBlockShouldIndexTheEpochStartBlockInfo(t *testing.T) {
+	t.Parallel()
 
-	args := elasticSearchDatabaseArgs{
-		url:                    "http://localhost:9200",
-		userName:               "basic_auth_username",
-		password:               "basic_auth_password",
-		marshalizer:            &mock.MarshalizerMock{},
-		hasher:                 &mock.HasherMock{},
-		addressPubkeyConverter: &mock.PubkeyConverterMock{},
+	metaBlock := &dataBlock.MetaBlock{
+		Nonce:     10,
+		Epoch:     4,
+		Round:     250,
+		TimeStamp: 12345,
+		ShardInfo: []dataBlock.ShardData{
+			{HeaderHash: []byte("shard 0 hash")},
+			{HeaderHash: []byte("shard 1 hash")},
+		},
+		EpochStart: dataBlock.EpochStart{
+			LastFinalizedHeaders: []dataBlock.EpochStartShardData{{ShardID: 0}},
+		},
 	}
 
-	esDatabase, _ := newElasticSearchDatabase(args)
+	numBlockRequests := 0
+	epochInfoBulks := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			require.Equal(t, blockIndex, req.Index)
+			numBlockRequests++
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, epochInfoIndex, index)
+			epochInfoBulks = append(epochInfoBulks, buff.String())
+			return nil
+		},
+	}
 
-	txHash1 := []byte("txHash1")
-	tx1 := &transaction.Transaction{
-		GasPrice: 10,
-		GasLimit: 500,
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.SaveHeader(metaBlock, []uint64{0}, &dataBlock.Body{}, nil, 0)
+
+	require.Equal(t, 1, numBlockRequests)
+	require.Equal(t, 1, len(epochInfoBulks))
+	lines := strings.Split(strings.TrimSpace(epochInfoBulks[0]), "\n")
+	require.Equal(t, 2, len(lines))
+	require.Equal(t, `{ "update" : { "_id" : "4", "_type" : "_doc" } }`, lines[0])
+
+	update := struct {
+		Doc         EpochStartBlockInfo `json:"doc"`
+		DocAsUpsert bool                `json:"doc_as_upsert"`
+	}{}
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &update))
+	require.True(t, update.DocAsUpsert)
+	require.Equal(t, EpochStartBlockInfo{
+		Epoch:     4,
+		Round:     250,
+		Timestamp: time.Duration(12345),
+		ShardHeadersHashes: []string{
+			hex.EncodeToString([]byte("shard 0 hash")),
+			hex.EncodeToString([]byte("shard 1 hash")),
+		},
+	}, update.Doc)
+}
+
+func TestElasticsearchSaveHeader_NotEpochStartMetaBlockShouldNotIndexTheEpochStartBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	metaBlock := &dataBlock.MetaBlock{
+		Nonce:     11,
+		Epoch:     4,
+		ShardInfo: []dataBlock.ShardData{{HeaderHash: []byte("shard 0 hash")}},
 	}
-	txHash2 := []byte("txHash2")
-	sndAddr := []byte("snd")
-	tx2 := &transaction.Transaction{
-		GasPrice: 10,
-		GasLimit: 500,
-		SndAddr:  sndAddr,
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "no bulk request should have been done")
+			return nil
+		},
 	}
-	txHash3 := []byte("txHash3")
-	tx3 := &transaction.Transaction{}
 
-	recHash1 := []byte("recHash1")
-	rec1 := &receipt.Receipt{
-		Value:  big.NewInt(100),
-		TxHash: txHash1,
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.SaveHeader(metaBlock, []uint64{0}, &dataBlock.Body{}, nil, 0)
+	elasticDatabase.SaveHeader(&dataBlock.Header{Nonce: 1}, []uint64{0}, &dataBlock.Body{}, nil, 0)
+}
+
+func TestElasticsearchGetTransactionByHash_ShouldUnmarshalTheSource(t *testing.T) {
+	t.Parallel()
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoGetRequestCalled: func(index string, id string) (json.RawMessage, error) {
+			require.Equal(t, txIndex, index)
+			require.Equal(t, "txHash", id)
+			return json.RawMessage(`{"nonce":3,"sender":"snd","status":"Success","scResults":[{"value":"10"}]}`), nil
+		},
 	}
 
-	scHash1 := []byte("scHash1")
-	scResult1 := &smartContractResult.SmartContractResult{
-		OriginalTxHash: txHash1,
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	tx, err := elasticDatabase.GetTransactionByHash("txHash")
+	require.Nil(t, err)
+	require.Equal(t, "txHash", tx.Hash)
+	require.Equal(t, uint64(3), tx.Nonce)
+	require.Equal(t, "snd", tx.Sender)
+	require.Equal(t, txStatusSuccess, tx.Status)
+	require.Equal(t, "10", tx.SmartContractResults[0].Value)
+}
+
+func TestElasticsearchGetTransactionByHash_MissingTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoGetRequestCalled: func(index string, id string) (json.RawMessage, error) {
+			return nil, fmt.Errorf("%w, index: %s, id: %s", ErrDocumentNotFound, index, id)
+		},
+		DoScrollRequestCalled: func(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+			require.Fail(t, "no search should be done without a custom routing")
+			return nil
+		},
 	}
 
-	scHash2 := []byte("scHash2")
-	scResult2 := &smartContractResult.SmartContractResult{
-		OriginalTxHash: txHash2,
-		RcvAddr:        sndAddr,
-		GasLimit:       500,
-		GasPrice:       1,
-		Value:          big.NewInt(150),
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	tx, err := elasticDatabase.GetTransactionByHash("txHash")
+	require.Nil(t, tx)
+	require.True(t, errors.Is(err, ErrDocumentNotFound))
+}
+
+func TestElasticsearchGetTransactionByHash_CustomRoutingShouldSearchAllTheShards(t *testing.T) {
+	t.Parallel()
+
+	var searchedIndex string
+	var searchQuery string
+	dbWriter := &mock.DatabaseWriterStub{
+		DoGetRequestCalled: func(index string, id string) (json.RawMessage, error) {
+			return nil, fmt.Errorf("%w, index: %s, id: %s", ErrDocumentNotFound, index, id)
+		},
+		DoScrollRequestCalled: func(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+			searchedIndex = index
+			searchQuery = string(query)
+			return handleSources([]json.RawMessage{json.RawMessage(`{"nonce":7}`)})
+		},
 	}
 
-	rTx1Hash := []byte("rTxHash1")
-	rTx1 := &rewardTx.RewardTx{
-		Round: 1113,
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.routingFunc = func(shardID uint32) string {
+		return fmt.Sprintf("shard%d", shardID)
 	}
-	rTx2Hash := []byte("rTxHash2")
-	rTx2 := &rewardTx.RewardTx{
-		Round: 1114,
+
+	tx, err := elasticDatabase.GetTransactionByHash("txHash")
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), tx.Nonce)
+	require.Equal(t, txIndex, searchedIndex)
+	require.JSONEq(t, `{"query":{"ids":{"values":["txHash"]}}}`, searchQuery)
+
+	dbWriter.DoScrollRequestCalled = func(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+		return nil
 	}
+	tx, err = elasticDatabase.GetTransactionByHash("txHash")
+	require.Nil(t, tx)
+	require.True(t, errors.Is(err, ErrDocumentNotFound))
+}
 
-	body := &dataBlock.Body{
-		MiniBlocks: []*dataBlock.MiniBlock{
-			{
-				TxHashes: [][]byte{txHash1, txHash2},
-				Type:     dataBlock.TxBlock,
-			},
-			{
-				TxHashes: [][]byte{txHash3},
-				Type:     dataBlock.TxBlock,
-			},
-			{
-				Type:     dataBlock.RewardsBlock,
-				TxHashes: [][]byte{rTx1Hash, rTx2Hash},
-			},
-			{
-				TxHashes: [][]byte{recHash1},
-				Type:     dataBlock.ReceiptBlock,
-			},
-			{
-				TxHashes: [][]byte{scHash1, scHash2},
-				Type:     dataBlock.SmartContractResultBlock,
-			},
+func TestElasticsearchGetBlockByHash_SnakeCaseFieldNamingShouldRestoreTheFields(t *testing.T) {
+	t.Parallel()
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoGetRequestCalled: func(index string, id string) (json.RawMessage, error) {
+			require.Equal(t, blockIndex, index)
+			return json.RawMessage(`{"nonce":9,"shard_id":2,"mini_blocks":[{"hash":"mbHash","type":"TxBlock"}],"state_root_hash":"root"}`), nil
 		},
 	}
-	header := &dataBlock.Header{}
-	txPool := map[string]data.TransactionHandler{
-		string(txHash1):  tx1,
-		string(txHash2):  tx2,
-		string(txHash3):  tx3,
-		string(recHash1): rec1,
-		string(rTx1Hash): rTx1,
-		string(rTx2Hash): rTx2,
-	}
 
-	body.MiniBlocks[0].ReceiverShardID = 1
-	// insert
-	esDatabase.SaveTransactions(body, header, txPool, 0)
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.fieldNamingFunc = toSnakeCase
 
-	header.TimeStamp = 1234
-	txPool = map[string]data.TransactionHandler{
-		string(txHash1): tx1,
-		string(txHash2): tx2,
-		string(scHash1): scResult1,
-		string(scHash2): scResult2,
+	elasticBlock, err := elasticDatabase.GetBlockByHash("blockHash")
+	require.Nil(t, err)
+	require.Equal(t, "blockHash", elasticBlock.Hash)
+	require.Equal(t, uint64(9), elasticBlock.Nonce)
+	require.Equal(t, uint32(2), elasticBlock.ShardID)
+	require.Equal(t, "root", elasticBlock.StateRootHash)
+	require.Equal(t, []MiniBlockInfo{{Hash: "mbHash", Type: "TxBlock"}}, elasticBlock.MiniBlocks)
+}
+
+func TestElasticsearch_SnakeCaseFieldNamingShouldApplyToRoundsAndValidatorsDocuments(t *testing.T) {
+	t.Parallel()
+
+	indexedDocuments := make(map[string]string)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			body, _ := ioutil.ReadAll(req.Body)
+			indexedDocuments[req.Index] = string(body)
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			indexedDocuments[index+"_bulk"] = buff.String()
+			return nil
+		},
 	}
 
-	// update
-	esDatabase.SaveTransactions(body, header, txPool, 1)
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.fieldNamingFunc = toSnakeCase
+
+	roundInfo := RoundInfo{Index: 1, SignersIndexes: []uint64{0}, BlockWasProposed: true, ShardId: 1}
+	elasticDatabase.SaveRoundInfo(roundInfo)
+	elasticDatabase.SaveRoundsInfo([]RoundInfo{roundInfo})
+	elasticDatabase.SaveShardValidatorsPubKeys(0, 1, [][]byte{[]byte("pubkey")})
+	elasticDatabase.SaveValidatorsRating("0_1", []ValidatorRatingInfo{{PublicKey: "pubkey", Rating: 50}})
+
+	for _, document := range []string{indexedDocuments[roundIndex], indexedDocuments[roundIndex+"_bulk"]} {
+		require.Contains(t, document, `"signers_indexes"`)
+		require.Contains(t, document, `"block_was_proposed"`)
+		require.Contains(t, document, `"shard_id"`)
+		require.NotContains(t, document, `"shardId"`)
+	}
+	require.Contains(t, indexedDocuments[validatorsIndex], `"public_keys"`)
+	require.Contains(t, indexedDocuments[ratingIndex], `"validators_rating"`)
+	require.Contains(t, indexedDocuments[ratingIndex], `"public_key"`)
 }
 
-func TestTrimSliceInBulks(t *testing.T) {
+func TestElasticsearchSaveTransactions_ShouldUpdateTheIndexingMetrics(t *testing.T) {
 	t.Parallel()
 
-	sliceSize := 9500
-	bulkSize := 1000
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			time.Sleep(2 * time.Millisecond)
+			return nil
+		},
+	}
 
-	testSlice := make([]int, sliceSize)
-	bulks := make([][]int, sliceSize/bulkSize+1)
-	bulksBigCapacity1 := make([][]int, sliceSize/bulkSize+1)
-	bulksBigCapacity2 := make([][]int, sliceSize/bulkSize+1)
+	statusMetrics := statusHandler.NewStatusMetrics()
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticsearchDatabaseArgs())
+	elasticDatabase.appStatusHandler = statusMetrics
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 2}, newTestTxPool(), 0)
 
-	for i := 0; i < sliceSize; i++ {
-		testSlice[i] = i
+	metrics := statusMetrics.StatusMetricsMapWithoutP2P()
+	require.Equal(t, uint64(6), metrics[core.MetricIndexerTxsIndexedTotal])
+	require.Nil(t, metrics[core.MetricIndexerBulkErrorsTotal])
+	require.True(t, metrics[core.MetricIndexerBulkDurationMs].(uint64) >= 2)
+}
+
+func TestElasticsearchSaveShardStatistics_FailedBulkShouldIncrementTheErrorsMetric(t *testing.T) {
+	t.Parallel()
+
+	tpsBenchmark := &mock.TpsBenchmarkMock{}
+	metaBlock := &dataBlock.MetaBlock{
+		TxCount: 2, Nonce: 1,
+		ShardInfo: []dataBlock.ShardData{{HeaderHash: []byte("hash")}},
 	}
+	tpsBenchmark.UpdateWithShardStats(metaBlock)
 
-	for i := 0; i < len(bulks); i++ {
-		if i == len(bulks)-1 {
-			bulks[i] = append([]int(nil), testSlice[i*bulkSize:]...)
-			bulksBigCapacity1[i] = append(bulksBigCapacity1[i], testSlice[i*bulkSize:]...)
-			bulksBigCapacity2[i] = testSlice[i*bulkSize:]
-			continue
-		}
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.bulkRequestMaxAttempts = 1
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return errors.New("service unavailable")
+		},
+	}
 
-		bulks[i] = append([]int(nil), testSlice[i*bulkSize:(i+1)*bulkSize]...)
-		bulksBigCapacity1[i] = append(bulksBigCapacity1[i], testSlice[i*bulkSize:(i+1)*bulkSize]...)
-		bulksBigCapacity2[i] = testSlice[i*bulkSize : (i+1)*bulkSize]
+	statusMetrics := statusHandler.NewStatusMetrics()
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.appStatusHandler = statusMetrics
+	elasticDatabase.SaveShardStatistics(tpsBenchmark)
+	elasticDatabase.SaveShardStatistics(tpsBenchmark)
+
+	metrics := statusMetrics.StatusMetricsMapWithoutP2P()
+	require.Equal(t, uint64(2*len(tpsBenchmark.ShardStatistics())), metrics[core.MetricIndexerBulkErrorsTotal])
+	require.Nil(t, metrics[core.MetricIndexerTxsIndexedTotal])
+}
+
+func TestNewElasticSearchDatabase_NilAppStatusHandlerShouldUseTheDisabledOne(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	arguments := createMockElasticsearchDatabaseArgs()
+	arguments.urls = []string{ts.URL}
+	arguments.appStatusHandler = nil
+
+	elasticDatabase, err := newElasticSearchDatabase(arguments)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(elasticDatabase.appStatusHandler))
+
+	elasticDatabase.SaveTransactions(newTestBlockBody(), &dataBlock.Header{Nonce: 1}, newTestTxPool(), 0)
+}
+
+func TestElasticsearch_SaveValidatorsRatingHistory(t *testing.T) {
+	t.Parallel()
+
+	validators := map[string]*state.ValidatorApiResponse{
+		"pubkey2": {
+			ShardId:             1,
+			Rating:              55.5,
+			TempRating:          56,
+			NumLeaderSuccess:    2,
+			NumLeaderFailure:    1,
+			NumValidatorSuccess: 30,
+			NumValidatorFailure: 4,
+		},
+		"pubkey1": {ShardId: 0, Rating: 50, TempRating: 49},
 	}
 
-	require.Equal(t, len(bulks), sliceSize/bulkSize+1)
-	require.Equal(t, len(bulksBigCapacity1), sliceSize/bulkSize+1)
-	require.Equal(t, len(bulksBigCapacity2), sliceSize/bulkSize+1)
+	arguments := createMockElasticsearchDatabaseArgs()
+	indexedBulks := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, ratingHistoryIndex, index)
+			indexedBulks = append(indexedBulks, buff.String())
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveValidatorsRatingHistory(7, validators)
+	// re-indexing the epoch should overwrite the same documents
+	elasticDatabase.SaveValidatorsRatingHistory(7, validators)
+
+	require.Equal(t, 2, len(indexedBulks))
+	require.Equal(t, indexedBulks[0], indexedBulks[1])
+
+	encodedPubKey1 := arguments.validatorPubkeyConverter.Encode([]byte("pubkey1"))
+	encodedPubKey2 := arguments.validatorPubkeyConverter.Encode([]byte("pubkey2"))
+	lines := strings.Split(strings.TrimSpace(indexedBulks[0]), "\n")
+	require.Equal(t, 4, len(lines))
+	require.Equal(t, fmt.Sprintf(`{ "index" : { "_id" : "%s_7", "_type" : "_doc" } }`, encodedPubKey1), lines[0])
+	require.Equal(t, fmt.Sprintf(`{ "index" : { "_id" : "%s_7", "_type" : "_doc" } }`, encodedPubKey2), lines[2])
+
+	ratingHistory := ValidatorRatingHistory{}
+	require.Nil(t, json.Unmarshal([]byte(lines[3]), &ratingHistory))
+	require.Equal(t, ValidatorRatingHistory{
+		PublicKey:           encodedPubKey2,
+		Epoch:               7,
+		ShardID:             1,
+		Rating:              55.5,
+		TempRating:          56,
+		NumLeaderSuccess:    2,
+		NumLeaderFailure:    1,
+		NumValidatorSuccess: 30,
+		NumValidatorFailure: 4,
+	}, ratingHistory)
 }
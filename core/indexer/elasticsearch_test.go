@@ -2,11 +2,16 @@ package indexer_test
 
 import (
 	"bytes"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -14,6 +19,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,6 +101,26 @@ func TestElasticIndexer_NewIndexerWithNilEpochStartNotifierShouldErr(t *testing.
 	require.Equal(t, core.ErrNilEpochStartNotifier, err)
 }
 
+func TestElasticIndexer_NewIndexerWithNilOptionsShouldErr(t *testing.T) {
+	arguments := NewElasticIndexerArguments()
+	arguments.Options = nil
+	ei, err := indexer.NewElasticIndexer(arguments)
+
+	require.Nil(t, ei)
+	require.Equal(t, indexer.ErrNilOptions, err)
+}
+
+func TestElasticIndexer_NewIndexerWithInvalidMiniBlockTypeShouldErr(t *testing.T) {
+	arguments := NewElasticIndexerArguments()
+	arguments.Options = &indexer.Options{
+		EnabledMiniBlockTypes: []string{block.TxBlock.String(), "invalid type"},
+	}
+	ei, err := indexer.NewElasticIndexer(arguments)
+
+	require.Nil(t, ei)
+	require.True(t, errors.Is(err, indexer.ErrInvalidMiniBlockType))
+}
+
 func TestElasticIndexer_NewIndexerWithCorrectParamsShouldWork(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/blocks" {
@@ -352,3 +379,148 @@ func TestElasticIndexer_EpochChangeValidators(t *testing.T) {
 	wg.Wait()
 	assert.True(t, secondEpochCalled)
 }
+
+func TestElasticIndexer_ReindexBlockWithoutStorageShouldErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	arguments := NewElasticIndexerArguments()
+	arguments.Url = ts.URL
+	ei, _ := indexer.NewElasticIndexer(arguments)
+
+	err := ei.ReindexBlock([]byte("header hash"))
+	require.Equal(t, indexer.ErrNilStorageService, err)
+}
+
+func TestElasticIndexer_ReindexBlockShouldRewriteTheBlockAndItsTransactions(t *testing.T) {
+	headerHash := []byte("header hash")
+	mbHash := []byte("miniblock hash")
+	txHash := []byte("tx hash")
+
+	marshalizer := &mock.MarshalizerMock{}
+	header := &block.Header{
+		Nonce:            10,
+		Round:            11,
+		PrevRandSeed:     []byte("prev rand seed"),
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: mbHash, Type: block.TxBlock}},
+	}
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{txHash}, Type: block.TxBlock}
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(100)}
+	storedData := map[dataRetriever.UnitType]map[string]interface{}{
+		dataRetriever.BlockHeaderUnit: {string(headerHash): header},
+		dataRetriever.MiniBlockUnit:   {string(mbHash): miniBlock},
+		dataRetriever.TransactionUnit: {string(txHash): tx},
+	}
+
+	mutRequests := sync.Mutex{}
+	requestsBodies := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		mutRequests.Lock()
+		requestsBodies[r.URL.Path] += string(body)
+		mutRequests.Unlock()
+	}))
+
+	arguments := NewElasticIndexerArguments()
+	arguments.Url = ts.URL
+	arguments.Options = &indexer.Options{TxIndexingEnabled: true}
+	arguments.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsIndexesCalled: func(_ []string, _ uint32) ([]uint64, error) {
+			return []uint64{0, 1}, nil
+		},
+	}
+	arguments.Storage = &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			obj, ok := storedData[unitType][string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+
+			return marshalizer.Marshal(obj)
+		},
+	}
+	ei, err := indexer.NewElasticIndexer(arguments)
+	require.Nil(t, err)
+
+	err = ei.ReindexBlock(headerHash)
+	require.Nil(t, err)
+
+	mutRequests.Lock()
+	defer mutRequests.Unlock()
+
+	hasher := &mock.HasherMock{}
+	indexedHeaderHash, _ := core.CalculateHash(marshalizer, hasher, header)
+	indexedMbHash, _ := core.CalculateHash(marshalizer, hasher, miniBlock)
+	_, blockWritten := requestsBodies["/blocks/_doc/"+hex.EncodeToString(indexedHeaderHash)]
+	assert.True(t, blockWritten)
+	assert.True(t, strings.Contains(requestsBodies["/miniblocks/_bulk"], hex.EncodeToString(indexedMbHash)))
+	assert.True(t, strings.Contains(requestsBodies["/transactions/_bulk"], hex.EncodeToString(txHash)))
+}
+
+func TestElasticIndexer_RevertIndexedBlockShouldRemoveTheBlockAfterItsQueuedSave(t *testing.T) {
+	mutRequests := sync.Mutex{}
+	blockRequests := make([]string, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/blocks/") {
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/blocks/_doc/") {
+			// a slow save, which would be overtaken by the removal if it was not ordered after it
+			time.Sleep(time.Millisecond * 50)
+		}
+
+		mutRequests.Lock()
+		blockRequests = append(blockRequests, r.URL.Path)
+		mutRequests.Unlock()
+	}))
+	defer ts.Close()
+
+	arguments := NewElasticIndexerArguments()
+	arguments.Url = ts.URL
+	arguments.Options = &indexer.Options{
+		IndexingQueueSize: 10,
+		IndexingWorkers:   2,
+	}
+	ei, err := indexer.NewElasticIndexer(arguments)
+	require.Nil(t, err)
+
+	header := &block.Header{Nonce: 1, Round: 1}
+	ei.SaveBlock(&block.Body{}, header, nil, []uint64{0}, nil)
+	ei.RevertIndexedBlock(header, &block.Body{})
+	require.Nil(t, ei.Close())
+
+	headerHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, header)
+	mutRequests.Lock()
+	defer mutRequests.Unlock()
+	expectedRequests := []string{
+		"/blocks/_doc/" + hex.EncodeToString(headerHash),
+		"/blocks/_delete_by_query",
+	}
+	assert.Equal(t, expectedRequests, blockRequests)
+}
+
+func TestElasticIndexer_ReindexBlockMissingTransactionShouldErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	headerHash := []byte("header hash")
+	marshalizer := &mock.MarshalizerMock{}
+	expectedErr := errors.New("key not found")
+	arguments := NewElasticIndexerArguments()
+	arguments.Url = ts.URL
+	arguments.Storage = &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			switch unitType {
+			case dataRetriever.BlockHeaderUnit:
+				return marshalizer.Marshal(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb")}}})
+			case dataRetriever.MiniBlockUnit:
+				return marshalizer.Marshal(&block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}})
+			default:
+				return nil, expectedErr
+			}
+		},
+	}
+	ei, _ := indexer.NewElasticIndexer(arguments)
+
+	err := ei.ReindexBlock(headerHash)
+	require.Equal(t, expectedErr, err)
+}
@@ -24,3 +24,48 @@ var ErrEmptyPassword = errors.New("password is empty")
 
 // ErrNilPubkeyConverter signals that an operation has been attempted to or with a nil public key converter implementation
 var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidMiniBlockType signals that an unknown miniblock type was provided in the indexer options
+var ErrInvalidMiniBlockType = errors.New("invalid miniblock type")
+
+// ErrNilOptions signals that a nil options structure has been provided
+var ErrNilOptions = errors.New("nil options")
+
+// ErrDocumentExistsCheck signals that the existence of a document could not be determined
+var ErrDocumentExistsCheck = errors.New("cannot check if document exists")
+
+// ErrIndexExistsCheck signals that the existence of an index could not be determined
+var ErrIndexExistsCheck = errors.New("cannot check if index exists")
+
+// ErrMissingIndex signals that a required elasticsearch index does not exist
+var ErrMissingIndex = errors.New("missing index")
+
+// ErrElasticSearchUnreachable signals that the elasticsearch server could not be reached
+var ErrElasticSearchUnreachable = errors.New("elasticsearch server unreachable")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrSearchRequest signals that a search request on elasticsearch failed
+var ErrSearchRequest = errors.New("search request failed")
+
+// ErrInvalidNoncesRange signals that the provided nonces range is invalid
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrInvalidFieldNaming signals that an unknown field naming strategy has been provided
+var ErrInvalidFieldNaming = errors.New("invalid field naming")
+
+// ErrBulkRequestRejected signals that elasticsearch rejected a bulk request as malformed, so it should not be retried
+var ErrBulkRequestRejected = errors.New("bulk request rejected")
+
+// ErrDeleteRequest signals that a delete request on elasticsearch failed
+var ErrDeleteRequest = errors.New("delete request failed")
+
+// ErrTooManyRequests signals that elasticsearch rejected a request because it receives more requests than it can handle
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrDocumentNotFound signals that no document with the requested id was found in the index
+var ErrDocumentNotFound = errors.New("document not found")
+
+// ErrGetRequest signals that a get request on elasticsearch failed
+var ErrGetRequest = errors.New("get request failed")
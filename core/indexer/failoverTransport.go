@@ -0,0 +1,91 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/elastic/go-elasticsearch/v7/estransport"
+)
+
+// failoverTransport sends the requests to the elasticsearch nodes in a round robin order. A request failing with a
+// connection error is sent to the next node, so that the indexing continues while some of the nodes are down
+type failoverTransport struct {
+	nodesTransports []estransport.Interface
+	nextNode        uint32
+}
+
+// newElasticsearchClient creates the elasticsearch client for the configured addresses. The requests are failed over
+//  between the nodes only if more than one address is provided
+func newElasticsearchClient(cfg elasticsearch.Config) (*elasticsearch.Client, error) {
+	if len(cfg.Addresses) <= 1 {
+		return elasticsearch.NewClient(cfg)
+	}
+
+	ft := &failoverTransport{
+		nodesTransports: make([]estransport.Interface, 0, len(cfg.Addresses)),
+	}
+	for _, address := range cfg.Addresses {
+		u, err := url.Parse(strings.TrimRight(address, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse url: %w", err)
+		}
+
+		ft.nodesTransports = append(ft.nodesTransports, estransport.New(estransport.Config{
+			URLs:      []*url.URL{u},
+			Username:  cfg.Username,
+			Password:  cfg.Password,
+			Transport: cfg.Transport,
+			Logger:    cfg.Logger,
+		}))
+	}
+
+	return &elasticsearch.Client{Transport: ft, API: esapi.New(ft)}, nil
+}
+
+// Perform sends the request to the next node and tries the other nodes, in order, while a connection error occurs.
+//  Each attempt works on its own copy of the request, as the node transport rewrites the request URL. The error of the
+//  last node is returned if none of them could be reached or if the request context is done
+func (ft *failoverTransport) Perform(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	numNodes := uint32(len(ft.nodesTransports))
+	firstNode := atomic.AddUint32(&ft.nextNode, 1) - 1
+
+	var res *http.Response
+	var err error
+	for i := uint32(0); i < numNodes; i++ {
+		attemptReq := req.Clone(req.Context())
+		if body != nil {
+			attemptReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		res, err = ft.nodesTransports[(firstNode+i)%numNodes].Perform(attemptReq)
+		if err == nil {
+			return res, nil
+		}
+		if req.Context().Err() != nil {
+			return res, err
+		}
+
+		log.Debug("indexer: elasticsearch node could not be reached, trying the next one",
+			"node", attemptReq.URL.Host,
+			"error", err.Error())
+	}
+
+	return res, err
+}
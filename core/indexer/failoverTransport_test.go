@@ -0,0 +1,71 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/elastic/go-elasticsearch/v7/estransport"
+	"github.com/stretchr/testify/require"
+)
+
+func newNodeTransportStub(host string, err error, requests *[]*http.Request) *mock.ElasticsearchTransportStub {
+	return &mock.ElasticsearchTransportStub{
+		PerformCalled: func(req *http.Request) (*http.Response, error) {
+			*requests = append(*requests, req)
+			req.URL.Host = host
+			if err != nil {
+				return nil, err
+			}
+
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		},
+	}
+}
+
+func TestFailoverTransport_PerformShouldSendACopyOfTheRequestToEachNode(t *testing.T) {
+	t.Parallel()
+
+	requests := make([]*http.Request, 0)
+	ft := &failoverTransport{
+		nodesTransports: []estransport.Interface{
+			newNodeTransportStub("node0", errors.New("connection refused"), &requests),
+			newNodeTransportStub("node1", nil, &requests),
+		},
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "/"+txIndex+"/_bulk", strings.NewReader("body"))
+	res, err := ft.Perform(req)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	require.Equal(t, 2, len(requests))
+	require.False(t, requests[0] == requests[1])
+	require.False(t, requests[0].URL == requests[1].URL)
+	require.Equal(t, "node0", requests[0].URL.Host)
+	require.Equal(t, "node1", requests[1].URL.Host)
+	require.Equal(t, "", req.URL.Host)
+}
+
+func TestFailoverTransport_PerformCanceledContextShouldNotTryTheNextNode(t *testing.T) {
+	t.Parallel()
+
+	requests := make([]*http.Request, 0)
+	ft := &failoverTransport{
+		nodesTransports: []estransport.Interface{
+			newNodeTransportStub("node0", context.Canceled, &requests),
+			newNodeTransportStub("node1", nil, &requests),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/"+txIndex+"/_bulk", strings.NewReader("body"))
+	res, err := ft.Perform(req)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Equal(t, 1, len(requests))
+}
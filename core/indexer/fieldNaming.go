@@ -0,0 +1,157 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+const (
+	// fieldNamingCamelCase keeps the field names of the documents as they are defined by the json tags
+	fieldNamingCamelCase = "camelCase"
+	// fieldNamingSnakeCase converts the field names of the documents to snake case (e.g. shardId becomes shard_id)
+	fieldNamingSnakeCase = "snake_case"
+)
+
+// parseFieldNaming returns the function renaming the fields of the indexed documents for the provided strategy. A nil
+//  function is returned for the default strategy, as the documents do not need to be rewritten
+func parseFieldNaming(fieldNaming string) (func(fieldName string) string, error) {
+	switch fieldNaming {
+	case "", fieldNamingCamelCase:
+		return nil, nil
+	case fieldNamingSnakeCase:
+		return toSnakeCase, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFieldNaming, fieldNaming)
+	}
+}
+
+func toSnakeCase(fieldName string) string {
+	runes := []rune(fieldName)
+	var builder strings.Builder
+	builder.Grow(len(fieldName) + 4)
+
+	for i, r := range runes {
+		if !unicode.IsUpper(r) {
+			builder.WriteRune(r)
+			continue
+		}
+
+		// an underscore starts a new word, while the consecutive upper case letters of an acronym (e.g. ID) are
+		// kept in the same word
+		if i > 0 {
+			isPrevLowerOrDigit := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			isAcronymEnd := unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if isPrevLowerOrDigit || isAcronymEnd {
+				builder.WriteRune('_')
+			}
+		}
+		builder.WriteRune(unicode.ToLower(r))
+	}
+
+	return builder.String()
+}
+
+func formatFieldName(fieldNamingFunc func(fieldName string) string, fieldName string) string {
+	if fieldNamingFunc == nil {
+		return fieldName
+	}
+
+	return fieldNamingFunc(fieldName)
+}
+
+// marshalDocument serializes the document and renames all its fields, including the fields of the nested objects,
+//  with the provided naming function
+func marshalDocument(document interface{}, fieldNamingFunc func(fieldName string) string) ([]byte, error) {
+	serializedDocument, err := json.Marshal(document)
+	if err != nil || fieldNamingFunc == nil {
+		return serializedDocument, err
+	}
+
+	// the numbers are decoded as they are, so the big values will not lose precision through a float conversion
+	decoder := json.NewDecoder(bytes.NewReader(serializedDocument))
+	decoder.UseNumber()
+
+	var genericDocument interface{}
+	err = decoder.Decode(&genericDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(renameFields(genericDocument, fieldNamingFunc))
+}
+
+func renameFields(value interface{}, fieldNamingFunc func(fieldName string) string) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		renamed := make(map[string]interface{}, len(typedValue))
+		for fieldName, fieldValue := range typedValue {
+			renamed[fieldNamingFunc(fieldName)] = renameFields(fieldValue, fieldNamingFunc)
+		}
+		return renamed
+	case []interface{}:
+		for i := range typedValue {
+			typedValue[i] = renameFields(typedValue[i], fieldNamingFunc)
+		}
+		return typedValue
+	default:
+		return value
+	}
+}
+
+// unmarshalDocument deserializes a document indexed with the provided naming function, restoring the field names
+//  defined by the json tags of the document type before decoding it
+func unmarshalDocument(serializedDocument []byte, document interface{}, fieldNamingFunc func(fieldName string) string) error {
+	if fieldNamingFunc == nil {
+		return json.Unmarshal(serializedDocument, document)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(serializedDocument))
+	decoder.UseNumber()
+
+	var genericDocument interface{}
+	err := decoder.Decode(&genericDocument)
+	if err != nil {
+		return err
+	}
+
+	originalNames := make(map[string]string)
+	collectFieldNames(reflect.TypeOf(document), fieldNamingFunc, originalNames)
+	restoredDocument, err := json.Marshal(renameFields(genericDocument, func(fieldName string) string {
+		originalName, ok := originalNames[fieldName]
+		if !ok {
+			return fieldName
+		}
+		return originalName
+	}))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(restoredDocument, document)
+}
+
+// collectFieldNames maps the renamed field names of the provided type, including the fields of the nested types, to
+//  the names defined by their json tags
+func collectFieldNames(documentType reflect.Type, fieldNamingFunc func(fieldName string) string, originalNames map[string]string) {
+	switch documentType.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		collectFieldNames(documentType.Elem(), fieldNamingFunc, originalNames)
+	case reflect.Struct:
+		for i := 0; i < documentType.NumField(); i++ {
+			field := documentType.Field(i)
+			fieldName := strings.Split(field.Tag.Get("json"), ",")[0]
+			if fieldName == "-" {
+				continue
+			}
+			if fieldName != "" {
+				originalNames[fieldNamingFunc(fieldName)] = fieldName
+			}
+
+			collectFieldNames(field.Type, fieldNamingFunc, originalNames)
+		}
+	}
+}
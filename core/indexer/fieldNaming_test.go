@@ -0,0 +1,107 @@
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getSerializedTxDocumentFields(t *testing.T, fieldNaming string) map[string]interface{} {
+	fieldNamingFunc, err := parseFieldNaming(fieldNaming)
+	require.Nil(t, err)
+
+	tx := &Transaction{
+		Hash:          "txHash",
+		MBHash:        "mbHash",
+		Nonce:         1,
+		Value:         "1000",
+		Sender:        "sender",
+		ReceiverShard: 1,
+		SenderShard:   1,
+		GasLimit:      50000,
+		GasUsed:       50000,
+		Status:        txStatusSuccess,
+		SmartContractResults: []ScResult{
+			{Nonce: 2, PreTxHash: "txHash"},
+		},
+	}
+	buff := serializeBulkTxs([]*Transaction{tx}, 1, nil, fieldNamingFunc)
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	document := make(map[string]interface{})
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &document))
+
+	return document
+}
+
+func TestSerializeBulkTxs_CamelCaseFieldNamingShouldKeepTheFieldNames(t *testing.T) {
+	t.Parallel()
+
+	for _, fieldNaming := range []string{"", fieldNamingCamelCase} {
+		document := getSerializedTxDocumentFields(t, fieldNaming)
+
+		require.Contains(t, document, "miniBlockHash")
+		require.Contains(t, document, "receiverShard")
+		require.Contains(t, document, "senderShard")
+		require.Contains(t, document, "gasLimit")
+		require.Contains(t, document, "scResults")
+		require.NotContains(t, document, "receiver_shard")
+
+		scResults := document["scResults"].([]interface{})
+		require.Contains(t, scResults[0], "prevTxHash")
+	}
+}
+
+func TestSerializeBulkTxs_SnakeCaseFieldNamingShouldRenameTheFields(t *testing.T) {
+	t.Parallel()
+
+	document := getSerializedTxDocumentFields(t, fieldNamingSnakeCase)
+
+	require.Contains(t, document, "mini_block_hash")
+	require.Contains(t, document, "receiver_shard")
+	require.Contains(t, document, "sender_shard")
+	require.Contains(t, document, "gas_limit")
+	require.Contains(t, document, "sc_results")
+	require.Contains(t, document, "nonce")
+	require.NotContains(t, document, "receiverShard")
+	require.Equal(t, "1000", document["value"])
+
+	scResults := document["sc_results"].([]interface{})
+	require.Contains(t, scResults[0], "prev_tx_hash")
+}
+
+func TestPrepareTxUpdate_SnakeCaseFieldNamingShouldRenameThePartialDocumentFields(t *testing.T) {
+	t.Parallel()
+
+	tx := &Transaction{Hash: "txHash", Status: txStatusSuccess, GasLimit: 10, GasUsed: 5, GasRefunded: 5}
+	_, serializedData := prepareTxUpdate(tx, "", toSnakeCase)
+
+	update := make(map[string]map[string]interface{})
+	require.Nil(t, json.Unmarshal(serializedData, &update))
+	require.Contains(t, update["doc"], "gas_used")
+	require.Contains(t, update["doc"], "gas_refunded")
+	require.Contains(t, update["doc"], "status")
+}
+
+func TestParseFieldNaming_UnknownStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fieldNamingFunc, err := parseFieldNaming("kebab-case")
+	require.Nil(t, fieldNamingFunc)
+	require.True(t, errors.Is(err, ErrInvalidFieldNaming))
+}
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "nonce", toSnakeCase("nonce"))
+	require.Equal(t, "shard_id", toSnakeCase("shardId"))
+	require.Equal(t, "shard_id", toSnakeCase("shardID"))
+	require.Equal(t, "relayed_tx_hash", toSnakeCase("relayedTxHash"))
+	require.Equal(t, "tx_hash_2", toSnakeCase("txHash_2"))
+	require.Equal(t, "scr_hash", toSnakeCase("SCRHash"))
+}
@@ -0,0 +1,278 @@
+package indexer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const indexTemplateFileExtension = ".json"
+
+const defaultIndexSettings = `"number_of_shards": 1, "number_of_replicas": 1`
+
+// defaultIndexTemplates holds the settings and mappings shipped for each index. Big integer values are
+//  mapped as keywords because they are indexed as strings
+var defaultIndexTemplates = map[string]string{
+	blockIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `, "sort.field": "timestamp", "sort.order": "desc"}},
+		"mappings": {"_doc": {"properties": {
+			"nonce": {"type": "long"},
+			"round": {"type": "long"},
+			"epoch": {"type": "integer"},
+			"miniBlocksHashes": {"type": "keyword"},
+			"miniBlocks": {"properties": {
+				"hash": {"type": "keyword"},
+				"type": {"type": "keyword"}
+			}},
+			"notarizedBlocksHashes": {"type": "keyword"},
+			"proposer": {"type": "long"},
+			"proposerPubKey": {"type": "keyword"},
+			"validators": {"type": "long"},
+			"pubKeyBitmap": {"type": "keyword"},
+			"size": {"type": "long"},
+			"isHeaderSizeOnly": {"type": "boolean"},
+			"sizeTxs": {"type": "long"},
+			"timestamp": {"type": "date"},
+			"stateRootHash": {"type": "keyword"},
+			"prevHash": {"type": "keyword"},
+			"shardId": {"type": "integer"},
+			"txCount": {"type": "integer"}
+		}}}
+	}`,
+	txIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `, "sort.field": "timestamp", "sort.order": "desc"}},
+		"mappings": {"_doc": {"properties": {
+			"miniBlockHash": {"type": "keyword"},
+			"nonce": {"type": "long"},
+			"round": {"type": "long"},
+			"value": {"type": "keyword"},
+			"valueNum": {"type": "double"},
+			"fee": {"type": "keyword"},
+			"feeNum": {"type": "double"},
+			"receiver": {"type": "keyword"},
+			"sender": {"type": "keyword"},
+			"receiverShard": {"type": "integer"},
+			"senderShard": {"type": "integer"},
+			"gasPrice": {"type": "long"},
+			"gasLimit": {"type": "long"},
+			"gasUsed": {"type": "long"},
+			"gasRefunded": {"type": "long"},
+			"data": {"type": "text"},
+			"hasData": {"type": "boolean"},
+			"signature": {"type": "keyword", "index": false},
+			"timestamp": {"type": "date"},
+			"status": {"type": "keyword"},
+			"type": {"type": "keyword"},
+			"relayer": {"type": "keyword"},
+			"relayedTxHash": {"type": "keyword"},
+			"scResults": {"properties": {
+				"senderShard": {"type": "integer"},
+				"receiverShard": {"type": "integer"}
+			}}
+		}}}
+	}`,
+	miniblocksIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"senderShard": {"type": "integer"},
+			"receiverShard": {"type": "integer"},
+			"senderBlockHash": {"type": "keyword"},
+			"receiverBlockHash": {"type": "keyword"},
+			"type": {"type": "keyword"}
+		}}}
+	}`,
+	tpsIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}}
+	}`,
+	validatorsIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"publicKeys": {"type": "keyword"}
+		}}}
+	}`,
+	roundIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `, "sort.field": "timestamp", "sort.order": "desc"}},
+		"mappings": {"_doc": {"properties": {
+			"round": {"type": "long"},
+			"signersIndexes": {"type": "long"},
+			"blockWasProposed": {"type": "boolean"},
+			"shardId": {"type": "integer"},
+			"timestamp": {"type": "date"},
+			"epoch": {"type": "integer"},
+			"proposer": {"type": "keyword"},
+			"consensusGroup": {"type": "keyword"}
+		}}}
+	}`,
+	accountsHistoryIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"address": {"type": "keyword"},
+			"oldBalance": {"type": "keyword"},
+			"newBalance": {"type": "keyword"},
+			"delta": {"type": "keyword"},
+			"blockNonce": {"type": "long"}
+		}}}
+	}`,
+	scResultsIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `, "sort.field": "timestamp", "sort.order": "desc"}},
+		"mappings": {"_doc": {"properties": {
+			"miniBlockHash": {"type": "keyword"},
+			"nonce": {"type": "long"},
+			"gasLimit": {"type": "long"},
+			"gasPrice": {"type": "long"},
+			"value": {"type": "keyword"},
+			"sender": {"type": "keyword"},
+			"receiver": {"type": "keyword"},
+			"data": {"type": "text"},
+			"prevTxHash": {"type": "keyword"},
+			"originalTxHash": {"type": "keyword"},
+			"senderShard": {"type": "integer"},
+			"receiverShard": {"type": "integer"},
+			"timestamp": {"type": "date"}
+		}}}
+	}`,
+	logsIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"txHash": {"type": "keyword"},
+			"address": {"type": "keyword"},
+			"events": {"properties": {
+				"address": {"type": "keyword"},
+				"identifier": {"type": "keyword"},
+				"topics": {"type": "keyword"},
+				"data": {"type": "keyword", "index": false}
+			}}
+		}}}
+	}`,
+	epochInfoIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"epoch": {"type": "integer"},
+			"totalSupply": {"type": "keyword"},
+			"inflation": {"type": "keyword"},
+			"rewardsDistributed": {"type": "keyword"},
+			"rewardsForCommunity": {"type": "keyword"},
+			"accumulatedFees": {"type": "keyword"},
+			"nodePrice": {"type": "keyword"},
+			"startRound": {"type": "long"},
+			"startTimestamp": {"type": "date"},
+			"shardHeadersHashes": {"type": "keyword"},
+			"lastFinalizedHeaders": {"properties": {
+				"shardId": {"type": "integer"},
+				"epoch": {"type": "integer"},
+				"round": {"type": "long"},
+				"nonce": {"type": "long"},
+				"headerHash": {"type": "keyword"},
+				"rootHash": {"type": "keyword"},
+				"firstPendingMetaBlock": {"type": "keyword"},
+				"lastFinishedMetaBlock": {"type": "keyword"},
+				"pendingMiniBlocks": {"properties": {
+					"hash": {"type": "keyword"},
+					"senderShard": {"type": "integer"},
+					"receiverShard": {"type": "integer"},
+					"txCount": {"type": "long"},
+					"type": {"type": "keyword"}
+				}}
+			}}
+		}}}
+	}`,
+	ratingIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"validatorsRating": {"properties": {
+				"publicKey": {"type": "keyword"},
+				"rating": {"type": "float"}
+			}}
+		}}}
+	}`,
+	ratingHistoryIndex: `{
+		"settings": {"index": {` + defaultIndexSettings + `}},
+		"mappings": {"_doc": {"properties": {
+			"publicKey": {"type": "keyword"},
+			"epoch": {"type": "integer"},
+			"shardId": {"type": "integer"},
+			"rating": {"type": "float"},
+			"tempRating": {"type": "float"},
+			"numLeaderSuccess": {"type": "long"},
+			"numLeaderFailure": {"type": "long"},
+			"numValidatorSuccess": {"type": "long"},
+			"numValidatorFailure": {"type": "long"}
+		}}}
+	}`,
+}
+
+// loadIndexTemplates returns the index creation body for each of the provided indexes. If a templates directory is
+//  provided, a <index>.json file found in that directory will replace the default template of that index
+func loadIndexTemplates(templatesPath string, indexes []string) (map[string][]byte, error) {
+	templates := make(map[string][]byte, len(indexes))
+	for _, index := range indexes {
+		template, err := loadIndexTemplate(templatesPath, index)
+		if err != nil {
+			return nil, err
+		}
+
+		templates[index] = template
+	}
+
+	return templates, nil
+}
+
+func loadIndexTemplate(templatesPath string, index string) ([]byte, error) {
+	if templatesPath != "" {
+		template, err := ioutil.ReadFile(filepath.Join(templatesPath, index+indexTemplateFileExtension))
+		if err == nil {
+			return template, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	defaultTemplate, ok := defaultIndexTemplates[index]
+	if !ok {
+		return nil, nil
+	}
+
+	return []byte(defaultTemplate), nil
+}
+
+// applyIndexSettings overwrites the number of shards and replicas found in the provided template with the values set
+//  in the index settings
+func applyIndexSettings(template []byte, settings IndexSettings) ([]byte, error) {
+	if settings.NumberOfShards == 0 && settings.NumberOfReplicas == nil {
+		return template, nil
+	}
+
+	templateObject := make(map[string]interface{})
+	if len(template) > 0 {
+		err := json.Unmarshal(template, &templateObject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the values can also be provided directly under settings, without the index level
+	templateSettings := getOrCreateObject(templateObject, "settings")
+	indexSettings := getOrCreateObject(templateSettings, "index")
+	if settings.NumberOfShards > 0 {
+		delete(templateSettings, "number_of_shards")
+		indexSettings["number_of_shards"] = settings.NumberOfShards
+	}
+	if settings.NumberOfReplicas != nil {
+		delete(templateSettings, "number_of_replicas")
+		indexSettings["number_of_replicas"] = *settings.NumberOfReplicas
+	}
+
+	return json.Marshal(templateObject)
+}
+
+func getOrCreateObject(parent map[string]interface{}, key string) map[string]interface{} {
+	child, ok := parent[key].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		parent[key] = child
+	}
+
+	return child
+}
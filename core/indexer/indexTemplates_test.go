@@ -0,0 +1,133 @@
+package indexer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadIndexTemplates_NoPathShouldReturnDefaults(t *testing.T) {
+	t.Parallel()
+
+	templates, err := loadIndexTemplates("", getIndexesToCreate())
+	require.Nil(t, err)
+	for _, index := range getIndexesToCreate() {
+		require.Equal(t, defaultIndexTemplates[index], string(templates[index]))
+	}
+}
+
+func TestDefaultIndexTemplates_ShouldBeValidJson(t *testing.T) {
+	t.Parallel()
+
+	for index, template := range defaultIndexTemplates {
+		parsedTemplate := make(map[string]interface{})
+		err := json.Unmarshal([]byte(template), &parsedTemplate)
+		require.Nil(t, err, "invalid template for index %s", index)
+	}
+}
+
+func TestDefaultIndexTemplates_RatingHistoryShouldHaveItsOwnTemplate(t *testing.T) {
+	t.Parallel()
+
+	getProperties := func(index string) map[string]interface{} {
+		parsedTemplate := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal([]byte(defaultIndexTemplates[index]), &parsedTemplate))
+		mappings := parsedTemplate["mappings"].(map[string]interface{})
+		doc := mappings["_doc"].(map[string]interface{})
+
+		return doc["properties"].(map[string]interface{})
+	}
+
+	ratingProperties := getProperties(ratingIndex)
+	require.Contains(t, ratingProperties, "validatorsRating")
+	require.NotContains(t, ratingProperties, "epoch")
+
+	ratingHistoryProperties := getProperties(ratingHistoryIndex)
+	require.Contains(t, ratingHistoryProperties, "epoch")
+	require.Contains(t, ratingHistoryProperties, "tempRating")
+	require.NotContains(t, ratingHistoryProperties, "validatorsRating")
+}
+
+func TestLoadIndexTemplates_FileInPathShouldOverrideDefault(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "indexTemplates")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	customTemplate := `{"settings": {"index": {"number_of_shards": 5}}}`
+	err = ioutil.WriteFile(filepath.Join(dir, txIndex+indexTemplateFileExtension), []byte(customTemplate), 0644)
+	require.Nil(t, err)
+
+	templates, err := loadIndexTemplates(dir, []string{txIndex, blockIndex})
+	require.Nil(t, err)
+	require.Equal(t, customTemplate, string(templates[txIndex]))
+	require.Equal(t, defaultIndexTemplates[blockIndex], string(templates[blockIndex]))
+}
+
+func TestLoadIndexTemplates_UnknownIndexWithoutFileShouldReturnEmptyTemplate(t *testing.T) {
+	t.Parallel()
+
+	templates, err := loadIndexTemplates("", []string{"unknown"})
+	require.Nil(t, err)
+	require.Nil(t, templates["unknown"])
+}
+
+func TestApplyIndexSettings_NoSettingsShouldKeepTemplate(t *testing.T) {
+	t.Parallel()
+
+	template := []byte(`{"settings": {"number_of_shards": 1}}`)
+	result, err := applyIndexSettings(template, IndexSettings{})
+
+	require.Nil(t, err)
+	require.Equal(t, template, result)
+}
+
+func TestApplyIndexSettings_ShouldOverwriteFlatAndNestedValues(t *testing.T) {
+	t.Parallel()
+
+	template := []byte(`{"settings": {"number_of_shards": 1, "number_of_replicas": 1}}`)
+	result, err := applyIndexSettings(template, IndexSettings{NumberOfShards: 5})
+	require.Nil(t, err)
+
+	expected := `{"settings": {"number_of_replicas": 1, "index": {"number_of_shards": 5}}}`
+	require.JSONEq(t, expected, string(result))
+}
+
+func TestApplyIndexSettings_EmptyTemplateShouldCreateSettings(t *testing.T) {
+	t.Parallel()
+
+	numReplicas := uint32(3)
+	result, err := applyIndexSettings(nil, IndexSettings{NumberOfShards: 2, NumberOfReplicas: &numReplicas})
+	require.Nil(t, err)
+
+	expected := `{"settings": {"index": {"number_of_shards": 2, "number_of_replicas": 3}}}`
+	require.JSONEq(t, expected, string(result))
+}
+
+func TestApplyIndexSettings_ZeroReplicasShouldBeApplied(t *testing.T) {
+	t.Parallel()
+
+	template := []byte(`{"settings": {"index": {"number_of_shards": 1, "number_of_replicas": 1}}}`)
+	numReplicas := uint32(0)
+	result, err := applyIndexSettings(template, IndexSettings{NumberOfReplicas: &numReplicas})
+	require.Nil(t, err)
+
+	expected := `{"settings": {"index": {"number_of_shards": 1, "number_of_replicas": 0}}}`
+	require.JSONEq(t, expected, string(result))
+}
+
+func TestApplyIndexSettings_InvalidTemplateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	result, err := applyIndexSettings([]byte("not json"), IndexSettings{NumberOfShards: 2})
+
+	require.NotNil(t, err)
+	require.Nil(t, result)
+}
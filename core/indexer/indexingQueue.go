@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"sync"
+)
+
+// indexingQueue runs the indexing tasks on dedicated go routines, so that the block processing will not wait for the
+// elasticsearch requests. The queue is bounded and a full queue blocks the caller instead of dropping data. With more
+// than one worker, the tasks are not guaranteed to complete in the order they were enqueued
+type indexingQueue struct {
+	tasks      chan func()
+	numWorkers uint32
+	wg         sync.WaitGroup
+
+	mutClosed sync.RWMutex
+	closed    bool
+}
+
+// newIndexingQueue starts the provided number of workers draining a queue of queueSize tasks. A zero value for
+// numWorkers disables the queue, in which case nil is returned
+func newIndexingQueue(queueSize uint32, numWorkers uint32) *indexingQueue {
+	if numWorkers == 0 {
+		return nil
+	}
+
+	iq := &indexingQueue{
+		tasks:      make(chan func(), queueSize),
+		numWorkers: numWorkers,
+	}
+
+	iq.wg.Add(int(numWorkers))
+	for i := uint32(0); i < numWorkers; i++ {
+		go iq.processTasks()
+	}
+
+	return iq
+}
+
+func (iq *indexingQueue) processTasks() {
+	defer iq.wg.Done()
+
+	for task := range iq.tasks {
+		task()
+	}
+}
+
+// enqueue adds the task in the queue, blocking while the queue is full. The task is run on the caller's go routine if
+// the queue was already closed
+func (iq *indexingQueue) enqueue(task func()) {
+	iq.mutClosed.RLock()
+	if iq.closed {
+		iq.mutClosed.RUnlock()
+		task()
+		return
+	}
+
+	iq.tasks <- task
+	iq.mutClosed.RUnlock()
+}
+
+// enqueueOrdered adds the task in the queue so that it runs after all the previously enqueued tasks completed and
+// before any of the tasks enqueued afterwards starts. Each worker takes one barrier task, so the task runs once all the
+// workers finished the tasks taken before, while the other workers wait for it to complete
+func (iq *indexingQueue) enqueueOrdered(task func()) {
+	iq.mutClosed.RLock()
+	if iq.closed {
+		iq.mutClosed.RUnlock()
+		task()
+		return
+	}
+
+	workersReached := &sync.WaitGroup{}
+	workersReached.Add(int(iq.numWorkers))
+	runOnce := &sync.Once{}
+	barrierTask := func() {
+		workersReached.Done()
+		workersReached.Wait()
+		runOnce.Do(task)
+	}
+
+	for i := uint32(0); i < iq.numWorkers; i++ {
+		iq.tasks <- barrierTask
+	}
+	iq.mutClosed.RUnlock()
+}
+
+// close waits for all the enqueued tasks to complete and then stops the workers
+func (iq *indexingQueue) close() {
+	iq.mutClosed.Lock()
+	if iq.closed {
+		iq.mutClosed.Unlock()
+		return
+	}
+	iq.closed = true
+	close(iq.tasks)
+	iq.mutClosed.Unlock()
+
+	iq.wg.Wait()
+}
@@ -0,0 +1,153 @@
+package indexer
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIndexingQueue_NoWorkersShouldDisableTheQueue(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newIndexingQueue(10, 0))
+}
+
+func TestIndexingQueue_CloseShouldRunAllTheEnqueuedTasks(t *testing.T) {
+	t.Parallel()
+
+	iq := newIndexingQueue(100, 2)
+
+	numTasks := 50
+	numRunTasks := int32(0)
+	for i := 0; i < numTasks; i++ {
+		iq.enqueue(func() {
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&numRunTasks, 1)
+		})
+	}
+	iq.close()
+
+	assert.Equal(t, int32(numTasks), atomic.LoadInt32(&numRunTasks))
+}
+
+func TestIndexingQueue_FullQueueShouldBlockTheCaller(t *testing.T) {
+	t.Parallel()
+
+	iq := newIndexingQueue(1, 1)
+
+	releaseWorker := make(chan struct{})
+	iq.enqueue(func() {
+		<-releaseWorker
+	})
+	// the worker is busy, so this task fills the queue
+	iq.enqueue(func() {})
+
+	enqueued := make(chan struct{})
+	go func() {
+		iq.enqueue(func() {})
+		close(enqueued)
+	}()
+
+	select {
+	case <-enqueued:
+		assert.Fail(t, "should have blocked while the queue was full")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	close(releaseWorker)
+	select {
+	case <-enqueued:
+	case <-time.After(time.Second):
+		assert.Fail(t, "should have been enqueued after the worker was released")
+	}
+	iq.close()
+}
+
+func TestIndexingQueue_EnqueueAfterCloseShouldRunTheTaskOnTheCaller(t *testing.T) {
+	t.Parallel()
+
+	iq := newIndexingQueue(10, 1)
+	iq.close()
+	iq.close()
+
+	wasRun := false
+	iq.enqueue(func() {
+		wasRun = true
+	})
+	assert.True(t, wasRun)
+}
+
+func TestIndexingQueue_ConcurrentEnqueueAndCloseShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	iq := newIndexingQueue(10, 4)
+
+	numRunTasks := int32(0)
+	wg := sync.WaitGroup{}
+	numCallers := 20
+	wg.Add(numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func() {
+			iq.enqueue(func() {
+				atomic.AddInt32(&numRunTasks, 1)
+			})
+			wg.Done()
+		}()
+	}
+	iq.close()
+	wg.Wait()
+
+	assert.Equal(t, int32(numCallers), atomic.LoadInt32(&numRunTasks))
+}
+
+func TestIndexingQueue_EnqueueOrderedShouldRunAfterThePreviousTasksAndBeforeTheNextOnes(t *testing.T) {
+	t.Parallel()
+
+	iq := newIndexingQueue(10, 3)
+
+	mut := sync.Mutex{}
+	runTasks := make([]string, 0)
+	addRunTask := func(name string) {
+		mut.Lock()
+		runTasks = append(runTasks, name)
+		mut.Unlock()
+	}
+
+	releasePrevious := make(chan struct{})
+	iq.enqueue(func() {
+		<-releasePrevious
+		addRunTask("previous")
+	})
+	iq.enqueueOrdered(func() {
+		addRunTask("ordered")
+	})
+	iq.enqueue(func() {
+		addRunTask("next")
+	})
+
+	// the free workers do not run the ordered and the next tasks while the previous one is pending
+	time.Sleep(time.Millisecond * 50)
+	mut.Lock()
+	assert.Equal(t, 0, len(runTasks))
+	mut.Unlock()
+
+	close(releasePrevious)
+	iq.close()
+	assert.Equal(t, []string{"previous", "ordered", "next"}, runTasks)
+}
+
+func TestIndexingQueue_EnqueueOrderedAfterCloseShouldRunTheTaskOnTheCaller(t *testing.T) {
+	t.Parallel()
+
+	iq := newIndexingQueue(10, 2)
+	iq.close()
+
+	wasRun := false
+	iq.enqueueOrdered(func() {
+		wasRun = true
+	})
+	assert.True(t, wasRun)
+}
@@ -2,11 +2,13 @@ package indexer
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
@@ -16,10 +18,21 @@ import (
 type Indexer interface {
 	SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase)
 	SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64, notarizedHeadersHashes []string)
+	RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler)
+	SaveLogs(logs map[string]data.LogHandler)
 	SaveRoundInfo(roundInfo RoundInfo)
+	SaveRoundsInfo(roundsInfos []RoundInfo)
+	ReindexBlock(headerHash []byte) error
 	UpdateTPS(tpsBenchmark statistics.TPSBenchmark)
 	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32)
 	SaveValidatorsRating(indexID string, infoRating []ValidatorRatingInfo)
+	SaveValidatorsRatingHistory(epoch uint32, validators map[string]*state.ValidatorApiResponse)
+	SaveAccountsHistory(blockNonce uint64, changes []AccountBalanceChange)
+	SaveEpochStartEconomics(epoch uint32, econ EpochEconomics)
+	SaveEpochStartInfo(metaBlock *block.MetaBlock)
+	VerifyContiguity(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
+	CheckHealth() error
+	Close() error
 	IsInterfaceNil() bool
 	IsNilIndexer() bool
 }
@@ -30,15 +43,34 @@ type databaseHandler interface {
 	SaveHeader(header data.HeaderHandler, signersIndexes []uint64, body *block.Body, notarizedHeadersHashes []string, txsSize int)
 	SaveMiniblocks(header data.HeaderHandler, body *block.Body)
 	SaveTransactions(body *block.Body, header data.HeaderHandler, txPool map[string]data.TransactionHandler, selfShardId uint32)
+	SaveLogs(logs map[string]data.LogHandler)
+	RemoveHeader(header data.HeaderHandler)
+	RemoveTransactions(body *block.Body, header data.HeaderHandler)
 	SaveRoundInfo(info RoundInfo)
+	SaveRoundsInfo(infos []RoundInfo)
 	SaveShardValidatorsPubKeys(shardId, epoch uint32, shardValidatorsPubKeys [][]byte)
 	SaveValidatorsRating(Index string, validatorsRatingInfo []ValidatorRatingInfo)
+	SaveValidatorsRatingHistory(epoch uint32, validators map[string]*state.ValidatorApiResponse)
 	SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark)
+	SaveAccountsHistory(blockNonce uint64, changes []AccountBalanceChange)
+	SaveEpochInfo(epoch uint32, econ EpochEconomics)
+	SaveEpochStartInfo(metaBlock *block.MetaBlock)
+	VerifyContiguity(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
+	GetTransactionByHash(hash string) (*Transaction, error)
+	GetBlockByHash(hash string) (*Block, error)
+	CheckHealth() error
+	Close() error
 }
 
 // databaseWriterHandler is an interface that do requests to elasticsearch server do save data
 type databaseWriterHandler interface {
 	DoRequest(req *esapi.IndexRequest) error
 	DoBulkRequest(buff *bytes.Buffer, index string) error
+	DoExistsRequest(index string, id string) (bool, error)
+	DoIndexExistsRequest(index string) (bool, error)
+	DoPingRequest() error
 	CheckAndCreateIndex(index string, body io.Reader) error
+	DoScrollRequest(index string, query []byte, handleSources func(sources []json.RawMessage) error) error
+	DoDeleteRequest(index string, ids []string) error
+	DoGetRequest(index string, id string) (json.RawMessage, error)
 }
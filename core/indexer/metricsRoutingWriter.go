@@ -0,0 +1,90 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// metricsRoutingWriter sends the documents of the operational metrics indexes (rounds, tps and validators) to a
+// dedicated elasticsearch cluster and all the other documents to the primary one
+type metricsRoutingWriter struct {
+	primaryWriter databaseWriterHandler
+	metricsWriter databaseWriterHandler
+}
+
+func newMetricsRoutingWriter(primaryWriter databaseWriterHandler, metricsWriter databaseWriterHandler) *metricsRoutingWriter {
+	return &metricsRoutingWriter{
+		primaryWriter: primaryWriter,
+		metricsWriter: metricsWriter,
+	}
+}
+
+func isMetricsIndex(index string) bool {
+	switch index {
+	case roundIndex, tpsIndex, validatorsIndex:
+		return true
+	default:
+		return false
+	}
+}
+
+func (mrw *metricsRoutingWriter) writerForIndex(index string) databaseWriterHandler {
+	if isMetricsIndex(index) {
+		return mrw.metricsWriter
+	}
+
+	return mrw.primaryWriter
+}
+
+// DoRequest will do the index request on the cluster holding the request's index
+func (mrw *metricsRoutingWriter) DoRequest(req *esapi.IndexRequest) error {
+	return mrw.writerForIndex(req.Index).DoRequest(req)
+}
+
+// DoBulkRequest will do the bulk request on the cluster holding the provided index
+func (mrw *metricsRoutingWriter) DoBulkRequest(buff *bytes.Buffer, index string) error {
+	return mrw.writerForIndex(index).DoBulkRequest(buff, index)
+}
+
+// DoExistsRequest will check the document existence on the cluster holding the provided index
+func (mrw *metricsRoutingWriter) DoExistsRequest(index string, id string) (bool, error) {
+	return mrw.writerForIndex(index).DoExistsRequest(index, id)
+}
+
+// DoIndexExistsRequest will check the index existence on the cluster that should hold it
+func (mrw *metricsRoutingWriter) DoIndexExistsRequest(index string) (bool, error) {
+	return mrw.writerForIndex(index).DoIndexExistsRequest(index)
+}
+
+// DoPingRequest will ping both clusters
+func (mrw *metricsRoutingWriter) DoPingRequest() error {
+	err := mrw.primaryWriter.DoPingRequest()
+	if err != nil {
+		return err
+	}
+
+	return mrw.metricsWriter.DoPingRequest()
+}
+
+// CheckAndCreateIndex will create the index, if missing, on the cluster that should hold it
+func (mrw *metricsRoutingWriter) CheckAndCreateIndex(index string, body io.Reader) error {
+	return mrw.writerForIndex(index).CheckAndCreateIndex(index, body)
+}
+
+// DoScrollRequest will scroll through the matched documents on the cluster holding the provided index
+func (mrw *metricsRoutingWriter) DoScrollRequest(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+	return mrw.writerForIndex(index).DoScrollRequest(index, query, handleSources)
+}
+
+// DoDeleteRequest will delete the documents from the cluster holding the provided index
+func (mrw *metricsRoutingWriter) DoDeleteRequest(index string, ids []string) error {
+	return mrw.writerForIndex(index).DoDeleteRequest(index, ids)
+}
+
+// DoGetRequest will return the document from the cluster holding the provided index
+func (mrw *metricsRoutingWriter) DoGetRequest(index string, id string) (json.RawMessage, error) {
+	return mrw.writerForIndex(index).DoGetRequest(index, id)
+}
@@ -3,6 +3,8 @@ package indexer
 import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -23,10 +25,27 @@ func (ni *NilIndexer) SaveBlock(_ data.BodyHandler, _ data.HeaderHandler, _ map[
 func (ni *NilIndexer) SetTxLogsProcessor(_ process.TransactionLogProcessorDatabase) {
 }
 
+// RevertIndexedBlock will do nothing
+func (ni *NilIndexer) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
 // SaveRoundInfo will do nothing
 func (ni *NilIndexer) SaveRoundInfo(_ RoundInfo) {
 }
 
+// SaveRoundsInfo will do nothing
+func (ni *NilIndexer) SaveRoundsInfo(_ []RoundInfo) {
+}
+
+// SaveLogs will do nothing
+func (ni *NilIndexer) SaveLogs(_ map[string]data.LogHandler) {
+}
+
+// ReindexBlock will do nothing
+func (ni *NilIndexer) ReindexBlock(_ []byte) error {
+	return nil
+}
+
 // UpdateTPS will do nothing
 func (ni *NilIndexer) UpdateTPS(_ statistics.TPSBenchmark) {
 }
@@ -35,6 +54,27 @@ func (ni *NilIndexer) UpdateTPS(_ statistics.TPSBenchmark) {
 func (ni *NilIndexer) SaveValidatorsRating(_ string, _ []ValidatorRatingInfo) {
 }
 
+// SaveValidatorsRatingHistory will do nothing
+func (ni *NilIndexer) SaveValidatorsRatingHistory(_ uint32, _ map[string]*state.ValidatorApiResponse) {
+}
+
+// SaveAccountsHistory will do nothing
+func (ni *NilIndexer) SaveAccountsHistory(_ uint64, _ []AccountBalanceChange) {
+}
+
+// SaveEpochStartEconomics will do nothing
+func (ni *NilIndexer) SaveEpochStartEconomics(_ uint32, _ EpochEconomics) {
+}
+
+// SaveEpochStartInfo will do nothing
+func (ni *NilIndexer) SaveEpochStartInfo(_ *block.MetaBlock) {
+}
+
+// VerifyContiguity will do nothing
+func (ni *NilIndexer) VerifyContiguity(_ uint64, _ uint64, _ uint32) ([]uint64, error) {
+	return nil, nil
+}
+
 // SaveValidatorsPubKeys will do nothing
 func (ni *NilIndexer) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 }
@@ -44,6 +84,16 @@ func (ni *NilIndexer) IsInterfaceNil() bool {
 	return ni == nil
 }
 
+// CheckHealth returns nil
+func (ni *NilIndexer) CheckHealth() error {
+	return nil
+}
+
+// Close returns nil
+func (ni *NilIndexer) Close() error {
+	return nil
+}
+
 // IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
 func (ni *NilIndexer) IsNilIndexer() bool {
 	return true
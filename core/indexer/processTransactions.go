@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -23,6 +25,9 @@ const (
 	txStatusPending     = "Pending"
 	txStatusInvalid     = "Invalid"
 	txStatusNotExecuted = "Not Executed"
+	txTypeNormal        = "normal"
+	txTypeRelayed       = "relayed"
+	txTypeReward        = "reward"
 	// A smart contract action (deploy, call, ...) should have minimum 2 smart contract results
 	// exception to this rule are smart contract calls to ESDT contract
 	minimumNumberOfSmartContractResults = 2
@@ -33,6 +38,9 @@ type txDatabaseProcessor struct {
 	txLogsProcessor process.TransactionLogProcessorDatabase
 	hasher          hashing.Hasher
 	marshalizer     marshal.Marshalizer
+	storeTxData     bool
+	maxDataBytes    uint32
+	feeHandler      process.FeeHandler
 }
 
 func newTxDatabaseProcessor(
@@ -40,6 +48,7 @@ func newTxDatabaseProcessor(
 	marshalizer marshal.Marshalizer,
 	addressPubkeyConverter core.PubkeyConverter,
 	validatorPubkeyConverter core.PubkeyConverter,
+	denomination int,
 ) *txDatabaseProcessor {
 	return &txDatabaseProcessor{
 		hasher:      hasher,
@@ -47,8 +56,10 @@ func newTxDatabaseProcessor(
 		commonProcessor: &commonProcessor{
 			addressPubkeyConverter:   addressPubkeyConverter,
 			validatorPubkeyConverter: validatorPubkeyConverter,
+			denomination:             denominationOrDefault(denomination),
 		},
 		txLogsProcessor: disabled.NewNilTxLogsProcessor(),
+		storeTxData:     true,
 	}
 }
 
@@ -61,6 +72,7 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 	transactions, rewardsTxs := tdp.groupNormalTxsAndRewards(body, txPool, header, selfShardID)
 	receipts := groupReceipts(txPool)
 	scResults := groupSmartContractResults(txPool)
+	scResultsMiniBlocks := mapMiniBlocksByTxHash(body, block.SmartContractResultBlock)
 
 	for _, rec := range receipts {
 		tx, ok := transactions[string(rec.TxHash)]
@@ -68,22 +80,23 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 			continue
 		}
 
-		gasUsed := big.NewInt(0).SetUint64(tx.GasPrice)
-		gasUsed.Mul(gasUsed, big.NewInt(0).SetUint64(tx.GasLimit))
-		gasUsed.Sub(gasUsed, rec.Value)
-		gasUsed.Div(gasUsed, big.NewInt(0).SetUint64(tx.GasPrice))
+		gasUsed, ok := computeGasUsedFromRefund(tx, rec.Value)
+		if !ok {
+			continue
+		}
 
-		tx.GasUsed = gasUsed.Uint64()
+		tx.GasUsed = gasUsed
 	}
 
 	countScResults := make(map[string]int)
-	for _, scResult := range scResults {
+	for scHash, scResult := range scResults {
 		tx, ok := transactions[string(scResult.OriginalTxHash)]
 		if !ok {
 			continue
 		}
 
-		tx = tdp.addScResultInfoInTx(scResult, tx)
+		senderShard, receiverShard := getScResultShards(scResultsMiniBlocks, scHash, selfShardID)
+		tx = tdp.addScResultInfoInTx(scResult, senderShard, receiverShard, tx)
 
 		countScResults[string(scResult.OriginalTxHash)]++
 	}
@@ -113,20 +126,59 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 	//	tx.Log = tdp.prepareTxLog(txLog)
 	//}
 
-	tdp.txLogsProcessor.Clean()
+	for _, tx := range transactions {
+		tx.GasRefunded = tx.GasLimit - tx.GasUsed
+		tdp.setTransactionFee(tx)
+	}
+
+	innerTxs := tdp.prepareRelayedInnerTransactions(transactions)
+	for hash, innerTx := range innerTxs {
+		transactions[hash] = innerTx
+	}
 
-	return append(convertMapTxsToSlice(transactions), rewardsTxs...)
+	// the data field is filtered only at the end, as the relayed inner transactions are decoded from it
+	preparedTxs := append(convertMapTxsToSlice(transactions), rewardsTxs...)
+	for _, tx := range preparedTxs {
+		tdp.filterTxData(tx)
+	}
+
+	return preparedTxs
 }
 
-func (tdp *txDatabaseProcessor) addScResultInfoInTx(scr *smartContractResult.SmartContractResult, tx *Transaction) *Transaction {
+// filterTxData flags the transactions sent with a data field and then omits or truncates the field, as configured
+func (tdp *txDatabaseProcessor) filterTxData(tx *Transaction) {
+	tx.HasData = len(tx.Data) > 0
+	tx.Data = tdp.filterData(tx.Data)
+}
+
+func (tdp *txDatabaseProcessor) filterData(data string) string {
+	if !tdp.storeTxData {
+		return ""
+	}
+	if tdp.maxDataBytes > 0 && len(data) > int(tdp.maxDataBytes) {
+		return data[:tdp.maxDataBytes]
+	}
+
+	return data
+}
+
+func (tdp *txDatabaseProcessor) addScResultInfoInTx(
+	scr *smartContractResult.SmartContractResult,
+	senderShard uint32,
+	receiverShard uint32,
+	tx *Transaction,
+) *Transaction {
 	dbScResult := tdp.commonProcessor.convertScResultInDatabaseScr(scr)
+	dbScResult.SenderShard = senderShard
+	dbScResult.ReceiverShard = receiverShard
 	if tx.Sender != dbScResult.Receiver || dbScResult.Data == "" {
 		return tx
 	}
 
+	dbScResult.Data = tdp.filterData(dbScResult.Data)
 	tx.SmartContractResults = append(tx.SmartContractResults, dbScResult)
 
-	if dbScResult.GasLimit != 0 && dbScResult.Value != "0" {
+	if dbScResult.GasLimit != 0 && dbScResult.Value != "0" && scr.GasLimit <= tx.GasLimit {
 		gasUsed := tx.GasLimit - scr.GasLimit
 		tx.GasUsed = gasUsed
 	}
@@ -134,6 +186,38 @@ func (tdp *txDatabaseProcessor) addScResultInfoInTx(scr *smartContractResult.Sma
 	return tx
 }
 
+// setMoveBalanceGasUsed sets the gas used by a move balance transaction to the gas needed for its data, as computed by
+// the fee handler. The smart contract calls keep the whole gas limit as used, until a refund is found for them. Nothing
+// is changed if no fee handler was provided
+func (tdp *txDatabaseProcessor) setMoveBalanceGasUsed(dbTx *Transaction, tx *transaction.Transaction) {
+	if check.IfNil(tdp.feeHandler) || core.IsSmartContractAddress(tx.RcvAddr) {
+		return
+	}
+
+	gasUsed := tdp.feeHandler.ComputeGasLimit(tx)
+	if gasUsed > tx.GasLimit {
+		return
+	}
+
+	dbTx.GasUsed = gasUsed
+}
+
+// computeGasUsedFromRefund returns the gas actually consumed by the transaction, knowing the value refunded to the
+// sender for the unused gas. It returns false if the refunded value can not be converted in a valid amount of gas, in
+// which case the whole gas limit is considered as consumed
+func computeGasUsedFromRefund(tx *Transaction, refundValue *big.Int) (uint64, bool) {
+	if tx.GasPrice == 0 || refundValue == nil || refundValue.Sign() < 0 {
+		return 0, false
+	}
+
+	gasRefunded := big.NewInt(0).Div(refundValue, big.NewInt(0).SetUint64(tx.GasPrice))
+	if !gasRefunded.IsUint64() || gasRefunded.Uint64() > tx.GasLimit {
+		return 0, false
+	}
+
+	return tx.GasLimit - gasRefunded.Uint64(), true
+}
+
 func (tdp *txDatabaseProcessor) prepareTxLog(log data.LogHandler) TxLog {
 	scAddr := tdp.addressPubkeyConverter.Encode(log.GetAddress())
 	events := log.GetLogEvents()
@@ -141,7 +225,7 @@ func (tdp *txDatabaseProcessor) prepareTxLog(log data.LogHandler) TxLog {
 	txLogEvents := make([]Event, len(events))
 	for i, event := range events {
 		txLogEvents[i].Address = hex.EncodeToString(event.GetAddress())
-		txLogEvents[i].Data = hex.EncodeToString(event.GetData())
+		txLogEvents[i].Data = tdp.filterData(hex.EncodeToString(event.GetData()))
 		txLogEvents[i].Identifier = hex.EncodeToString(event.GetIdentifier())
 
 		topics := event.GetTopics()
@@ -157,6 +241,46 @@ func (tdp *txDatabaseProcessor) prepareTxLog(log data.LogHandler) TxLog {
 	}
 }
 
+// prepareLogsForDatabase builds the document of each provided log, keyed by the hash of its transaction. The addresses
+//  are encoded with the address converter while the topics and the data of the events are hex encoded. The data of the
+//  events is omitted or truncated as the data field of the transactions
+func (tdp *txDatabaseProcessor) prepareLogsForDatabase(logs map[string]data.LogHandler) []*LogDocument {
+	logDocuments := make([]*LogDocument, 0, len(logs))
+	for txHash, txLog := range logs {
+		if check.IfNil(txLog) {
+			continue
+		}
+
+		events := txLog.GetLogEvents()
+		logEvents := make([]Event, 0, len(events))
+		for _, event := range events {
+			if check.IfNil(event) {
+				continue
+			}
+
+			topics := make([]string, len(event.GetTopics()))
+			for i, topic := range event.GetTopics() {
+				topics[i] = hex.EncodeToString(topic)
+			}
+
+			logEvents = append(logEvents, Event{
+				Address:    tdp.addressPubkeyConverter.Encode(event.GetAddress()),
+				Identifier: string(event.GetIdentifier()),
+				Topics:     topics,
+				Data:       tdp.filterData(hex.EncodeToString(event.GetData())),
+			})
+		}
+
+		logDocuments = append(logDocuments, &LogDocument{
+			TxHash:  hex.EncodeToString([]byte(txHash)),
+			Address: tdp.addressPubkeyConverter.Encode(txLog.GetAddress()),
+			Events:  logEvents,
+		})
+	}
+
+	return logDocuments
+}
+
 func convertMapTxsToSlice(txs map[string]*Transaction) []*Transaction {
 	transactions := make([]*Transaction, len(txs))
 	i := 0
@@ -195,6 +319,7 @@ func (tdp *txDatabaseProcessor) groupNormalTxsAndRewards(
 			txs := getTransactions(txPool, mb.TxHashes)
 			for hash, tx := range txs {
 				dbTx := tdp.commonProcessor.buildTransaction(tx, []byte(hash), mbHash, mb, header, mbTxStatus)
+				tdp.setMoveBalanceGasUsed(dbTx, tx)
 				transactions[hash] = dbTx
 				delete(txPool, hash)
 			}
@@ -220,20 +345,92 @@ func (tdp *txDatabaseProcessor) groupNormalTxsAndRewards(
 	return transactions, rewardsTxs
 }
 
-func groupSmartContractResults(txPool map[string]data.TransactionHandler) []*smartContractResult.SmartContractResult {
-	scResults := make([]*smartContractResult.SmartContractResult, 0)
-	for _, tx := range txPool {
+// prepareScResultsForDatabase builds a document for each smart contract result of the body's smart contract results
+//  miniblocks. The results missing from the pool are skipped
+func (tdp *txDatabaseProcessor) prepareScResultsForDatabase(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+) []*ScResultDocument {
+	scResults := make([]*ScResultDocument, 0)
+	for _, mb := range body.MiniBlocks {
+		if mb.Type != block.SmartContractResultBlock {
+			continue
+		}
+
+		mbHash, err := core.CalculateHash(tdp.marshalizer, tdp.hasher, mb)
+		if err != nil {
+			continue
+		}
+
+		for _, scHash := range mb.TxHashes {
+			scr, ok := txPool[string(scHash)].(*smartContractResult.SmartContractResult)
+			if !ok {
+				continue
+			}
+
+			scResults = append(scResults, &ScResultDocument{
+				Hash:           hex.EncodeToString(scHash),
+				MBHash:         hex.EncodeToString(mbHash),
+				Nonce:          scr.Nonce,
+				GasLimit:       scr.GasLimit,
+				GasPrice:       scr.GasPrice,
+				Value:          bigIntToString(scr.Value),
+				Sender:         tdp.addressPubkeyConverter.Encode(scr.SndAddr),
+				Receiver:       tdp.addressPubkeyConverter.Encode(scr.RcvAddr),
+				Data:           tdp.filterData(string(scr.Data)),
+				PreTxHash:      hex.EncodeToString(scr.PrevTxHash),
+				OriginalTxHash: hex.EncodeToString(scr.OriginalTxHash),
+				SenderShard:    mb.SenderShardID,
+				ReceiverShard:  mb.ReceiverShardID,
+				Timestamp:      time.Duration(header.GetTimeStamp()),
+			})
+		}
+	}
+
+	return scResults
+}
+
+func groupSmartContractResults(txPool map[string]data.TransactionHandler) map[string]*smartContractResult.SmartContractResult {
+	scResults := make(map[string]*smartContractResult.SmartContractResult)
+	for hash, tx := range txPool {
 		scResult, ok := tx.(*smartContractResult.SmartContractResult)
 		if !ok {
 			continue
 		}
 
-		scResults = append(scResults, scResult)
+		scResults[hash] = scResult
 	}
 
 	return scResults
 }
 
+func mapMiniBlocksByTxHash(body *block.Body, mbType block.Type) map[string]*block.MiniBlock {
+	miniBlocks := make(map[string]*block.MiniBlock)
+	for _, mb := range body.MiniBlocks {
+		if mb.Type != mbType {
+			continue
+		}
+
+		for _, txHash := range mb.TxHashes {
+			miniBlocks[string(txHash)] = mb
+		}
+	}
+
+	return miniBlocks
+}
+
+// getScResultShards returns the sender and receiver shards of the miniblock holding the smart contract result. The
+// results which are not part of the block body were generated and executed in the self shard
+func getScResultShards(scResultsMiniBlocks map[string]*block.MiniBlock, scHash string, selfShardID uint32) (uint32, uint32) {
+	mb, ok := scResultsMiniBlocks[scHash]
+	if !ok {
+		return selfShardID, selfShardID
+	}
+
+	return mb.SenderShardID, mb.ReceiverShardID
+}
+
 func groupReceipts(txPool map[string]data.TransactionHandler) []*receipt.Receipt {
 	receipts := make([]*receipt.Receipt, 0)
 	for hash, tx := range txPool {
@@ -2,9 +2,12 @@ package indexer
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"math"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -12,7 +15,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPrepareTransactionsForDatabase(t *testing.T) {
@@ -118,6 +123,7 @@ func TestPrepareTransactionsForDatabase(t *testing.T) {
 		&mock.MarshalizerMock{},
 		&mock.PubkeyConverterMock{},
 		&mock.PubkeyConverterMock{},
+		defaultDenomination,
 	)
 
 	transactions := txDbProc.prepareTransactionsForDatabase(body, header, txPool, 0)
@@ -125,6 +131,264 @@ func TestPrepareTransactionsForDatabase(t *testing.T) {
 
 }
 
+func TestPrepareTransactionsForDatabaseShouldSetTheStatusFromTheMiniBlockType(t *testing.T) {
+	t.Parallel()
+
+	executedTxHash := []byte("executedTxHash")
+	pendingTxHash := []byte("pendingTxHash")
+	invalidTxHash := []byte("invalidTxHash")
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{executedTxHash},
+				Type:     block.TxBlock,
+			},
+			{
+				TxHashes:        [][]byte{pendingTxHash},
+				ReceiverShardID: 1,
+				Type:            block.TxBlock,
+			},
+			{
+				TxHashes: [][]byte{invalidTxHash},
+				Type:     block.InvalidBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(executedTxHash): &transaction.Transaction{Nonce: 1},
+		string(pendingTxHash):  &transaction.Transaction{Nonce: 2},
+		string(invalidTxHash):  &transaction.Transaction{Nonce: 3},
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	require.Equal(t, 3, len(transactions))
+
+	statuses := make(map[string]string)
+	for _, tx := range transactions {
+		statuses[tx.Hash] = tx.Status
+	}
+	assert.Equal(t, txStatusSuccess, statuses[hex.EncodeToString(executedTxHash)])
+	assert.Equal(t, txStatusPending, statuses[hex.EncodeToString(pendingTxHash)])
+	assert.Equal(t, txStatusInvalid, statuses[hex.EncodeToString(invalidTxHash)])
+}
+
+func TestPrepareTransactionsForDatabaseCrossShardShouldSetShards(t *testing.T) {
+	t.Parallel()
+
+	senderAddr := []byte("sender")
+	txHash := []byte("txHash")
+	tx := &transaction.Transaction{
+		SndAddr:  senderAddr,
+		GasLimit: 100,
+		GasPrice: 100,
+	}
+	scHash := []byte("scHash")
+	scResult := &smartContractResult.SmartContractResult{
+		OriginalTxHash: txHash,
+		RcvAddr:        senderAddr,
+		Value:          big.NewInt(0),
+		Data:           []byte("@" + "6F6B"),
+	}
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes:        [][]byte{txHash},
+				Type:            block.TxBlock,
+				SenderShardID:   1,
+				ReceiverShardID: 2,
+			},
+			{
+				TxHashes:        [][]byte{scHash},
+				Type:            block.SmartContractResultBlock,
+				SenderShardID:   2,
+				ReceiverShardID: 1,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash): tx,
+		string(scHash): scResult,
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 1)
+	assert.Equal(t, 1, len(transactions))
+	assert.Equal(t, uint32(1), transactions[0].SenderShard)
+	assert.Equal(t, uint32(2), transactions[0].ReceiverShard)
+	assert.Equal(t, 1, len(transactions[0].SmartContractResults))
+	assert.Equal(t, uint32(2), transactions[0].SmartContractResults[0].SenderShard)
+	assert.Equal(t, uint32(1), transactions[0].SmartContractResults[0].ReceiverShard)
+}
+
+func TestPrepareScResultsForDatabase(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	scHash := []byte("scHash")
+	scResult := &smartContractResult.SmartContractResult{
+		Nonce:          3,
+		GasLimit:       500,
+		GasPrice:       10,
+		Value:          big.NewInt(1000),
+		SndAddr:        []byte("sender"),
+		RcvAddr:        []byte("receiver"),
+		Data:           []byte("@6F6B"),
+		PrevTxHash:     txHash,
+		OriginalTxHash: txHash,
+	}
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash},
+				Type:     block.TxBlock,
+			},
+			{
+				TxHashes:        [][]byte{scHash, []byte("missing scHash")},
+				Type:            block.SmartContractResultBlock,
+				SenderShardID:   2,
+				ReceiverShardID: 1,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash): &transaction.Transaction{},
+		string(scHash): scResult,
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	scResults := txDbProc.prepareScResultsForDatabase(body, &block.Header{TimeStamp: 100}, txPool)
+	require.Equal(t, 1, len(scResults))
+
+	scr := scResults[0]
+	assert.Equal(t, hex.EncodeToString(scHash), scr.Hash)
+	assert.Equal(t, hex.EncodeToString(txHash), scr.OriginalTxHash)
+	assert.Equal(t, hex.EncodeToString(txHash), scr.PreTxHash)
+	assert.Equal(t, uint64(3), scr.Nonce)
+	assert.Equal(t, uint64(500), scr.GasLimit)
+	assert.Equal(t, uint64(10), scr.GasPrice)
+	assert.Equal(t, "1000", scr.Value)
+	assert.Equal(t, hex.EncodeToString([]byte("sender")), scr.Sender)
+	assert.Equal(t, hex.EncodeToString([]byte("receiver")), scr.Receiver)
+	assert.Equal(t, "@6F6B", scr.Data)
+	assert.Equal(t, uint32(2), scr.SenderShard)
+	assert.Equal(t, uint32(1), scr.ReceiverShard)
+}
+
+func TestPrepareTransactionsForDatabase_FeeHandlerShouldComputeTheMoveBalanceGasUsed(t *testing.T) {
+	t.Parallel()
+
+	moveBalanceTxHash := []byte("move balance tx")
+	scCallTxHash := []byte("sc call tx")
+	scAddress := make([]byte, 32)
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{moveBalanceTxHash, scCallTxHash},
+				Type:     block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(moveBalanceTxHash): &transaction.Transaction{
+			RcvAddr:  []byte("receiver"),
+			GasLimit: 100000,
+			GasPrice: 10,
+			Value:    big.NewInt(0),
+		},
+		string(scCallTxHash): &transaction.Transaction{
+			RcvAddr:  scAddress,
+			GasLimit: 100000,
+			GasPrice: 10,
+			Value:    big.NewInt(0),
+		},
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+	txDbProc.feeHandler = &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 50000
+		},
+	}
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	require.Equal(t, 2, len(transactions))
+	for _, tx := range transactions {
+		if tx.Hash == hex.EncodeToString(moveBalanceTxHash) {
+			assert.Equal(t, uint64(50000), tx.GasUsed)
+			assert.Equal(t, "500000", tx.Fee)
+			continue
+		}
+
+		assert.Equal(t, uint64(100000), tx.GasUsed)
+		assert.Equal(t, "1000000", tx.Fee)
+	}
+}
+
+func TestPrepareTransactionsForDatabase_NilFeeHandlerShouldKeepTheGasLimitAsUsed(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx")
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash},
+				Type:     block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash): &transaction.Transaction{
+			RcvAddr:  []byte("receiver"),
+			GasLimit: 100000,
+			GasPrice: 10,
+			Value:    big.NewInt(0),
+		},
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	require.Equal(t, 1, len(transactions))
+	assert.Equal(t, uint64(100000), transactions[0].GasUsed)
+	assert.Equal(t, "1000000", transactions[0].Fee)
+}
+
 func TestPrepareTxLog(t *testing.T) {
 	t.Parallel()
 
@@ -133,6 +397,7 @@ func TestPrepareTxLog(t *testing.T) {
 		&mock.MarshalizerMock{},
 		&mock.PubkeyConverterMock{},
 		&mock.PubkeyConverterMock{},
+		defaultDenomination,
 	)
 
 	scAddr := []byte("addr")
@@ -166,3 +431,326 @@ func TestPrepareTxLog(t *testing.T) {
 	dbTxLog := txDbProc.prepareTxLog(txLog)
 	assert.Equal(t, expectedTxLog, dbTxLog)
 }
+
+func TestPrepareTransactionsForDatabaseShouldSetTheRawAndDenominatedAmounts(t *testing.T) {
+	t.Parallel()
+
+	oneEGLD, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+	txHash := []byte("txHash")
+	tx := &transaction.Transaction{
+		Value:    oneEGLD,
+		GasLimit: 50000,
+		GasPrice: 1000000000,
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash},
+				Type:     block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash): tx,
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	require.Equal(t, 1, len(transactions))
+	assert.Equal(t, "1000000000000000000", transactions[0].Value)
+	assert.Equal(t, float64(1), transactions[0].ValueNum)
+	assert.Equal(t, "50000000000000", transactions[0].Fee)
+	assert.InDelta(t, 0.00005, transactions[0].FeeNum, 1e-12)
+}
+
+func TestPrepareTransactionsForDatabaseFailedTxWithPartialRefundShouldIndexTheGasUsed(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	tx := &transaction.Transaction{
+		GasLimit: 100000,
+		GasPrice: 10,
+		Data:     []byte("callFunction"),
+	}
+	recHash := []byte("recHash")
+	rec := &receipt.Receipt{
+		Value:  big.NewInt(300000),
+		TxHash: txHash,
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash},
+				Type:     block.InvalidBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash):  tx,
+		string(recHash): rec,
+	}
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	require.Equal(t, 1, len(transactions))
+	assert.Equal(t, txStatusInvalid, transactions[0].Status)
+	assert.True(t, transactions[0].GasUsed < transactions[0].GasLimit)
+	assert.Equal(t, uint64(70000), transactions[0].GasUsed)
+	assert.Equal(t, uint64(30000), transactions[0].GasRefunded)
+	assert.Equal(t, "700000", transactions[0].Fee)
+}
+
+func TestComputeGasUsedFromRefund(t *testing.T) {
+	t.Parallel()
+
+	tx := &Transaction{
+		GasLimit: 1000,
+		GasPrice: 10,
+	}
+
+	gasUsed, ok := computeGasUsedFromRefund(tx, big.NewInt(2500))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(750), gasUsed)
+
+	_, ok = computeGasUsedFromRefund(tx, big.NewInt(10010))
+	assert.False(t, ok)
+
+	_, ok = computeGasUsedFromRefund(tx, big.NewInt(-1))
+	assert.False(t, ok)
+
+	_, ok = computeGasUsedFromRefund(tx, nil)
+	assert.False(t, ok)
+
+	_, ok = computeGasUsedFromRefund(&Transaction{GasLimit: 1000}, big.NewInt(100))
+	assert.False(t, ok)
+}
+
+func TestPrepareTransactionsForDatabaseRelayedTxShouldSetTheTypeAndLinkTheInnerTxToTheRelayer(t *testing.T) {
+	t.Parallel()
+
+	innerTx := &transaction.Transaction{
+		Nonce:    5,
+		Value:    big.NewInt(1000),
+		SndAddr:  []byte("inner sender"),
+		RcvAddr:  []byte("inner receiver"),
+		GasPrice: 10,
+		GasLimit: 500,
+	}
+	innerTxBytes, _ := json.Marshal(innerTx)
+	relayedTxHash := []byte("relayedTxHash")
+	relayedTx := &transaction.Transaction{
+		SndAddr:  []byte("relayer"),
+		RcvAddr:  []byte("inner sender"),
+		GasPrice: 10,
+		GasLimit: 1000,
+		Data:     []byte(relayedTxDataPrefix + hex.EncodeToString(innerTxBytes)),
+	}
+	normalTxHash := []byte("normalTxHash")
+	normalTx := &transaction.Transaction{
+		SndAddr: []byte("sender"),
+		RcvAddr: []byte("receiver"),
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{relayedTxHash, normalTxHash},
+				Type:     block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(relayedTxHash): relayedTx,
+		string(normalTxHash):  normalTx,
+	}
+
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+	txDbProc := newTxDatabaseProcessor(
+		hasher,
+		marshalizer,
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+
+	transactions := txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)
+	require.Equal(t, 3, len(transactions))
+
+	innerTxHash, _ := core.CalculateHash(marshalizer, hasher, innerTx)
+	dbTxs := make(map[string]*Transaction)
+	for _, tx := range transactions {
+		dbTxs[tx.Hash] = tx
+	}
+
+	dbRelayedTx := dbTxs[hex.EncodeToString(relayedTxHash)]
+	require.NotNil(t, dbRelayedTx)
+	assert.Equal(t, txTypeRelayed, dbRelayedTx.TxType)
+	assert.Equal(t, txTypeNormal, dbTxs[hex.EncodeToString(normalTxHash)].TxType)
+
+	dbInnerTx := dbTxs[hex.EncodeToString(innerTxHash)]
+	require.NotNil(t, dbInnerTx)
+	assert.Equal(t, txTypeNormal, dbInnerTx.TxType)
+	assert.Equal(t, dbRelayedTx.Sender, dbInnerTx.Relayer)
+	assert.Equal(t, dbRelayedTx.Hash, dbInnerTx.RelayedTxHash)
+	assert.Equal(t, hex.EncodeToString(innerTx.SndAddr), dbInnerTx.Sender)
+	assert.Equal(t, "1000", dbInnerTx.Value)
+	assert.Equal(t, "0", dbInnerTx.Fee)
+}
+
+func TestComputeDenominatedValue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, float64(0), computeDenominatedValue(nil, defaultDenomination))
+	assert.Equal(t, 1.5, computeDenominatedValue(big.NewInt(1500), 3))
+
+	hugeValue := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(400), nil)
+	assert.Equal(t, math.MaxFloat64, computeDenominatedValue(hugeValue, defaultDenomination))
+	assert.Equal(t, -math.MaxFloat64, computeDenominatedValue(big.NewInt(0).Neg(hugeValue), defaultDenomination))
+}
+
+func prepareTxWithDataForDatabase(txDbProc *txDatabaseProcessor, txData []byte) *Transaction {
+	txHash := []byte("txHash")
+	tx := &transaction.Transaction{
+		Nonce:    3,
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("receiver"),
+		Value:    big.NewInt(10),
+		GasLimit: 100,
+		GasPrice: 10,
+		Data:     txData,
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{txHash},
+				Type:     block.TxBlock,
+			},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		string(txHash): tx,
+	}
+
+	return txDbProc.prepareTransactionsForDatabase(body, &block.Header{}, txPool, 0)[0]
+}
+
+func TestPrepareTransactionsForDatabase_TxDataOffShouldOmitTheDataField(t *testing.T) {
+	t.Parallel()
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+	txDbProc.storeTxData = false
+
+	dbTx := prepareTxWithDataForDatabase(txDbProc, []byte("private payload"))
+	assert.Equal(t, "", dbTx.Data)
+	assert.True(t, dbTx.HasData)
+	assert.Equal(t, uint64(3), dbTx.Nonce)
+	assert.Equal(t, "10", dbTx.Value)
+	assert.Equal(t, uint64(100), dbTx.GasLimit)
+
+	serializedTx, err := json.Marshal(dbTx)
+	assert.Nil(t, err)
+	document := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(serializedTx, &document))
+	_, hasDataField := document["data"]
+	assert.False(t, hasDataField)
+	assert.Equal(t, true, document["hasData"])
+}
+
+func TestPrepareTransactionsForDatabase_MaxDataBytesShouldTruncateTheDataField(t *testing.T) {
+	t.Parallel()
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+	txDbProc.maxDataBytes = 4
+
+	dbTx := prepareTxWithDataForDatabase(txDbProc, []byte("long payload"))
+	assert.Equal(t, "long", dbTx.Data)
+	assert.True(t, dbTx.HasData)
+	assert.Equal(t, uint64(3), dbTx.Nonce)
+
+	dbTx = prepareTxWithDataForDatabase(txDbProc, []byte("abc"))
+	assert.Equal(t, "abc", dbTx.Data)
+
+	dbTx = prepareTxWithDataForDatabase(txDbProc, nil)
+	assert.Equal(t, "", dbTx.Data)
+	assert.False(t, dbTx.HasData)
+}
+
+func TestAddScResultInfoInTx_TxDataOffShouldOmitTheScResultData(t *testing.T) {
+	t.Parallel()
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+	txDbProc.storeTxData = false
+
+	sender := []byte("sender")
+	tx := &Transaction{Sender: txDbProc.addressPubkeyConverter.Encode(sender)}
+	scr := &smartContractResult.SmartContractResult{
+		RcvAddr: sender,
+		Value:   big.NewInt(0),
+		Data:    []byte("@" + hex.EncodeToString([]byte("private payload"))),
+	}
+
+	tx = txDbProc.addScResultInfoInTx(scr, 0, 1, tx)
+	require.Equal(t, 1, len(tx.SmartContractResults))
+	assert.Equal(t, "", tx.SmartContractResults[0].Data)
+}
+
+func TestPrepareLogsForDatabase_MaxDataBytesShouldTruncateTheEventsData(t *testing.T) {
+	t.Parallel()
+
+	txDbProc := newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		defaultDenomination,
+	)
+	txDbProc.maxDataBytes = 4
+
+	logs := map[string]data.LogHandler{
+		"txHash": &transaction.Log{
+			Address: []byte("addr"),
+			Events:  []*transaction.Event{{Address: []byte("addr"), Data: []byte("long payload")}},
+		},
+	}
+
+	logDocuments := txDbProc.prepareLogsForDatabase(logs)
+	require.Equal(t, 1, len(logDocuments))
+	require.Equal(t, 1, len(logDocuments[0].Events))
+	assert.Equal(t, hex.EncodeToString([]byte("long payload"))[:4], logDocuments[0].Events[0].Data)
+
+	txDbProc.storeTxData = false
+	logDocuments = txDbProc.prepareLogsForDatabase(logs)
+	assert.Equal(t, "", logDocuments[0].Events[0].Data)
+}
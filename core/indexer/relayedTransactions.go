@@ -0,0 +1,89 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// relayedTxDataPrefix marks the transactions whose data field holds the hex encoded, json marshaled, inner
+//  transaction sent on behalf of its signer by the sender of the relayed transaction
+const relayedTxDataPrefix = "relayedTx@"
+
+var relayedTxMarshalizer = &marshal.JsonMarshalizer{}
+
+func getTransactionType(tx *transaction.Transaction) string {
+	if strings.HasPrefix(string(tx.Data), relayedTxDataPrefix) {
+		return txTypeRelayed
+	}
+
+	return txTypeNormal
+}
+
+// prepareRelayedInnerTransactions builds a document for the inner transaction of each relayed transaction, linked to
+//  its relayer and to the relayed transaction. The gas of the inner transactions is paid by the relayed transactions,
+//  so the inner documents do not account for any fee
+func (tdp *txDatabaseProcessor) prepareRelayedInnerTransactions(transactions map[string]*Transaction) map[string]*Transaction {
+	innerTxs := make(map[string]*Transaction)
+	for _, tx := range transactions {
+		if tx.TxType != txTypeRelayed {
+			continue
+		}
+
+		innerTx, innerTxHash, err := tdp.decodeRelayedInnerTransaction(tx.Data)
+		if err != nil {
+			log.Debug("indexer: could not decode relayed inner transaction",
+				"relayed tx hash", tx.Hash,
+				"error", err.Error())
+			continue
+		}
+
+		innerTxs[string(innerTxHash)] = &Transaction{
+			Hash:          hex.EncodeToString(innerTxHash),
+			MBHash:        tx.MBHash,
+			Nonce:         innerTx.Nonce,
+			Round:         tx.Round,
+			Value:         bigIntToString(innerTx.Value),
+			ValueNum:      computeDenominatedValue(innerTx.Value, tdp.denomination),
+			Fee:           "0",
+			Receiver:      tdp.addressPubkeyConverter.Encode(innerTx.RcvAddr),
+			Sender:        tdp.addressPubkeyConverter.Encode(innerTx.SndAddr),
+			ReceiverShard: tx.ReceiverShard,
+			SenderShard:   tx.SenderShard,
+			GasPrice:      innerTx.GasPrice,
+			GasLimit:      innerTx.GasLimit,
+			Data:          string(innerTx.Data),
+			Signature:     hex.EncodeToString(innerTx.Signature),
+			Timestamp:     tx.Timestamp,
+			Status:        tx.Status,
+			TxType:        txTypeNormal,
+			Relayer:       tx.Sender,
+			RelayedTxHash: tx.Hash,
+		}
+	}
+
+	return innerTxs
+}
+
+func (tdp *txDatabaseProcessor) decodeRelayedInnerTransaction(relayedTxData string) (*transaction.Transaction, []byte, error) {
+	innerTxBytes, err := hex.DecodeString(strings.TrimPrefix(relayedTxData, relayedTxDataPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	innerTx := &transaction.Transaction{}
+	err = relayedTxMarshalizer.Unmarshal(innerTx, innerTxBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	innerTxHash, err := core.CalculateHash(tdp.marshalizer, tdp.hasher, innerTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return innerTx, innerTxHash, nil
+}
@@ -0,0 +1,89 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// throttledWriter resends the index and bulk requests rejected by elasticsearch with a too many requests status,
+// waiting longer after each rejection so that the server can catch up. The other requests are passed directly to the
+// wrapped writer
+type throttledWriter struct {
+	databaseWriterHandler
+	maxRetries uint32
+	retryDelay time.Duration
+	sleep      func(duration time.Duration)
+}
+
+func newThrottledWriter(writer databaseWriterHandler, maxRetries uint32, retryDelay time.Duration) *throttledWriter {
+	return &throttledWriter{
+		databaseWriterHandler: writer,
+		maxRetries:            maxRetries,
+		retryDelay:            retryDelay,
+		sleep:                 time.Sleep,
+	}
+}
+
+// DoRequest will do the index request, resending it while it is throttled. The request is sent only once if its body
+// can not be rewound
+func (tw *throttledWriter) DoRequest(req *esapi.IndexRequest) error {
+	return tw.doWithThrottling(req.Index, func() error {
+		return tw.databaseWriterHandler.DoRequest(req)
+	}, func() bool {
+		return rewindBody(req.Body)
+	})
+}
+
+// DoBulkRequest will do the bulk request, resending it while it is throttled. If only some of the items were throttled,
+// the resent bulk is made only of them
+func (tw *throttledWriter) DoBulkRequest(buff *bytes.Buffer, index string) error {
+	bulk := buff
+	return tw.doWithThrottling(index, func() error {
+		err := tw.databaseWriterHandler.DoBulkRequest(bulk, index)
+
+		var throttledItemsErr *throttledBulkItemsError
+		if errors.As(err, &throttledItemsErr) {
+			bulk = throttledItemsErr.throttledItems
+		}
+
+		return err
+	}, func() bool {
+		return true
+	})
+}
+
+func (tw *throttledWriter) doWithThrottling(index string, sendRequest func() error, canResend func() bool) error {
+	retryDelay := tw.retryDelay
+	for retry := uint32(0); ; retry++ {
+		err := sendRequest()
+		if !errors.Is(err, ErrTooManyRequests) || retry >= tw.maxRetries || !canResend() {
+			return err
+		}
+
+		log.Debug("indexer: request throttled by elasticsearch, will resend",
+			"index", index,
+			"retry", retry+1,
+			"retry in", retryDelay)
+
+		tw.sleep(retryDelay)
+		retryDelay *= 2
+	}
+}
+
+func rewindBody(body io.Reader) bool {
+	if body == nil {
+		return true
+	}
+
+	seeker, ok := body.(io.Seeker)
+	if !ok {
+		return false
+	}
+
+	_, err := seeker.Seek(0, io.SeekStart)
+	return err == nil
+}
@@ -0,0 +1,36 @@
+package indexer
+
+const txSubscriberQueueSize = 1000
+
+// txSubscriber delivers the indexed transactions to a registered handler through a bounded queue, so a slow
+//  subscriber will never block the indexing path. Transactions that do not fit in the queue are dropped
+type txSubscriber struct {
+	handler func(tx *Transaction)
+	queue   chan *Transaction
+}
+
+func newTxSubscriber(handler func(tx *Transaction)) *txSubscriber {
+	ts := &txSubscriber{
+		handler: handler,
+		queue:   make(chan *Transaction, txSubscriberQueueSize),
+	}
+	go ts.processQueue()
+
+	return ts
+}
+
+func (ts *txSubscriber) processQueue() {
+	for tx := range ts.queue {
+		ts.handler(tx)
+	}
+}
+
+// notify queues the transaction for the handler and returns false if the queue is full
+func (ts *txSubscriber) notify(tx *Transaction) bool {
+	select {
+	case ts.queue <- tx:
+		return true
+	default:
+		return false
+	}
+}
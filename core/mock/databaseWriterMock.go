@@ -2,6 +2,7 @@ package mock
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 
 	"github.com/elastic/go-elasticsearch/v7/esapi"
@@ -9,8 +10,14 @@ import (
 
 // DatabaseWriterStub --
 type DatabaseWriterStub struct {
-	DoRequestCalled     func(req *esapi.IndexRequest) error
-	DoBulkRequestCalled func(buff *bytes.Buffer, index string) error
+	DoRequestCalled            func(req *esapi.IndexRequest) error
+	DoBulkRequestCalled        func(buff *bytes.Buffer, index string) error
+	DoExistsRequestCalled      func(index string, id string) (bool, error)
+	DoIndexExistsRequestCalled func(index string) (bool, error)
+	DoPingRequestCalled        func() error
+	DoScrollRequestCalled      func(index string, query []byte, handleSources func(sources []json.RawMessage) error) error
+	DoDeleteRequestCalled      func(index string, ids []string) error
+	DoGetRequestCalled         func(index string, id string) (json.RawMessage, error)
 }
 
 // DoRequest --
@@ -29,7 +36,55 @@ func (dwm *DatabaseWriterStub) DoBulkRequest(buff *bytes.Buffer, index string) e
 	return nil
 }
 
+// DoExistsRequest --
+func (dwm *DatabaseWriterStub) DoExistsRequest(index string, id string) (bool, error) {
+	if dwm.DoExistsRequestCalled != nil {
+		return dwm.DoExistsRequestCalled(index, id)
+	}
+	return false, nil
+}
+
+// DoIndexExistsRequest --
+func (dwm *DatabaseWriterStub) DoIndexExistsRequest(index string) (bool, error) {
+	if dwm.DoIndexExistsRequestCalled != nil {
+		return dwm.DoIndexExistsRequestCalled(index)
+	}
+	return true, nil
+}
+
+// DoPingRequest --
+func (dwm *DatabaseWriterStub) DoPingRequest() error {
+	if dwm.DoPingRequestCalled != nil {
+		return dwm.DoPingRequestCalled()
+	}
+	return nil
+}
+
 // CheckAndCreateIndex --
 func (dwm *DatabaseWriterStub) CheckAndCreateIndex(_ string, _ io.Reader) error {
 	return nil
 }
+
+// DoScrollRequest --
+func (dwm *DatabaseWriterStub) DoScrollRequest(index string, query []byte, handleSources func(sources []json.RawMessage) error) error {
+	if dwm.DoScrollRequestCalled != nil {
+		return dwm.DoScrollRequestCalled(index, query, handleSources)
+	}
+	return nil
+}
+
+// DoDeleteRequest --
+func (dwm *DatabaseWriterStub) DoDeleteRequest(index string, ids []string) error {
+	if dwm.DoDeleteRequestCalled != nil {
+		return dwm.DoDeleteRequestCalled(index, ids)
+	}
+	return nil
+}
+
+// DoGetRequest --
+func (dwm *DatabaseWriterStub) DoGetRequest(index string, id string) (json.RawMessage, error) {
+	if dwm.DoGetRequestCalled != nil {
+		return dwm.DoGetRequestCalled(index, id)
+	}
+	return nil, nil
+}
@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"net/http"
+)
+
+// ElasticsearchTransportStub -
+type ElasticsearchTransportStub struct {
+	PerformCalled func(req *http.Request) (*http.Response, error)
+}
+
+// Perform -
+func (ets *ElasticsearchTransportStub) Perform(req *http.Request) (*http.Response, error) {
+	if ets.PerformCalled != nil {
+		return ets.PerformCalled(req)
+	}
+
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// FeeHandlerStub -
+type FeeHandlerStub struct {
+	MaxGasLimitPerBlockCalled   func() uint64
+	ComputeGasLimitCalled       func(tx process.TransactionWithFeeHandler) uint64
+	ComputeFeeCalled            func(tx process.TransactionWithFeeHandler) *big.Int
+	CheckValidityTxValuesCalled func(tx process.TransactionWithFeeHandler) error
+	DeveloperPercentageCalled   func() float64
+	MinGasPriceCalled           func() uint64
+}
+
+// MinGasPrice -
+func (fhs *FeeHandlerStub) MinGasPrice() uint64 {
+	if fhs.MinGasPriceCalled != nil {
+		return fhs.MinGasPriceCalled()
+	}
+	return 0
+}
+
+// DeveloperPercentage -
+func (fhs *FeeHandlerStub) DeveloperPercentage() float64 {
+	if fhs.DeveloperPercentageCalled != nil {
+		return fhs.DeveloperPercentageCalled()
+	}
+	return 0
+}
+
+// MaxGasLimitPerBlock -
+func (fhs *FeeHandlerStub) MaxGasLimitPerBlock(uint32) uint64 {
+	if fhs.MaxGasLimitPerBlockCalled != nil {
+		return fhs.MaxGasLimitPerBlockCalled()
+	}
+	return 0
+}
+
+// ComputeGasLimit -
+func (fhs *FeeHandlerStub) ComputeGasLimit(tx process.TransactionWithFeeHandler) uint64 {
+	if fhs.ComputeGasLimitCalled != nil {
+		return fhs.ComputeGasLimitCalled(tx)
+	}
+	return 0
+}
+
+// ComputeFee -
+func (fhs *FeeHandlerStub) ComputeFee(tx process.TransactionWithFeeHandler) *big.Int {
+	if fhs.ComputeFeeCalled != nil {
+		return fhs.ComputeFeeCalled(tx)
+	}
+	return big.NewInt(0)
+}
+
+// CheckValidityTxValues -
+func (fhs *FeeHandlerStub) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
+	if fhs.CheckValidityTxValuesCalled != nil {
+		return fhs.CheckValidityTxValuesCalled(tx)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fhs *FeeHandlerStub) IsInterfaceNil() bool {
+	return fhs == nil
+}
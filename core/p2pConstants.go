@@ -39,3 +39,15 @@ const MetricP2PNumReceiverPeers = "erd_p2p_num_receiver_peers"
 // MetricP2PPeakNumReceiverPeers represents the peak number of connected peer sent messages to the current peer
 // (and have been received by the current peer) in the amount of time
 const MetricP2PPeakNumReceiverPeers = "erd_p2p_peak_num_receiver_peers"
+
+// MetricP2PLivePeerNumReceivedMessages represents the maximum number of received messages counted on a connected peer
+// since the start of the current interval
+const MetricP2PLivePeerNumReceivedMessages = "erd_p2p_live_peer_num_received_messages"
+
+// MetricP2PLivePeerSizeReceivedMessages represents the maximum size of received data (sum of all messages) counted on a
+// connected peer since the start of the current interval
+const MetricP2PLivePeerSizeReceivedMessages = "erd_p2p_live_peer_size_received_messages"
+
+// MetricP2PLiveNumReceiverPeers represents the number of connected peers that sent messages to the current peer since
+// the start of the current interval
+const MetricP2PLiveNumReceiverPeers = "erd_p2p_live_num_receiver_peers"
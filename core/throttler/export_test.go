@@ -0,0 +1,10 @@
+package throttler
+
+import "time"
+
+func (tbrl *TokenBucketRateLimiter) SetGetTimeHandler(handler func() time.Time) {
+	tbrl.mut.Lock()
+	tbrl.getTimeHandler = handler
+	tbrl.lastRefillTime = handler()
+	tbrl.mut.Unlock()
+}
@@ -0,0 +1,69 @@
+package throttler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// TokenBucketRateLimiter allows up to a maximum number of operations per second. Unused tokens accumulate up to the
+// burst size so that short spikes can be served as long as the average rate is respected
+type TokenBucketRateLimiter struct {
+	mut            sync.Mutex
+	ratePerSecond  float64
+	burst          float64
+	tokens         float64
+	lastRefillTime time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewTokenBucketRateLimiter creates a new token bucket rate limiter instance. The bucket starts full
+func NewTokenBucketRateLimiter(ratePerSecond uint32, burst uint32) (*TokenBucketRateLimiter, error) {
+	if ratePerSecond == 0 || burst == 0 {
+		return nil, core.ErrNotPositiveValue
+	}
+
+	tbrl := &TokenBucketRateLimiter{
+		ratePerSecond:  float64(ratePerSecond),
+		burst:          float64(burst),
+		tokens:         float64(burst),
+		getTimeHandler: time.Now,
+	}
+	tbrl.lastRefillTime = tbrl.getTimeHandler()
+
+	return tbrl, nil
+}
+
+// TryAcquire consumes one token and returns true if a token was available
+func (tbrl *TokenBucketRateLimiter) TryAcquire() bool {
+	tbrl.mut.Lock()
+	defer tbrl.mut.Unlock()
+
+	tbrl.refill()
+	if tbrl.tokens < 1 {
+		return false
+	}
+
+	tbrl.tokens--
+	return true
+}
+
+func (tbrl *TokenBucketRateLimiter) refill() {
+	now := tbrl.getTimeHandler()
+	elapsed := now.Sub(tbrl.lastRefillTime)
+	if elapsed <= 0 {
+		return
+	}
+
+	tbrl.lastRefillTime = now
+	tbrl.tokens += elapsed.Seconds() * tbrl.ratePerSecond
+	if tbrl.tokens > tbrl.burst {
+		tbrl.tokens = tbrl.burst
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tbrl *TokenBucketRateLimiter) IsInterfaceNil() bool {
+	return tbrl == nil
+}
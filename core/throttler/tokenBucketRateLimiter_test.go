@@ -0,0 +1,52 @@
+package throttler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTokenBucketRateLimiter_ZeroRateShouldError(t *testing.T) {
+	t.Parallel()
+
+	tbrl, err := throttler.NewTokenBucketRateLimiter(0, 1)
+
+	assert.True(t, check.IfNil(tbrl))
+	assert.Equal(t, core.ErrNotPositiveValue, err)
+}
+
+func TestNewTokenBucketRateLimiter_ZeroBurstShouldError(t *testing.T) {
+	t.Parallel()
+
+	tbrl, err := throttler.NewTokenBucketRateLimiter(1, 0)
+
+	assert.True(t, check.IfNil(tbrl))
+	assert.Equal(t, core.ErrNotPositiveValue, err)
+}
+
+func TestTokenBucketRateLimiter_TryAcquireShouldRespectTheBurstAndRefillRate(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tbrl, _ := throttler.NewTokenBucketRateLimiter(4, 2)
+	tbrl.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	assert.True(t, tbrl.TryAcquire())
+	assert.True(t, tbrl.TryAcquire())
+	assert.False(t, tbrl.TryAcquire())
+
+	currentTime = currentTime.Add(time.Millisecond * 250)
+	assert.True(t, tbrl.TryAcquire())
+	assert.False(t, tbrl.TryAcquire())
+
+	currentTime = currentTime.Add(time.Hour)
+	assert.True(t, tbrl.TryAcquire())
+	assert.True(t, tbrl.TryAcquire())
+	assert.False(t, tbrl.TryAcquire())
+}
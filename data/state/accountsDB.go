@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"sync"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -447,6 +448,38 @@ func (adb *AccountsDB) JournalLen() int {
 	return length
 }
 
+// GetBalancesBeforeCommit returns, for each user account changed since the last commit, its balance as it was at the
+// last commit. The accounts created since the last commit are returned with a zero balance
+func (adb *AccountsDB) GetBalancesBeforeCommit() map[string]*big.Int {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	balances := make(map[string]*big.Int)
+	for _, entry := range adb.entries {
+		switch journalEntry := entry.(type) {
+		case *journalEntryAccount:
+			userAccount, ok := journalEntry.account.(UserAccountHandler)
+			if !ok {
+				continue
+			}
+
+			address := string(userAccount.AddressBytes())
+			_, found := balances[address]
+			if !found {
+				balances[address] = userAccount.GetBalance()
+			}
+		case *journalEntryAccountCreation:
+			address := string(journalEntry.address)
+			_, found := balances[address]
+			if !found {
+				balances[address] = big.NewInt(0)
+			}
+		}
+	}
+
+	return balances
+}
+
 // Commit will persist all data inside the trie
 func (adb *AccountsDB) Commit() ([]byte, error) {
 	adb.mutOp.Lock()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -828,6 +829,45 @@ func TestAccountsDB_RevertToSnapshotShouldWork(t *testing.T) {
 	assert.Equal(t, expectedRoot, root)
 }
 
+func TestAccountsDB_GetBalancesBeforeCommitShouldReturnTheCommittedBalances(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, accFactory)
+
+	existingAddress := []byte("existing address                ")
+	acc, _ := adb.LoadAccount(existingAddress)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(100))
+	_ = adb.SaveAccount(acc)
+	_, _ = adb.Commit()
+	assert.Equal(t, 0, len(adb.GetBalancesBeforeCommit()))
+
+	acc, _ = adb.LoadAccount(existingAddress)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(50))
+	_ = adb.SaveAccount(acc)
+	acc, _ = adb.LoadAccount(existingAddress)
+	_ = acc.(state.UserAccountHandler).SubFromBalance(big.NewInt(30))
+	_ = adb.SaveAccount(acc)
+
+	newAddress := []byte("new address                     ")
+	acc, _ = adb.LoadAccount(newAddress)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+
+	balances := adb.GetBalancesBeforeCommit()
+	assert.Equal(t, 2, len(balances))
+	assert.Equal(t, big.NewInt(100), balances[string(existingAddress)])
+	assert.Equal(t, big.NewInt(0), balances[string(newAddress)])
+
+	_, _ = adb.Commit()
+	assert.Equal(t, 0, len(adb.GetBalancesBeforeCommit()))
+}
+
 func TestAccountsDB_RevertToSnapshotWithoutLastRootHashSet(t *testing.T) {
 	t.Parallel()
 
@@ -92,6 +92,7 @@ type ValidatorApiResponse struct {
 	TotalNumValidatorFailure uint32  `protobuf:"varint,11,opt,name=TotalNumValidatorFailure,proto3" json:"totalNumValidatorFailure"`
 	ShardId                  uint32  `protobuf:"varint,12,opt,name=ShardId,proto3" json:"shardId"`
 	ValidatorStatus          string  `protobuf:"bytes,13,opt,name=ValidatorStatus,proto3" json:"validatorStatus"`
+	SelectionChancePercent   float32 `protobuf:"fixed32,14,opt,name=SelectionChancePercent,proto3" json:"selectionChancePercent"`
 }
 
 func (m *ValidatorApiResponse) Reset()      { *m = ValidatorApiResponse{} }
@@ -213,6 +214,13 @@ func (m *ValidatorApiResponse) GetValidatorStatus() string {
 	return ""
 }
 
+func (m *ValidatorApiResponse) GetSelectionChancePercent() float32 {
+	if m != nil {
+		return m.SelectionChancePercent
+	}
+	return 0
+}
+
 // PeerAccountData represents the data that defines the PeerAccount
 type PeerAccountData struct {
 	BLSPublicKey               []byte        `protobuf:"bytes,1,opt,name=BLSPublicKey,proto3" json:"BLSPublicKey,omitempty"`
@@ -382,58 +390,60 @@ func init() {
 func init() { proto.RegisterFile("peerAccountData.proto", fileDescriptor_26bd0314afcce126) }
 
 var fileDescriptor_26bd0314afcce126 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
+	// 840 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x2f, 0xf9, 0xb9, 0x9d, 0xfc, 0x95, 0x69, 0x7b, 0x71, 0xaf, 0xd0, 0x38, 0x8a, 0x40,
-	0xea, 0xe6, 0x26, 0x12, 0xec, 0xf8, 0x8f, 0x4b, 0xaf, 0x64, 0x68, 0x43, 0x35, 0x29, 0x08, 0xb1,
-	0x9b, 0xd8, 0x53, 0xc7, 0xaa, 0xe3, 0x89, 0xc6, 0xe3, 0x16, 0x76, 0x3c, 0x02, 0x8f, 0x81, 0x78,
-	0x0c, 0x56, 0x5d, 0x76, 0xd9, 0x95, 0xa1, 0xee, 0x06, 0x79, 0xd5, 0x07, 0x60, 0x71, 0x95, 0x71,
-	0x9c, 0xc6, 0xb1, 0x93, 0x55, 0x3c, 0xe7, 0xfb, 0xce, 0x97, 0xef, 0xcc, 0x9c, 0x73, 0xc0, 0xc1,
-	0x8c, 0x52, 0x3e, 0x30, 0x4d, 0x16, 0x78, 0xe2, 0x5b, 0x22, 0x48, 0x6f, 0xc6, 0x99, 0x60, 0xb0,
-	0x22, 0x7f, 0x5e, 0xbf, 0xb1, 0x1d, 0x31, 0x09, 0xc6, 0x3d, 0x93, 0x4d, 0xfb, 0x36, 0xb3, 0x59,
-	0x5f, 0x86, 0xc7, 0xc1, 0xa5, 0x3c, 0xc9, 0x83, 0xfc, 0x4a, 0xb2, 0xba, 0xdf, 0x81, 0x97, 0x23,
-	0xc7, 0xf6, 0x30, 0x11, 0x14, 0x22, 0x00, 0x86, 0xc1, 0x74, 0x14, 0x98, 0x26, 0xf5, 0x7d, 0x55,
-	0xe9, 0x28, 0x47, 0x4d, 0xbc, 0x12, 0x59, 0xe0, 0x6f, 0x89, 0xe3, 0x06, 0x9c, 0xaa, 0x2f, 0x96,
-	0xf8, 0x22, 0xd2, 0xfd, 0xbf, 0x0a, 0xf6, 0x7f, 0x22, 0xae, 0x63, 0x11, 0xc1, 0xf8, 0x60, 0xe6,
-	0x60, 0xea, 0xcf, 0x98, 0xe7, 0x53, 0xd8, 0x03, 0xe0, 0x82, 0x4e, 0x67, 0x98, 0x08, 0xc7, 0xb3,
-	0xa5, 0xf0, 0x0b, 0xbd, 0x15, 0x87, 0x1a, 0x10, 0xcb, 0x28, 0x5e, 0x61, 0xc0, 0x6f, 0xc0, 0xee,
-	0x30, 0x98, 0x9e, 0x52, 0x62, 0x51, 0x9e, 0xda, 0x91, 0x7f, 0xa7, 0xef, 0xc7, 0xa1, 0xb6, 0xeb,
-	0xad, 0x61, 0x38, 0xc7, 0xce, 0x28, 0xa4, 0x86, 0xdf, 0x2b, 0x50, 0x58, 0x60, 0x38, 0xc7, 0x86,
-	0x06, 0xd8, 0x1b, 0x06, 0xd3, 0x65, 0x39, 0xa9, 0x8d, 0xb2, 0x14, 0xf9, 0x20, 0x0e, 0xb5, 0x3d,
-	0x2f, 0x0f, 0xe3, 0xa2, 0x9c, 0x75, 0xa9, 0xd4, 0x4f, 0xa5, 0x58, 0x2a, 0xb5, 0x54, 0x94, 0x03,
-	0xbb, 0xa0, 0xba, 0xb8, 0xc5, 0xaa, 0xbc, 0x45, 0x10, 0x87, 0x5a, 0x95, 0x27, 0x37, 0xb8, 0x40,
-	0xe0, 0x67, 0xa0, 0x95, 0x7c, 0x9d, 0x31, 0xcb, 0xb9, 0x74, 0x28, 0x57, 0x6b, 0x92, 0x0b, 0xe3,
-	0x50, 0x6b, 0xf1, 0x0c, 0x82, 0xd7, 0x98, 0xf0, 0x07, 0x70, 0x70, 0xc1, 0x04, 0x71, 0x73, 0xd7,
-	0xff, 0x52, 0x9a, 0x3d, 0x8c, 0x43, 0xed, 0x40, 0x14, 0x11, 0x70, 0x71, 0x5e, 0x5e, 0x30, 0xad,
-	0x7e, 0x67, 0x93, 0x60, 0x5a, 0x7f, 0x71, 0x1e, 0xfc, 0x19, 0xa8, 0x29, 0x90, 0x7b, 0x1c, 0x20,
-	0x35, 0x3f, 0x8c, 0x43, 0x4d, 0x15, 0x1b, 0x38, 0x78, 0x63, 0x76, 0xa1, 0x72, 0xea, 0xb6, 0xbe,
-	0x45, 0x39, 0x35, 0xbc, 0x31, 0x1b, 0x7e, 0x0c, 0x6a, 0xa3, 0x09, 0xe1, 0x96, 0x61, 0xa9, 0x0d,
-	0x29, 0x54, 0x8f, 0x43, 0xad, 0xe6, 0x27, 0x21, 0x9c, 0x62, 0xf0, 0x4b, 0xd0, 0x7e, 0x36, 0x25,
-	0x88, 0x08, 0x7c, 0xb5, 0xd9, 0x51, 0x8e, 0x76, 0xf4, 0xbd, 0x38, 0xd4, 0xda, 0xd7, 0x59, 0x08,
-	0xaf, 0x73, 0xbb, 0x7f, 0x57, 0x41, 0xfb, 0x3c, 0xbb, 0x1a, 0x60, 0x17, 0x34, 0xf4, 0xd3, 0xd1,
-	0x79, 0x30, 0x76, 0x1d, 0xf3, 0x7b, 0xfa, 0x9b, 0x9c, 0xbd, 0x06, 0xce, 0xc4, 0xe0, 0x47, 0xa0,
-	0x89, 0xe9, 0x0d, 0xe1, 0xd6, 0xc0, 0xb2, 0x78, 0x3a, 0x6a, 0x0d, 0x9c, 0x0d, 0x42, 0xf5, 0xb9,
-	0x06, 0x39, 0x48, 0xcf, 0xb6, 0x8d, 0x95, 0xa9, 0x4f, 0x6f, 0x99, 0x08, 0x2a, 0x47, 0xa5, 0xfe,
-	0x49, 0x3b, 0x59, 0x34, 0xbd, 0x74, 0xcb, 0xe8, 0xe5, 0xdb, 0x50, 0x2b, 0xe1, 0xc2, 0x14, 0x78,
-	0x0c, 0xde, 0xcf, 0x76, 0xd5, 0x5c, 0xa7, 0xb2, 0x4d, 0x27, 0xcf, 0x87, 0xaf, 0x32, 0x33, 0xd2,
-	0x5c, 0xce, 0x05, 0xca, 0x6c, 0xa1, 0x5a, 0xb2, 0xbe, 0x56, 0xb6, 0x0e, 0x03, 0xed, 0x81, 0x69,
-	0x06, 0xd3, 0xc0, 0x25, 0x82, 0x5a, 0x6f, 0x29, 0x4d, 0xba, 0xbe, 0xa1, 0x9f, 0xfc, 0xf5, 0x8f,
-	0x36, 0x98, 0x12, 0x31, 0xe9, 0x8f, 0x1d, 0xbb, 0x67, 0x78, 0xe2, 0xf3, 0x95, 0x1d, 0x7b, 0xe2,
-	0x72, 0xe6, 0x59, 0x43, 0x2a, 0x6e, 0x18, 0xbf, 0xea, 0x53, 0x79, 0x7a, 0x63, 0xb3, 0xbe, 0x35,
-	0xdf, 0xcc, 0xba, 0x63, 0x1b, 0x9e, 0x38, 0x26, 0xbe, 0xa0, 0x1c, 0xaf, 0xab, 0xc3, 0xaf, 0xc0,
-	0xeb, 0xf9, 0x76, 0xa5, 0x2e, 0x35, 0x05, 0xb5, 0x0c, 0x6f, 0x51, 0x84, 0xee, 0x32, 0xf3, 0xca,
-	0x4f, 0x06, 0x04, 0x6f, 0x61, 0xc0, 0x0e, 0xa8, 0x1b, 0x9e, 0x45, 0x7f, 0x35, 0xbc, 0x53, 0xc7,
-	0x17, 0x49, 0xf7, 0xe3, 0xd5, 0x10, 0x84, 0xa0, 0x2c, 0xa1, 0x79, 0xfb, 0xee, 0x60, 0xf9, 0x0d,
-	0xbf, 0x00, 0x87, 0xc7, 0xf3, 0xad, 0x6c, 0x06, 0xc2, 0xb9, 0xa6, 0xe7, 0x9c, 0xcd, 0x98, 0x4f,
-	0xf9, 0x99, 0xe3, 0xfb, 0xd4, 0x4f, 0xda, 0x13, 0x6f, 0x26, 0xc0, 0x11, 0x38, 0x94, 0x6d, 0x5e,
-	0xf8, 0xe2, 0xcd, 0x6d, 0x2f, 0xb5, 0x39, 0x0f, 0x9e, 0x81, 0x57, 0x12, 0xcc, 0xbf, 0x7d, 0x6b,
-	0x9b, 0xe2, 0x86, 0x24, 0xb8, 0x0f, 0x2a, 0x43, 0xe6, 0x99, 0x54, 0x6d, 0x77, 0x94, 0xa3, 0x32,
-	0x4e, 0x0e, 0xf3, 0x36, 0xff, 0xd1, 0x1b, 0x09, 0x72, 0x45, 0xad, 0x93, 0x19, 0x33, 0x27, 0xea,
-	0xae, 0xac, 0x35, 0x1b, 0xd4, 0xbf, 0xbe, 0x7b, 0x40, 0xa5, 0xfb, 0x07, 0x54, 0x7a, 0x7a, 0x40,
-	0xca, 0xef, 0x11, 0x52, 0xfe, 0x8c, 0x90, 0x72, 0x1b, 0x21, 0xe5, 0x2e, 0x42, 0xca, 0x7d, 0x84,
-	0x94, 0x7f, 0x23, 0xa4, 0xfc, 0x17, 0xa1, 0xd2, 0x53, 0x84, 0x94, 0x3f, 0x1e, 0x51, 0xe9, 0xee,
-	0x11, 0x95, 0xee, 0x1f, 0x51, 0xe9, 0x97, 0x8a, 0x2f, 0x88, 0xa0, 0xe3, 0xaa, 0xb4, 0xfa, 0xe9,
-	0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0xee, 0x1d, 0x40, 0xa6, 0x07, 0x00, 0x00,
+	0x14, 0x8e, 0x2f, 0x6d, 0x72, 0x7b, 0x9a, 0x9f, 0x32, 0xfd, 0xc1, 0xad, 0x90, 0x5d, 0x45, 0x20,
+	0x75, 0x73, 0x13, 0x09, 0x76, 0xfc, 0xc7, 0xa5, 0x57, 0x0a, 0xb4, 0xa1, 0x9a, 0x5c, 0x10, 0x62,
+	0x37, 0xb1, 0xcf, 0x4d, 0xac, 0x26, 0x33, 0xd1, 0x78, 0x7c, 0x2f, 0xec, 0x78, 0x04, 0x1e, 0x03,
+	0xf1, 0x18, 0xac, 0xee, 0xb2, 0xcb, 0xae, 0x0c, 0x4d, 0x37, 0xc8, 0xab, 0x3e, 0x02, 0xca, 0x38,
+	0x6e, 0xe3, 0xc4, 0xee, 0x2a, 0x9e, 0xf3, 0x7d, 0xe7, 0xcb, 0x99, 0x73, 0xe6, 0x3b, 0xb0, 0x3f,
+	0x45, 0x94, 0x1d, 0xd7, 0x15, 0x21, 0x57, 0xdf, 0x32, 0xc5, 0x5a, 0x53, 0x29, 0x94, 0x20, 0x9b,
+	0xfa, 0xe7, 0xe8, 0xc5, 0xd0, 0x57, 0xa3, 0x70, 0xd0, 0x72, 0xc5, 0xa4, 0x3d, 0x14, 0x43, 0xd1,
+	0xd6, 0xe1, 0x41, 0xf8, 0x5a, 0x9f, 0xf4, 0x41, 0x7f, 0x25, 0x59, 0xcd, 0xef, 0xe0, 0x79, 0xdf,
+	0x1f, 0x72, 0xca, 0x14, 0x12, 0x0b, 0xa0, 0x17, 0x4e, 0xfa, 0xa1, 0xeb, 0x62, 0x10, 0x98, 0xc6,
+	0xb1, 0x71, 0x52, 0xa3, 0x4b, 0x91, 0x05, 0xfe, 0x92, 0xf9, 0xe3, 0x50, 0xa2, 0xf9, 0xec, 0x01,
+	0x5f, 0x44, 0x9a, 0xd7, 0x15, 0xd8, 0xfb, 0x89, 0x8d, 0x7d, 0x8f, 0x29, 0x21, 0x3b, 0x53, 0x9f,
+	0x62, 0x30, 0x15, 0x3c, 0x40, 0xd2, 0x02, 0x78, 0x85, 0x93, 0x29, 0x65, 0xca, 0xe7, 0x43, 0x2d,
+	0xfc, 0xcc, 0xa9, 0xc7, 0x91, 0x0d, 0xea, 0x21, 0x4a, 0x97, 0x18, 0xe4, 0x1b, 0xd8, 0xe9, 0x85,
+	0x93, 0x73, 0x64, 0x1e, 0xca, 0xb4, 0x1c, 0xfd, 0x77, 0xce, 0x5e, 0x1c, 0xd9, 0x3b, 0x7c, 0x05,
+	0xa3, 0x6b, 0xec, 0x8c, 0x42, 0x5a, 0xf0, 0x7b, 0x39, 0x0a, 0x0b, 0x8c, 0xae, 0xb1, 0x49, 0x17,
+	0x76, 0x7b, 0xe1, 0xe4, 0xe1, 0x3a, 0x69, 0x19, 0x1b, 0x5a, 0xe4, 0x83, 0x38, 0xb2, 0x77, 0xf9,
+	0x3a, 0x4c, 0xf3, 0x72, 0x56, 0xa5, 0xd2, 0x7a, 0x36, 0xf3, 0xa5, 0xd2, 0x92, 0xf2, 0x72, 0x48,
+	0x13, 0xca, 0x8b, 0x2e, 0x96, 0x75, 0x17, 0x21, 0x8e, 0xec, 0xb2, 0x4c, 0x3a, 0xb8, 0x40, 0xc8,
+	0x67, 0x50, 0x4f, 0xbe, 0x2e, 0x84, 0xe7, 0xbf, 0xf6, 0x51, 0x9a, 0x15, 0xcd, 0x25, 0x71, 0x64,
+	0xd7, 0x65, 0x06, 0xa1, 0x2b, 0x4c, 0xf2, 0x03, 0xec, 0xbf, 0x12, 0x8a, 0x8d, 0xd7, 0xda, 0xff,
+	0x5c, 0x17, 0x7b, 0x18, 0x47, 0xf6, 0xbe, 0xca, 0x23, 0xd0, 0xfc, 0xbc, 0x75, 0xc1, 0xf4, 0xf6,
+	0x5b, 0x45, 0x82, 0xe9, 0xfd, 0xf3, 0xf3, 0xc8, 0xcf, 0x60, 0xa6, 0xc0, 0xda, 0x70, 0x40, 0x6b,
+	0x7e, 0x18, 0x47, 0xb6, 0xa9, 0x0a, 0x38, 0xb4, 0x30, 0x3b, 0x57, 0x39, 0xad, 0x76, 0xfb, 0x09,
+	0xe5, 0xb4, 0xe0, 0xc2, 0x6c, 0xf2, 0x31, 0x54, 0xfa, 0x23, 0x26, 0xbd, 0xae, 0x67, 0x56, 0xb5,
+	0xd0, 0x76, 0x1c, 0xd9, 0x95, 0x20, 0x09, 0xd1, 0x14, 0x23, 0x5f, 0x42, 0xe3, 0xb1, 0x28, 0xc5,
+	0x54, 0x18, 0x98, 0xb5, 0x63, 0xe3, 0x64, 0xcb, 0xd9, 0x8d, 0x23, 0xbb, 0xf1, 0x26, 0x0b, 0xd1,
+	0x55, 0x2e, 0xa1, 0x70, 0xd0, 0xc7, 0x31, 0xba, 0xca, 0x17, 0xfc, 0x74, 0xc4, 0xb8, 0x8b, 0x97,
+	0x28, 0x5d, 0xe4, 0xca, 0xac, 0xeb, 0xf9, 0x1f, 0xc5, 0x91, 0x7d, 0x10, 0xe4, 0x32, 0x68, 0x41,
+	0x66, 0xf3, 0xef, 0x32, 0x34, 0x2e, 0xb3, 0xeb, 0x86, 0x34, 0xa1, 0xea, 0x9c, 0xf7, 0x2f, 0xc3,
+	0xc1, 0xd8, 0x77, 0xbf, 0xc7, 0xdf, 0xb4, 0x9f, 0xab, 0x34, 0x13, 0x23, 0x1f, 0x41, 0x8d, 0xe2,
+	0x5b, 0x26, 0xbd, 0x8e, 0xe7, 0xc9, 0xd4, 0xbe, 0x55, 0x9a, 0x0d, 0x12, 0xf3, 0xb1, 0x2f, 0xda,
+	0x9c, 0x8f, 0xad, 0xe8, 0xc2, 0xde, 0xea, 0x7c, 0xe6, 0x2b, 0x4a, 0xdb, 0x6f, 0xfb, 0x93, 0x46,
+	0xb2, 0xbc, 0x5a, 0xe9, 0xe6, 0x72, 0x36, 0xde, 0x45, 0x76, 0x89, 0xe6, 0xa6, 0x90, 0x53, 0x78,
+	0x3f, 0xfb, 0x52, 0x99, 0x4a, 0xbc, 0x57, 0xa8, 0xb3, 0xce, 0x27, 0x07, 0x19, 0xdf, 0xd5, 0x1e,
+	0xbc, 0x66, 0x65, 0x36, 0x5b, 0x45, 0x63, 0x4b, 0x11, 0x22, 0xa0, 0xd1, 0x71, 0xdd, 0x70, 0x12,
+	0x8e, 0x99, 0x42, 0xef, 0x25, 0x62, 0xe2, 0xa4, 0xaa, 0x73, 0xf6, 0xd7, 0x3f, 0x76, 0x67, 0xc2,
+	0xd4, 0xa8, 0x3d, 0xf0, 0x87, 0xad, 0x2e, 0x57, 0x9f, 0x2f, 0xed, 0xed, 0xb3, 0xb1, 0x14, 0xdc,
+	0xeb, 0xa1, 0x7a, 0x2b, 0xe4, 0x55, 0x1b, 0xf5, 0xe9, 0xc5, 0x50, 0xb4, 0xbd, 0xf9, 0xb6, 0x77,
+	0xfc, 0x61, 0x97, 0xab, 0x53, 0x16, 0x28, 0x94, 0x74, 0x55, 0x9d, 0x7c, 0x05, 0x47, 0xf3, 0x8d,
+	0xad, 0xa7, 0x89, 0x5e, 0x97, 0x2f, 0x2e, 0xe1, 0x8c, 0x85, 0x7b, 0x15, 0x24, 0xa6, 0xa3, 0x4f,
+	0x30, 0xc8, 0x31, 0x6c, 0x77, 0xb9, 0x87, 0xbf, 0x76, 0xf9, 0xb9, 0x1f, 0xa8, 0xc4, 0x51, 0x74,
+	0x39, 0x44, 0x08, 0x6c, 0x68, 0x68, 0x6e, 0x89, 0x2d, 0xaa, 0xbf, 0xc9, 0x17, 0x70, 0x78, 0x3a,
+	0xdf, 0xf4, 0x6e, 0xa8, 0xfc, 0x37, 0x78, 0x29, 0xc5, 0x54, 0x04, 0x28, 0x2f, 0xfc, 0x20, 0xc0,
+	0x20, 0x79, 0xf2, 0xb4, 0x98, 0x40, 0xfa, 0x70, 0xa8, 0xad, 0x93, 0x3b, 0xf1, 0xda, 0x53, 0x93,
+	0x2a, 0xce, 0x23, 0x17, 0x70, 0xa0, 0xc1, 0xf5, 0xd9, 0xd7, 0x9f, 0x52, 0x2c, 0x48, 0x22, 0x7b,
+	0xb0, 0xd9, 0x13, 0xdc, 0x45, 0xb3, 0x71, 0x6c, 0x9c, 0x6c, 0xd0, 0xe4, 0x30, 0x7f, 0xe6, 0x3f,
+	0xf2, 0xbe, 0x62, 0x57, 0xe8, 0x9d, 0x4d, 0x85, 0x3b, 0x32, 0x77, 0xf4, 0x5d, 0xb3, 0x41, 0xe7,
+	0xeb, 0xeb, 0x5b, 0xab, 0x74, 0x73, 0x6b, 0x95, 0xee, 0x6f, 0x2d, 0xe3, 0xf7, 0x99, 0x65, 0xfc,
+	0x39, 0xb3, 0x8c, 0x77, 0x33, 0xcb, 0xb8, 0x9e, 0x59, 0xc6, 0xcd, 0xcc, 0x32, 0xfe, 0x9d, 0x59,
+	0xc6, 0x7f, 0x33, 0xab, 0x74, 0x3f, 0xb3, 0x8c, 0x3f, 0xee, 0xac, 0xd2, 0xf5, 0x9d, 0x55, 0xba,
+	0xb9, 0xb3, 0x4a, 0xbf, 0x6c, 0x06, 0x8a, 0x29, 0x1c, 0x94, 0x75, 0xa9, 0x9f, 0xfe, 0x3f, 0x00,
+	0x7b, 0x3c, 0x8d, 0x31, 0xfa, 0x07, 0x00, 0x00,
 }
 
 func (this *SignRate) Equal(that interface{}) bool {
@@ -521,6 +531,9 @@ func (this *ValidatorApiResponse) Equal(that interface{}) bool {
 	if this.ValidatorStatus != that1.ValidatorStatus {
 		return false
 	}
+	if this.SelectionChancePercent != that1.SelectionChancePercent {
+		return false
+	}
 	return true
 }
 func (this *PeerAccountData) Equal(that interface{}) bool {
@@ -610,7 +623,7 @@ func (this *ValidatorApiResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&state.ValidatorApiResponse{")
 	s = append(s, "TempRating: "+fmt.Sprintf("%#v", this.TempRating)+",\n")
 	s = append(s, "NumLeaderSuccess: "+fmt.Sprintf("%#v", this.NumLeaderSuccess)+",\n")
@@ -625,6 +638,7 @@ func (this *ValidatorApiResponse) GoString() string {
 	s = append(s, "TotalNumValidatorFailure: "+fmt.Sprintf("%#v", this.TotalNumValidatorFailure)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "ValidatorStatus: "+fmt.Sprintf("%#v", this.ValidatorStatus)+",\n")
+	s = append(s, "SelectionChancePercent: "+fmt.Sprintf("%#v", this.SelectionChancePercent)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -714,6 +728,12 @@ func (m *ValidatorApiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelectionChancePercent != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SelectionChancePercent))))
+		i--
+		dAtA[i] = 0x75
+	}
 	if len(m.ValidatorStatus) > 0 {
 		i -= len(m.ValidatorStatus)
 		copy(dAtA[i:], m.ValidatorStatus)
@@ -996,6 +1016,9 @@ func (m *ValidatorApiResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPeerAccountData(uint64(l))
 	}
+	if m.SelectionChancePercent != 0 {
+		n += 5
+	}
 	return n
 }
 
@@ -1092,6 +1115,7 @@ func (this *ValidatorApiResponse) String() string {
 		`TotalNumValidatorFailure:` + fmt.Sprintf("%v", this.TotalNumValidatorFailure) + `,`,
 		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
 		`ValidatorStatus:` + fmt.Sprintf("%v", this.ValidatorStatus) + `,`,
+		`SelectionChancePercent:` + fmt.Sprintf("%v", this.SelectionChancePercent) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1485,6 +1509,17 @@ func (m *ValidatorApiResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionChancePercent", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SelectionChancePercent = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPeerAccountData(dAtA[iNdEx:])
@@ -0,0 +1,7 @@
+package state
+
+// ValidatorApiResponseWithKey holds the API response of a validator along with its encoded public key
+type ValidatorApiResponseWithKey struct {
+	PublicKey string `json:"publicKey"`
+	ValidatorApiResponse
+}
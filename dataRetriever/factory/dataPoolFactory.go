@@ -9,8 +9,10 @@ import (
 	txPoolFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/txpool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -22,6 +24,8 @@ type ArgsDataPool struct {
 	Config           *config.Config
 	EconomicsData    *economics.EconomicsData
 	ShardCoordinator sharding.Coordinator
+	Marshalizer      marshal.Marshalizer
+	SnapshotStorer   storage.Storer
 }
 
 // NewDataPoolFromConfig will return a new instance of a PoolsHolder
@@ -36,6 +40,9 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		MinGasPrice:    args.EconomicsData.MinGasPrice(),
 		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
 		SelfShardID:    args.ShardCoordinator.SelfId(),
+		SnapshotConfig: mainConfig.TxPoolSnapshotConfig,
+		SnapshotStorer: args.SnapshotStorer,
+		Marshalizer:    args.Marshalizer,
 	})
 	if err != nil {
 		log.Error("error creating txpool")
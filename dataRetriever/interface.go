@@ -38,6 +38,8 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case TxPoolSnapshotUnit:
+		return "TxPoolSnapshotUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -72,6 +74,8 @@ const (
 	StatusMetricsUnit UnitType = 10
 	// TxLogsUnit is the status metrics storage unit identifier
 	TxLogsUnit UnitType = 11
+	// TxPoolSnapshotUnit is the transactions pool snapshot storage unit identifier
+	TxPoolSnapshotUnit UnitType = 12
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
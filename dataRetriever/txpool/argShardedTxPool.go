@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
@@ -14,6 +18,9 @@ type ArgShardedTxPool struct {
 	MinGasPrice    uint64
 	NumberOfShards uint32
 	SelfShardID    uint32
+	SnapshotConfig config.TxPoolSnapshotConfig
+	SnapshotStorer storage.Storer      `json:"-"`
+	Marshalizer    marshal.Marshalizer `json:"-"`
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -41,10 +48,26 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
+	if args.isSnapshotEnabled() {
+		if check.IfNil(args.Marshalizer) {
+			return fmt.Errorf("%w for the pool snapshot", dataRetriever.ErrNilMarshalizer)
+		}
+		if args.SnapshotConfig.IntervalInSeconds == 0 {
+			return fmt.Errorf("%w: SnapshotConfig.IntervalInSeconds is not valid", dataRetriever.ErrInvalidValue)
+		}
+		if args.SnapshotConfig.MaxNumTxs == 0 {
+			return fmt.Errorf("%w: SnapshotConfig.MaxNumTxs is not valid", dataRetriever.ErrInvalidValue)
+		}
+	}
 
 	return nil
 }
 
+// isSnapshotEnabled returns true if the pool contents should be periodically saved in the snapshot storer
+func (args *ArgShardedTxPool) isSnapshotEnabled() bool {
+	return args.SnapshotConfig.Enabled && !check.IfNil(args.SnapshotStorer)
+}
+
 // String returns a readable representation of the object
 func (args *ArgShardedTxPool) String() string {
 	bytes, _ := json.Marshal(args)
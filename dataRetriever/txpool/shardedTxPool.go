@@ -27,6 +27,7 @@ type shardedTxPool struct {
 	configPrototypeDestinationMe txcache.ConfigDestinationMe
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
+	snapshotter                  *txPoolSnapshotter
 }
 
 type txPoolShard struct {
@@ -75,6 +76,17 @@ func NewShardedTxPool(args ArgShardedTxPool) (dataRetriever.ShardedDataCacherNot
 		selfShardID:                  args.SelfShardID,
 	}
 
+	if args.isSnapshotEnabled() {
+		snapshotter := newTxPoolSnapshotter(args)
+		err = snapshotter.loadSnapshot(shardedTxPoolObject)
+		if err != nil {
+			log.Warn("NewShardedTxPool: could not load the pool snapshot", "err", err)
+		}
+
+		snapshotter.startSnapshotting(shardedTxPoolObject)
+		shardedTxPoolObject.snapshotter = snapshotter
+	}
+
 	return shardedTxPoolObject, nil
 }
 
@@ -310,6 +322,28 @@ func (txPool *shardedTxPool) RegisterHandler(handler func(key []byte, value inte
 	txPool.mutexAddCallbacks.Unlock()
 }
 
+// ForEachTransaction calls the provided function for each transaction in pool, with the same arguments a registered
+// handler receives, so that a component registered after the pool was filled, as it happens with a loaded snapshot,
+// can also track the transactions already in pool
+func (txPool *shardedTxPool) ForEachTransaction(function func(key []byte, value interface{})) {
+	if function == nil {
+		return
+	}
+
+	txPool.mutexBackingMap.RLock()
+	shards := make([]*txPoolShard, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		shards = append(shards, shard)
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range shards {
+		shard.Cache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+			function(txHash, tx)
+		})
+	}
+}
+
 // GetCounts returns the total number of transactions in the pool
 func (txPool *shardedTxPool) GetCounts() counting.Counts {
 	txPool.mutexBackingMap.RLock()
@@ -325,6 +359,15 @@ func (txPool *shardedTxPool) GetCounts() counting.Counts {
 	return counts
 }
 
+// Close stops the pool snapshotting, if enabled, after saving a last snapshot
+func (txPool *shardedTxPool) Close() error {
+	if txPool.snapshotter != nil {
+		txPool.snapshotter.close()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/require"
 )
 
@@ -304,6 +305,23 @@ func Test_RegisterHandler(t *testing.T) {
 	require.Equal(t, 1, len(pool.onAddCallbacks))
 }
 
+func Test_ForEachTransaction(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "1")
+	pool.AddData([]byte("hash-y"), createTx("bob", 15), 0, "3")
+
+	visited := make(map[string]uint32)
+	pool.ForEachTransaction(func(key []byte, value interface{}) {
+		tx := value.(*txcache.WrappedTransaction)
+		visited[string(key)] = tx.SenderShardID
+	})
+	require.Equal(t, map[string]uint32{"hash-x": 1, "hash-y": 3}, visited)
+
+	pool.ForEachTransaction(nil)
+}
+
 func Test_GetCounts(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
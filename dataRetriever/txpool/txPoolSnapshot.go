@@ -0,0 +1,183 @@
+package txpool
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var txPoolSnapshotKey = []byte("txPoolSnapshot")
+
+// txPoolSnapshot holds the pool contents saved in the snapshot storer
+type txPoolSnapshot struct {
+	Timestamp int64
+	Txs       []*snapshotTx
+}
+
+type snapshotTx struct {
+	TxHash          []byte
+	TxBytes         []byte
+	SenderShardID   uint32
+	ReceiverShardID uint32
+}
+
+// txPoolSnapshotter periodically saves the pool contents so that they can be reloaded after a restart
+type txPoolSnapshotter struct {
+	storer         storage.Storer
+	marshalizer    marshal.Marshalizer
+	interval       time.Duration
+	maxAge         time.Duration
+	maxNumTxs      int
+	getTimeHandler func() time.Time
+	cancelFunc     func()
+	chDone         chan struct{}
+}
+
+func newTxPoolSnapshotter(args ArgShardedTxPool) *txPoolSnapshotter {
+	return &txPoolSnapshotter{
+		storer:         args.SnapshotStorer,
+		marshalizer:    args.Marshalizer,
+		interval:       time.Duration(args.SnapshotConfig.IntervalInSeconds) * time.Second,
+		maxAge:         time.Duration(args.SnapshotConfig.MaxAgeInSeconds) * time.Second,
+		maxNumTxs:      int(args.SnapshotConfig.MaxNumTxs),
+		getTimeHandler: time.Now,
+		chDone:         make(chan struct{}),
+	}
+}
+
+// startSnapshotting starts the go routine which saves the pool contents at each interval
+func (tps *txPoolSnapshotter) startSnapshotting(txPool *shardedTxPool) {
+	var ctx context.Context
+	ctx, tps.cancelFunc = context.WithCancel(context.Background())
+	go tps.snapshotPeriodically(ctx, txPool)
+}
+
+func (tps *txPoolSnapshotter) snapshotPeriodically(ctx context.Context, txPool *shardedTxPool) {
+	ticker := time.NewTicker(tps.interval)
+	defer func() {
+		ticker.Stop()
+		close(tps.chDone)
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			tps.saveSnapshotLoggingErrors(txPool)
+		case <-ctx.Done():
+			// a last snapshot is saved so that a restarted node reloads the most recent pool contents
+			tps.saveSnapshotLoggingErrors(txPool)
+			return
+		}
+	}
+}
+
+func (tps *txPoolSnapshotter) saveSnapshotLoggingErrors(txPool *shardedTxPool) {
+	err := tps.saveSnapshot(txPool)
+	if err != nil {
+		log.Debug("txPoolSnapshotter.saveSnapshot()", "err", err)
+	}
+}
+
+// close stops the snapshotting go routine and waits until the last snapshot is saved
+func (tps *txPoolSnapshotter) close() {
+	if tps.cancelFunc == nil {
+		return
+	}
+
+	tps.cancelFunc()
+	<-tps.chDone
+}
+
+// saveSnapshot writes at most maxNumTxs transactions from the pool in the snapshot storer
+func (tps *txPoolSnapshotter) saveSnapshot(txPool *shardedTxPool) error {
+	snapshot := &txPoolSnapshot{
+		Timestamp: tps.getTimeHandler().Unix(),
+		Txs:       make([]*snapshotTx, 0),
+	}
+
+	txPool.mutexBackingMap.RLock()
+	for _, shard := range txPool.backingMap {
+		shard.Cache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+			if len(snapshot.Txs) >= tps.maxNumTxs {
+				return
+			}
+
+			txBytes, err := tps.marshalizer.Marshal(tx.Tx)
+			if err != nil {
+				log.Trace("txPoolSnapshotter.saveSnapshot()", "tx hash", txHash, "err", err)
+				return
+			}
+
+			snapshot.Txs = append(snapshot.Txs, &snapshotTx{
+				TxHash:          txHash,
+				TxBytes:         txBytes,
+				SenderShardID:   tx.SenderShardID,
+				ReceiverShardID: tx.ReceiverShardID,
+			})
+		})
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	buff, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("txPoolSnapshotter.saveSnapshot()", "num txs", len(snapshot.Txs), "size", len(buff))
+
+	return tps.storer.Put(txPoolSnapshotKey, buff)
+}
+
+// loadSnapshot adds in the pool the transactions found in the snapshot storer, if the snapshot is not too old
+func (tps *txPoolSnapshotter) loadSnapshot(txPool *shardedTxPool) error {
+	buff, err := tps.storer.Get(txPoolSnapshotKey)
+	if err != nil {
+		log.Debug("txPoolSnapshotter.loadSnapshot(): no snapshot found", "err", err)
+		return nil
+	}
+
+	snapshot := &txPoolSnapshot{}
+	err = json.Unmarshal(buff, snapshot)
+	if err != nil {
+		return err
+	}
+
+	age := tps.getTimeHandler().Sub(time.Unix(snapshot.Timestamp, 0))
+	if age > tps.maxAge {
+		log.Debug("txPoolSnapshotter.loadSnapshot(): snapshot too old", "age", age, "max age", tps.maxAge)
+		return nil
+	}
+
+	numLoaded := 0
+	for _, stx := range snapshot.Txs {
+		if numLoaded >= tps.maxNumTxs {
+			break
+		}
+
+		tx := &transaction.Transaction{}
+		err = tps.marshalizer.Unmarshal(tx, stx.TxBytes)
+		if err != nil {
+			log.Trace("txPoolSnapshotter.loadSnapshot()", "tx hash", stx.TxHash, "err", err)
+			continue
+		}
+
+		cacheID := process.ShardCacherIdentifier(stx.SenderShardID, stx.ReceiverShardID)
+		txPool.addTx(&txcache.WrappedTransaction{
+			Tx:              tx,
+			TxHash:          stx.TxHash,
+			SenderShardID:   stx.SenderShardID,
+			ReceiverShardID: stx.ReceiverShardID,
+		}, cacheID)
+		numLoaded++
+	}
+
+	log.Debug("txPoolSnapshotter.loadSnapshot()", "num txs", numLoaded, "age", age)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/require"
+)
+
+func createSnapshotStorer(t *testing.T) storage.Storer {
+	cache, _ := lrucache.NewCache(10)
+	storer, err := storageUnit.NewStorageUnit(cache, memorydb.New())
+	require.Nil(t, err)
+
+	return storer
+}
+
+func createArgsWithSnapshot(storer storage.Storer) ArgShardedTxPool {
+	return ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		MinGasPrice:    200000000000,
+		NumberOfShards: 4,
+		SelfShardID:    0,
+		SnapshotConfig: config.TxPoolSnapshotConfig{
+			Enabled:           true,
+			IntervalInSeconds: 3600,
+			MaxAgeInSeconds:   600,
+			MaxNumTxs:         100,
+		},
+		SnapshotStorer: storer,
+		Marshalizer:    &mock.MarshalizerMock{},
+	}
+}
+
+func Test_NewShardedTxPool_WhenBadSnapshotConfig(t *testing.T) {
+	args := createArgsWithSnapshot(createSnapshotStorer(t))
+	args.Marshalizer = nil
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.NotNil(t, err)
+
+	args = createArgsWithSnapshot(createSnapshotStorer(t))
+	args.SnapshotConfig.IntervalInSeconds = 0
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.NotNil(t, err)
+
+	args = createArgsWithSnapshot(createSnapshotStorer(t))
+	args.SnapshotConfig.MaxNumTxs = 0
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.NotNil(t, err)
+
+	args = createArgsWithSnapshot(nil)
+	args.Marshalizer = nil
+	pool, err = NewShardedTxPool(args)
+	require.NotNil(t, pool)
+	require.Nil(t, err)
+}
+
+func Test_SaveSnapshot_RecreatedPoolShouldContainTheTransactions(t *testing.T) {
+	args := createArgsWithSnapshot(createSnapshotStorer(t))
+	poolAsInterface, _ := NewShardedTxPool(args)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("bob", 7), 0, "1_0")
+
+	err := newTxPoolSnapshotter(args).saveSnapshot(pool)
+	require.Nil(t, err)
+
+	recreated, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	tx, ok := recreated.SearchFirstData([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, createTx("alice", 42), tx)
+	_, ok = recreated.SearchFirstData([]byte("hash-y"))
+	require.True(t, ok)
+	_, ok = recreated.ShardDataStore("1_0").Get([]byte("hash-z"))
+	require.True(t, ok)
+}
+
+func Test_LoadSnapshot_TooOldShouldNotLoad(t *testing.T) {
+	args := createArgsWithSnapshot(createSnapshotStorer(t))
+	poolAsInterface, _ := NewShardedTxPool(args)
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+
+	snapshotter := newTxPoolSnapshotter(args)
+	snapshotter.getTimeHandler = func() time.Time {
+		return time.Now().Add(-time.Hour)
+	}
+	err := snapshotter.saveSnapshot(pool)
+	require.Nil(t, err)
+
+	recreated, _ := NewShardedTxPool(args)
+	_, ok := recreated.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+}
+
+func Test_SaveSnapshot_ShouldNotExceedMaxNumTxs(t *testing.T) {
+	args := createArgsWithSnapshot(createSnapshotStorer(t))
+	args.SnapshotConfig.MaxNumTxs = 2
+	poolAsInterface, _ := NewShardedTxPool(args)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("alice", 44), 0, "0")
+
+	err := newTxPoolSnapshotter(args).saveSnapshot(pool)
+	require.Nil(t, err)
+
+	recreated, _ := NewShardedTxPool(args)
+	require.Equal(t, int64(2), recreated.(*shardedTxPool).GetCounts().GetTotal())
+}
+
+func Test_Close_ShouldSaveALastSnapshot(t *testing.T) {
+	args := createArgsWithSnapshot(createSnapshotStorer(t))
+	poolAsInterface, _ := NewShardedTxPool(args)
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+
+	err := pool.Close()
+	require.Nil(t, err)
+	err = pool.Close()
+	require.Nil(t, err)
+
+	recreated, _ := NewShardedTxPool(args)
+	_, ok := recreated.SearchFirstData([]byte("hash-x"))
+	require.True(t, ok)
+}
@@ -0,0 +1,24 @@
+package disabled
+
+type syncStateHandler struct {
+}
+
+// NewSyncStateHandler returns a new instance of syncStateHandler that always reports the node as syncing, as it is
+// the case while bootstrapping from the network
+func NewSyncStateHandler() *syncStateHandler {
+	return &syncStateHandler{}
+}
+
+// ReceivedSyncState -
+func (s *syncStateHandler) ReceivedSyncState(_ bool) {
+}
+
+// IsSyncing -
+func (s *syncStateHandler) IsSyncing() bool {
+	return true
+}
+
+// IsInterfaceNil -
+func (s *syncStateHandler) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -107,6 +107,8 @@ func NewEpochStartMetaSyncer(args ArgsNewEpochStartMetaSyncer) (*epochStartMetaS
 		ValidityAttester:        disabled.NewValidityAttester(),
 		EpochStartTrigger:       disabled.NewEpochStartTrigger(),
 		NonceConverter:          args.NonceConverter,
+		// the epoch of the node is not known yet, as it is given by the epoch start metablock synced here
+		SkipHeaderEpochCheck: true,
 	}
 
 	interceptedMetaHdrDataFactory, err := interceptorsFactory.NewInterceptedMetaHeaderDataFactory(&argsInterceptedDataFactory)
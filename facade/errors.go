@@ -16,3 +16,6 @@ var ErrInvalidValue = errors.New("invalid value")
 
 // ErrNoApiRoutesConfig signals that no configuration was found for API routes
 var ErrNoApiRoutesConfig = errors.New("no configuration found for API routes")
+
+// ErrEpochInfoNotAvailable signals that the epoch metrics were not yet set
+var ErrEpochInfoNotAvailable = errors.New("epoch info not available")
@@ -46,11 +46,16 @@ type NodeHandler interface {
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
+	// GetPeerInfo returns the p2p identity of the node and the number of its connected peers
+	GetPeerInfo() *external.PeerInfo
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 
 	// ValidatorStatisticsApi return the statistics for all the validators
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	// ValidatorsPageApi returns a sorted page of validators along with the total number of validators
+	ValidatorsPageApi(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int)
 	DirectTrigger(epoch uint32) error
 	IsSelfTrigger() bool
 
@@ -58,13 +63,29 @@ type NodeHandler interface {
 	DecodeAddressPubkey(pk string) ([]byte, error)
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
+
+	ForceCleanTxsPools() (int, error)
+
+	// GetBootstrapStatus returns the sync state of the node
+	GetBootstrapStatus() (*external.BootstrapStatus, error)
+
+	// CheckIndexerHealth returns an error if the indexer can not write on the elasticsearch server
+	CheckIndexerHealth() error
+
+	// ReindexBlock indexes again the block with the provided hex encoded header hash
+	ReindexBlock(hash string) error
+
+	// GetIndexerGaps returns the nonces from the provided range for which no block of the shard was indexed
+	GetIndexerGaps(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
+	GetAccountsBulk(addresses [][]byte) ([]*external.AccountResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -10,8 +10,10 @@ import (
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryAsyncHandler        func(query *process.SCQuery) <-chan process.SCQueryResult
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
+	GetAccountsBulkHandler            func(addresses [][]byte) ([]*external.AccountResponse, error)
 }
 
 // ExecuteSCQuery -
@@ -19,6 +21,11 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return ars.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueryAsync -
+func (ars *ApiResolverStub) ExecuteSCQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	return ars.ExecuteSCQueryAsyncHandler(query)
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return ars.StatusMetricsHandler()
@@ -29,6 +36,15 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return ars.ComputeTransactionGasLimitHandler(tx)
 }
 
+// GetAccountsBulk -
+func (ars *ApiResolverStub) GetAccountsBulk(addresses [][]byte) ([]*external.AccountResponse, error) {
+	if ars.GetAccountsBulkHandler != nil {
+		return ars.GetAccountsBulkHandler(addresses)
+	}
+
+	return make([]*external.AccountResponse, 0), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 )
 
 // NodeStub -
@@ -27,12 +28,64 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
+	GetPeerInfoCalled                              func() *external.PeerInfo
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
+	ValidatorsPageApiCalled                        func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int)
 	DirectTriggerCalled                            func(epoch uint32) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetTransactionStatusCalled                     func(hash string) (string, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	ForceCleanTxsPoolsCalled                       func() (int, error)
+	GetBootstrapStatusCalled                       func() (*external.BootstrapStatus, error)
+	CheckIndexerHealthCalled                       func() error
+	ReindexBlockCalled                             func(hash string) error
+	GetIndexerGapsCalled                           func(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
+}
+
+// GetIndexerGaps -
+func (ns *NodeStub) GetIndexerGaps(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	if ns.GetIndexerGapsCalled != nil {
+		return ns.GetIndexerGapsCalled(fromNonce, toNonce, shardID)
+	}
+
+	return nil, nil
+}
+
+// ReindexBlock -
+func (ns *NodeStub) ReindexBlock(hash string) error {
+	if ns.ReindexBlockCalled != nil {
+		return ns.ReindexBlockCalled(hash)
+	}
+
+	return nil
+}
+
+// CheckIndexerHealth -
+func (ns *NodeStub) CheckIndexerHealth() error {
+	if ns.CheckIndexerHealthCalled != nil {
+		return ns.CheckIndexerHealthCalled()
+	}
+
+	return nil
+}
+
+// GetBootstrapStatus -
+func (ns *NodeStub) GetBootstrapStatus() (*external.BootstrapStatus, error) {
+	if ns.GetBootstrapStatusCalled != nil {
+		return ns.GetBootstrapStatusCalled()
+	}
+
+	return &external.BootstrapStatus{}, nil
+}
+
+// ForceCleanTxsPools -
+func (ns *NodeStub) ForceCleanTxsPools() (int, error) {
+	if ns.ForceCleanTxsPoolsCalled != nil {
+		return ns.ForceCleanTxsPoolsCalled()
+	}
+
+	return 0, nil
 }
 
 // GetValueForKey -
@@ -105,11 +158,25 @@ func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return ns.GetHeartbeatsHandler()
 }
 
+// GetPeerInfo -
+func (ns *NodeStub) GetPeerInfo() *external.PeerInfo {
+	return ns.GetPeerInfoCalled()
+}
+
 // ValidatorStatisticsApi -
 func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return ns.ValidatorStatisticsApiCalled()
 }
 
+// ValidatorsPageApi -
+func (ns *NodeStub) ValidatorsPageApi(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+	if ns.ValidatorsPageApiCalled != nil {
+		return ns.ValidatorsPageApiCalled(offset, limit, sortBy)
+	}
+
+	return make([]state.ValidatorApiResponseWithKey, 0), 0
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32) error {
 	return ns.DirectTriggerCalled(epoch)
@@ -3,6 +3,7 @@ package facade
 import (
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -15,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -233,6 +235,11 @@ func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRe
 	return nf.node.ValidatorStatisticsApi()
 }
 
+// ValidatorsPageApi will return a sorted page of validators along with the total number of validators
+func (nf *nodeFacade) ValidatorsPageApi(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+	return nf.node.ValidatorsPageApi(offset, limit, sortBy)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -259,6 +266,21 @@ func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, erro
 	return nf.node.GetAccount(address)
 }
 
+// GetAccountsBulk returns the accounts of the provided addresses, all read from the last committed state
+func (nf *nodeFacade) GetAccountsBulk(addresses []string) ([]*external.AccountResponse, error) {
+	decodedAddresses := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		decodedAddress, err := nf.node.DecodeAddressPubkey(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, address)
+		}
+
+		decodedAddresses = append(decodedAddresses, decodedAddress)
+	}
+
+	return nf.apiResolver.GetAccountsBulk(decodedAddresses)
+}
+
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
 func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	hbStatus := nf.node.GetHeartbeats()
@@ -269,6 +291,62 @@ func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return hbStatus, nil
 }
 
+// GetPeerInfo returns the p2p identity of the node together with the number of its connected peers
+func (nf *nodeFacade) GetPeerInfo() *external.PeerInfo {
+	return nf.node.GetPeerInfo()
+}
+
+// GetHeartbeatsSummary returns the number of active and inactive peers, aggregated on each shard
+func (nf *nodeFacade) GetHeartbeatsSummary() (*data.HeartbeatSummary, error) {
+	hbStatus, err := nf.GetHeartbeats()
+	if err != nil {
+		return nil, err
+	}
+
+	return computeHeartbeatSummary(hbStatus), nil
+}
+
+func computeHeartbeatSummary(hbStatus []data.PubKeyHeartbeat) *data.HeartbeatSummary {
+	shardsSummaries := make(map[uint32]*data.ShardHeartbeatSummary)
+	summary := &data.HeartbeatSummary{
+		Total: len(hbStatus),
+	}
+	for _, hb := range hbStatus {
+		shardSummary, ok := shardsSummaries[hb.ComputedShardID]
+		if !ok {
+			shardSummary = &data.ShardHeartbeatSummary{ShardID: hb.ComputedShardID}
+			shardsSummaries[hb.ComputedShardID] = shardSummary
+		}
+
+		if !hb.IsActive {
+			shardSummary.Inactive++
+			summary.Inactive++
+			continue
+		}
+
+		shardSummary.Active++
+		summary.Active++
+		if isValidatorPeerType(hb.PeerType) {
+			shardSummary.ActiveValidators++
+			summary.ActiveValidators++
+		}
+	}
+
+	summary.Shards = make([]data.ShardHeartbeatSummary, 0, len(shardsSummaries))
+	for _, shardSummary := range shardsSummaries {
+		summary.Shards = append(summary.Shards, *shardSummary)
+	}
+	sort.Slice(summary.Shards, func(i, j int) bool {
+		return summary.Shards[i].ShardID < summary.Shards[j].ShardID
+	})
+
+	return summary
+}
+
+func isValidatorPeerType(peerType string) bool {
+	return peerType == string(core.EligibleList) || peerType == string(core.WaitingList)
+}
+
 // StatusMetrics will return the node's status metrics
 func (nf *nodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return nf.apiResolver.StatusMetrics()
@@ -279,6 +357,12 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput
 	return nf.apiResolver.ExecuteSCQuery(query)
 }
 
+// ExecuteSCQueryAsync retrieves data from existing SC trie, delivering the result on the returned channel so that the
+// caller can stop waiting for it
+func (nf *nodeFacade) ExecuteSCQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	return nf.apiResolver.ExecuteSCQueryAsync(query)
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -309,6 +393,59 @@ func (nf *nodeFacade) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return nf.node.GetQueryHandler(name)
 }
 
+// ForceCleanTxsPools removes right away the stale transactions from the pools and returns their number
+func (nf *nodeFacade) ForceCleanTxsPools() (int, error) {
+	return nf.node.ForceCleanTxsPools()
+}
+
+// GetEpochInfo returns the current epoch and round, the first round of the epoch and the number of rounds left until
+// the next epoch, as computed from the network status metrics
+func (nf *nodeFacade) GetEpochInfo() (*external.EpochInfo, error) {
+	networkMetrics := nf.StatusMetrics().NetworkMetrics()
+	roundsPerEpoch, _ := networkMetrics[core.MetricRoundsPerEpoch].(uint64)
+	if roundsPerEpoch == 0 {
+		return nil, ErrEpochInfoNotAvailable
+	}
+
+	epoch, _ := networkMetrics[core.MetricEpochNumber].(uint64)
+	currentRound, _ := networkMetrics[core.MetricCurrentRound].(uint64)
+	epochStartRound, _ := networkMetrics[core.MetricRoundAtEpochStart].(uint64)
+
+	epochInfo := &external.EpochInfo{
+		Epoch:           uint32(epoch),
+		CurrentRound:    currentRound,
+		EpochStartRound: epochStartRound,
+		RoundsPerEpoch:  roundsPerEpoch,
+	}
+	nextEpochStartRound := epochStartRound + roundsPerEpoch
+	if currentRound < nextEpochStartRound {
+		epochInfo.RoundsRemaining = nextEpochStartRound - currentRound
+	}
+
+	return epochInfo, nil
+}
+
+// GetBootstrapStatus returns the probable highest nonce, the current nonce and whether the node is synced
+func (nf *nodeFacade) GetBootstrapStatus() (*external.BootstrapStatus, error) {
+	return nf.node.GetBootstrapStatus()
+}
+
+// CheckIndexerHealth returns an error if the indexer is not enabled or can not reach the elasticsearch server
+func (nf *nodeFacade) CheckIndexerHealth() error {
+	return nf.node.CheckIndexerHealth()
+}
+
+// ReindexBlock indexes again the block with the provided hex encoded header hash, loading it from the node storage
+func (nf *nodeFacade) ReindexBlock(hash string) error {
+	return nf.node.ReindexBlock(hash)
+}
+
+// GetIndexerGaps returns the nonces from the inclusive [fromNonce, toNonce] range for which no block of the provided
+// shard was indexed
+func (nf *nodeFacade) GetIndexerGaps(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	return nf.node.GetIndexerGaps(fromNonce, toNonce, shardID)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
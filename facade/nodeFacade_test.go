@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -18,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 )
@@ -286,6 +289,46 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	assert.Equal(t, called, 1)
 }
 
+func TestNodeFacade_GetAccountsBulkShouldDecodeTheAddresses(t *testing.T) {
+	t.Parallel()
+
+	expectedResponses := []*external.AccountResponse{
+		{Address: []byte("address1"), Balance: big.NewInt(1)},
+		{Address: []byte("address2"), Balance: big.NewInt(2)},
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetAccountsBulkHandler: func(addresses [][]byte) ([]*external.AccountResponse, error) {
+			assert.Equal(t, [][]byte{[]byte("address1"), []byte("address2")}, addresses)
+			return expectedResponses, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	responses, err := nf.GetAccountsBulk([]string{hex.EncodeToString([]byte("address1")), hex.EncodeToString([]byte("address2"))})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponses, responses)
+}
+
+func TestNodeFacade_GetAccountsBulkInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetAccountsBulkHandler: func(addresses [][]byte) ([]*external.AccountResponse, error) {
+			assert.Fail(t, "should have not read the accounts")
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	responses, err := nf.GetAccountsBulk([]string{"invalid address"})
+
+	assert.Nil(t, responses)
+	assert.NotNil(t, err)
+}
+
 func TestNodeFacade_GetHeartbeatsReturnsNilShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -337,6 +380,59 @@ func TestNodeFacade_GetHeartbeats(t *testing.T) {
 	fmt.Println(result)
 }
 
+func TestNodeFacade_GetHeartbeatsSummaryReturnsNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node := &mock.NodeStub{
+		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
+			return nil
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	summary, err := nf.GetHeartbeatsSummary()
+
+	assert.Nil(t, summary)
+	assert.Equal(t, ErrHeartbeatsNotActive, err)
+}
+
+func TestNodeFacade_GetHeartbeatsSummaryShouldAggregateOnShards(t *testing.T) {
+	t.Parallel()
+
+	node := &mock.NodeStub{
+		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
+			return []data.PubKeyHeartbeat{
+				{PublicKey: "pk1", IsActive: true, ComputedShardID: 1, PeerType: string(core.EligibleList)},
+				{PublicKey: "pk2", IsActive: true, ComputedShardID: 0, PeerType: string(core.WaitingList)},
+				{PublicKey: "pk3", IsActive: true, ComputedShardID: 0, PeerType: string(core.ObserverList)},
+				{PublicKey: "pk4", IsActive: false, ComputedShardID: 0, PeerType: string(core.EligibleList)},
+				{PublicKey: "pk5", IsActive: false, ComputedShardID: 1, PeerType: string(core.ObserverList)},
+				{PublicKey: "pk6", IsActive: false, ComputedShardID: 1, PeerType: string(core.EligibleList)},
+			}
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	summary, err := nf.GetHeartbeatsSummary()
+
+	expectedSummary := &data.HeartbeatSummary{
+		Shards: []data.ShardHeartbeatSummary{
+			{ShardID: 0, Active: 2, Inactive: 1, ActiveValidators: 1},
+			{ShardID: 1, Active: 1, Inactive: 2, ActiveValidators: 1},
+		},
+		Active:           3,
+		Inactive:         3,
+		ActiveValidators: 2,
+		Total:            6,
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSummary, summary)
+}
+
 func TestNodeFacade_GetDataValue(t *testing.T) {
 	t.Parallel()
 
@@ -354,6 +450,24 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeFacade_ExecuteSCQueryAsyncShouldDeliverTheApiResolverResult(t *testing.T) {
+	t.Parallel()
+
+	expectedResult := process.SCQueryResult{VMOutput: &vmcommon.VMOutput{}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryAsyncHandler: func(query *process.SCQuery) <-chan process.SCQueryResult {
+			chResult := make(chan process.SCQueryResult, 1)
+			chResult <- expectedResult
+			return chResult
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	result := <-nf.ExecuteSCQueryAsync(nil)
+	assert.Equal(t, expectedResult, result)
+}
+
 func TestNodeFacade_EmptyRestInterface(t *testing.T) {
 	t.Parallel()
 
@@ -394,6 +508,28 @@ func TestNodeFacade_ValidatorStatisticsApi(t *testing.T) {
 	assert.Equal(t, mapToRet, res)
 }
 
+func TestNodeFacade_ValidatorsPageApi(t *testing.T) {
+	t.Parallel()
+
+	pageToRet := []state.ValidatorApiResponseWithKey{{PublicKey: "test"}}
+	node := &mock.NodeStub{
+		ValidatorsPageApiCalled: func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+			assert.Equal(t, 10, offset)
+			assert.Equal(t, 5, limit)
+			assert.Equal(t, "rating", sortBy)
+
+			return pageToRet, 11
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	res, total := nf.ValidatorsPageApi(10, 5, "rating")
+	assert.Equal(t, pageToRet, res)
+	assert.Equal(t, 11, total)
+}
+
 func TestNodeFacade_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -439,6 +575,50 @@ func TestNodeFacade_StatusMetrics(t *testing.T) {
 	assert.True(t, apiResolverMetricsRequested)
 }
 
+func TestNodeFacade_GetEpochInfoShouldComputeTheRemainingRounds(t *testing.T) {
+	t.Parallel()
+
+	statusMetrics := statusHandler.NewStatusMetrics()
+	statusMetrics.SetUInt64Value(core.MetricEpochNumber, 3)
+	statusMetrics.SetUInt64Value(core.MetricCurrentRound, 350)
+	statusMetrics.SetUInt64Value(core.MetricRoundAtEpochStart, 300)
+	statusMetrics.SetUInt64Value(core.MetricRoundsPerEpoch, 100)
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return statusMetrics
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	epochInfo, err := nf.GetEpochInfo()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), epochInfo.Epoch)
+	assert.Equal(t, uint64(350), epochInfo.CurrentRound)
+	assert.Equal(t, uint64(300), epochInfo.EpochStartRound)
+	assert.Equal(t, uint64(100), epochInfo.RoundsPerEpoch)
+	assert.Equal(t, uint64(50), epochInfo.RoundsRemaining)
+}
+
+func TestNodeFacade_GetEpochInfoWithoutRoundsPerEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return statusHandler.NewStatusMetrics()
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	epochInfo, err := nf.GetEpochInfo()
+
+	assert.Nil(t, epochInfo)
+	assert.Equal(t, ErrEpochInfoNotAvailable, err)
+}
+
 func TestNodeFacade_PprofEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/close"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -14,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
 )
 
 // DataComponentsFactoryArgs holds the arguments needed for creating a data components factory
@@ -23,6 +25,7 @@ type DataComponentsFactoryArgs struct {
 	ShardCoordinator   sharding.Coordinator
 	Core               *CoreComponents
 	PathManager        storage.PathManagerHandler
+	ArchivePathManager storage.PathManagerHandler
 	EpochStartNotifier EpochStartNotifier
 	CurrentEpoch       uint32
 }
@@ -55,19 +58,44 @@ func NewDataComponentsFactory(args DataComponentsFactoryArgs) (*dataComponentsFa
 		return nil, ErrNilEpochStartNotifier
 	}
 
+	var pathManager storage.PathManagerHandler = args.PathManager
+	if !check.IfNil(args.ArchivePathManager) {
+		var err error
+		pathManager, err = pathmanager.NewEpochAwarePathManager(args.PathManager, args.ArchivePathManager, args.CurrentEpoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &dataComponentsFactory{
 		config:             args.Config,
 		economicsData:      args.EconomicsData,
 		shardCoordinator:   args.ShardCoordinator,
 		core:               args.Core,
-		pathManager:        args.PathManager,
+		pathManager:        pathManager,
 		epochStartNotifier: args.EpochStartNotifier,
 		currentEpoch:       args.CurrentEpoch,
 	}, nil
 }
 
+// Validate checks the storage configs without opening any persister, so a bad config can be detected before
+// the data components are created
+func (dcf *dataComponentsFactory) Validate() error {
+	storageServiceFactory, err := dcf.createStorageServiceFactory()
+	if err != nil {
+		return err
+	}
+
+	return storageServiceFactory.Validate()
+}
+
 // Create will create and return the data components
 func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
+	err := dcf.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStorageConfig, err.Error())
+	}
+
 	var datapool dataRetriever.PoolsHolder
 	blkc, err := dcf.createBlockChainFromConfig()
 	if err != nil {
@@ -83,6 +111,8 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
 		ShardCoordinator: dcf.shardCoordinator,
+		Marshalizer:      dcf.core.InternalMarshalizer,
+		SnapshotStorer:   store.GetStorer(dataRetriever.TxPoolSnapshotUnit),
 	}
 	datapool, err = dataRetrieverFactory.NewDataPoolFromConfig(dataPoolArgs)
 	if err != nil {
@@ -96,6 +126,64 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 	}, nil
 }
 
+// CreateForAllShards will create and return the data components of every shard and of the metachain, all of them
+// sharing the same config. The result is indexed by shard ID
+func (dcf *dataComponentsFactory) CreateForAllShards() (map[uint32]*DataComponents, error) {
+	numShards := dcf.shardCoordinator.NumberOfShards()
+	shardIDs := make([]uint32, 0, numShards+1)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	allComponents := make(map[uint32]*DataComponents, len(shardIDs))
+	for _, shardID := range shardIDs {
+		components, err := dcf.createForShard(numShards, shardID)
+		if err != nil {
+			closeDataComponents(allComponents)
+			return nil, fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		allComponents[shardID] = components
+	}
+
+	return allComponents, nil
+}
+
+func (dcf *dataComponentsFactory) createForShard(numShards uint32, shardID uint32) (*DataComponents, error) {
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(numShards, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	shardFactory := *dcf
+	shardFactory.shardCoordinator = shardCoordinator
+
+	return shardFactory.Create()
+}
+
+func closeDataComponents(allComponents map[uint32]*DataComponents) {
+	for _, components := range allComponents {
+		_ = components.Close()
+	}
+}
+
+// Close stops the data pools go routines, which might still write in the storers, and then closes all the storage units
+func (dc *DataComponents) Close() error {
+	var errTxPool error
+	txPool, ok := dc.Datapool.Transactions().(close.Closer)
+	if ok {
+		errTxPool = txPool.Close()
+	}
+
+	errStore := dc.Store.CloseAll()
+	if errTxPool != nil {
+		return errTxPool
+	}
+
+	return errStore
+}
+
 func (dcf *dataComponentsFactory) createBlockChainFromConfig() (data.ChainHandler, error) {
 	if dcf.shardCoordinator.SelfId() < dcf.shardCoordinator.NumberOfShards() {
 		blockChain := blockchain.NewBlockChain()
@@ -120,14 +208,18 @@ func (dcf *dataComponentsFactory) createBlockChainFromConfig() (data.ChainHandle
 	return nil, ErrBlockchainCreation
 }
 
-func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.StorageService, error) {
-	storageServiceFactory, err := factory.NewStorageServiceFactory(
+func (dcf *dataComponentsFactory) createStorageServiceFactory() (*factory.StorageServiceFactory, error) {
+	return factory.NewStorageServiceFactory(
 		&dcf.config,
 		dcf.shardCoordinator,
 		dcf.pathManager,
 		dcf.epochStartNotifier,
 		dcf.currentEpoch,
 	)
+}
+
+func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.StorageService, error) {
+	storageServiceFactory, err := dcf.createStorageServiceFactory()
 	if err != nil {
 		return nil, err
 	}
@@ -1,13 +1,20 @@
 package factory_test
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/require"
 )
 
@@ -85,10 +92,45 @@ func TestDataComponentsFactory_CreateShouldErrDueBadConfig(t *testing.T) {
 	require.NoError(t, err)
 
 	dc, err := dcf.Create()
-	require.Error(t, err)
+	require.True(t, errors.Is(err, factory.ErrInvalidStorageConfig))
 	require.Nil(t, dc)
 }
 
+func TestDataComponentsFactory_ValidateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getDataArgs()
+	dcf, _ := factory.NewDataComponentsFactory(args)
+
+	err := dcf.Validate()
+	require.NoError(t, err)
+}
+
+func TestDataComponentsFactory_ValidateShouldErrDueBadConfig(t *testing.T) {
+	t.Parallel()
+
+	args := getDataArgs()
+	args.Config.ShardHdrNonceHashStorage = config.StorageConfig{}
+	dcf, _ := factory.NewDataComponentsFactory(args)
+
+	err := dcf.Validate()
+	require.True(t, errors.Is(err, storage.ErrNotSupportedCacheType))
+	require.True(t, strings.Contains(err.Error(), "ShardHdrNonceHashStorage"))
+}
+
+func TestDataComponentsFactory_ValidateShouldErrDueEmptyPersistentDBPath(t *testing.T) {
+	t.Parallel()
+
+	args := getDataArgs()
+	args.Config.TxStorage.DB.Type = string(storageUnit.LvlDBSerial)
+	args.Config.TxStorage.DB.FilePath = ""
+	dcf, _ := factory.NewDataComponentsFactory(args)
+
+	err := dcf.Validate()
+	require.True(t, errors.Is(err, storage.ErrEmptyDBFilePath))
+	require.True(t, strings.Contains(err.Error(), "TxStorage"))
+}
+
 func TestDataComponentsFactory_CreateForShardShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -115,6 +157,77 @@ func TestDataComponentsFactory_CreateForMetaShouldWork(t *testing.T) {
 	require.NotNil(t, dc)
 }
 
+func TestDataComponentsFactory_CreateWithArchivePathManagerShouldOpenOldEpochsFromArchive(t *testing.T) {
+	t.Parallel()
+
+	mutPaths := sync.Mutex{}
+	mainEpochs := make(map[uint32]struct{})
+	archiveEpochs := make(map[uint32]struct{})
+	args := getDataArgs()
+	args.Config.StoragePruning.Enabled = true
+	args.CurrentEpoch = 2
+	args.PathManager = &mock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			mutPaths.Lock()
+			mainEpochs[epoch] = struct{}{}
+			mutPaths.Unlock()
+
+			return fmt.Sprintf("main/Epoch_%d/Shard_%s/%s", epoch, shardId, identifier)
+		},
+	}
+	args.ArchivePathManager = &mock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			mutPaths.Lock()
+			archiveEpochs[epoch] = struct{}{}
+			mutPaths.Unlock()
+
+			return fmt.Sprintf("archive/Epoch_%d/Shard_%s/%s", epoch, shardId, identifier)
+		},
+	}
+	dcf, err := factory.NewDataComponentsFactory(args)
+	require.NoError(t, err)
+
+	dc, err := dcf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, dc)
+
+	mutPaths.Lock()
+	defer mutPaths.Unlock()
+	require.Equal(t, map[uint32]struct{}{2: {}}, mainEpochs)
+	require.Equal(t, map[uint32]struct{}{0: {}, 1: {}}, archiveEpochs)
+}
+
+func TestDataComponentsFactory_CreateForAllShardsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getDataArgs()
+	args.ShardCoordinator = mock.NewMultiShardsCoordinatorMock(3)
+	dcf, err := factory.NewDataComponentsFactory(args)
+	require.NoError(t, err)
+
+	allComponents, err := dcf.CreateForAllShards()
+	require.NoError(t, err)
+	require.Equal(t, 4, len(allComponents))
+	for _, shardID := range []uint32{0, 1, 2, core.MetachainShardId} {
+		require.NotNil(t, allComponents[shardID])
+	}
+	require.NotNil(t, allComponents[core.MetachainShardId].Store.GetStorer(dataRetriever.MetaHdrNonceHashDataUnit))
+	require.NotNil(t, allComponents[2].Store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit+2))
+}
+
+func TestDataComponentsFactory_CreateForAllShardsShouldErrDueBadConfig(t *testing.T) {
+	t.Parallel()
+
+	args := getDataArgs()
+	args.ShardCoordinator = mock.NewMultiShardsCoordinatorMock(3)
+	args.Config.ShardHdrNonceHashStorage = config.StorageConfig{}
+	dcf, _ := factory.NewDataComponentsFactory(args)
+
+	allComponents, err := dcf.CreateForAllShards()
+	require.True(t, errors.Is(err, factory.ErrInvalidStorageConfig))
+	require.Nil(t, allComponents)
+}
+
 func getDataArgs() factory.DataComponentsFactoryArgs {
 	return factory.DataComponentsFactoryArgs{
 		Config:             getGeneralConfig(),
@@ -71,6 +71,9 @@ var ErrDataStoreCreation = errors.New("can not create data store")
 // ErrDataPoolCreation signals that the data pool cannot be created
 var ErrDataPoolCreation = errors.New("can not create data pool")
 
+// ErrInvalidStorageConfig signals that an invalid storage config has been provided
+var ErrInvalidStorageConfig = errors.New("invalid storage config")
+
 // ErrMissingConsensusConfig signals that consensus type isn't specified in the configuration file
 var ErrMissingConsensusConfig = errors.New("no consensus type provided in config file")
 
@@ -10,6 +10,7 @@ import (
 type QueryServiceStub struct {
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryAsyncCalled     func(query *process.SCQuery) <-chan process.SCQueryResult
 }
 
 // ComputeScCallGasLimit -
@@ -30,6 +31,19 @@ func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMO
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueryAsync -
+func (qss *QueryServiceStub) ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	if qss.ExecuteQueryAsyncCalled != nil {
+		return qss.ExecuteQueryAsyncCalled(query)
+	}
+
+	chResult := make(chan process.SCQueryResult, 1)
+	vmOutput, err := qss.ExecuteQuery(query)
+	chResult <- process.SCQueryResult{VMOutput: vmOutput, Err: err}
+
+	return chResult
+}
+
 // IsInterfaceNil -
 func (qss *QueryServiceStub) IsInterfaceNil() bool {
 	return qss == nil
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	peerProcess "github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
+	disabledAntiflood "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -50,6 +51,7 @@ type ArgHeartbeat struct {
 	PeerShardMapper          heartbeat.NetworkShardingCollector
 	SizeCheckDelta           uint32
 	ValidatorsProvider       peerProcess.ValidatorsProvider
+	HeartbeatInterceptor     heartbeat.HeartbeatInterceptor
 }
 
 // HeartbeatHandler is the struct used to manage heartbeat subsystem consisting of a heartbeat sender and monitor
@@ -91,7 +93,8 @@ func (hbh *HeartbeatHandler) create() error {
 		return heartbeat.ErrNilMessenger
 	}
 
-	if arg.Messenger.HasTopicValidator(core.HeartbeatTopic) {
+	isInterceptorProvided := !check.IfNil(arg.HeartbeatInterceptor)
+	if !isInterceptorProvided && arg.Messenger.HasTopicValidator(core.HeartbeatTopic) {
 		return heartbeat.ErrValidatorAlreadySet
 	}
 
@@ -162,6 +165,12 @@ func (hbh *HeartbeatHandler) create() error {
 		}
 	}
 
+	var antifloodHandler heartbeat.P2PAntifloodHandler = arg.AntifloodHandler
+	if isInterceptorProvided {
+		// the antiflood checks were already done by the heartbeat interceptor
+		antifloodHandler = &disabledAntiflood.AntiFlood{}
+	}
+
 	argMonitor := process.ArgHeartbeatMonitor{
 		Marshalizer:                        netInputMarshalizer,
 		MaxDurationPeerUnresponsive:        time.Second * time.Duration(arg.HeartbeatConfig.DurationToConsiderUnresponsiveInSec),
@@ -171,12 +180,13 @@ func (hbh *HeartbeatHandler) create() error {
 		Storer:                             heartbeatStorer,
 		PeerTypeProvider:                   peerTypeProvider,
 		Timer:                              timer,
-		AntifloodHandler:                   arg.AntifloodHandler,
+		AntifloodHandler:                   antifloodHandler,
 		HardforkTrigger:                    arg.HardforkTrigger,
 		PeerBlackListHandler:               arg.PeerBlackListHandler,
 		ValidatorPubkeyConverter:           arg.ValidatorPubkeyConverter,
 		HeartbeatRefreshIntervalInSec:      arg.HeartbeatConfig.HeartbeatRefreshIntervalInSec,
 		HideInactiveValidatorIntervalInSec: arg.HeartbeatConfig.HideInactiveValidatorIntervalInSec,
+		PruneInactivePeerIntervalInSec:     arg.HeartbeatConfig.PruneInactivePeerIntervalInSec,
 	}
 	hbh.monitor, err = process.NewMonitor(argMonitor)
 	if err != nil {
@@ -190,7 +200,11 @@ func (hbh *HeartbeatHandler) create() error {
 		return err
 	}
 
-	err = arg.Messenger.RegisterMessageProcessor(core.HeartbeatTopic, hbh.monitor)
+	if isInterceptorProvided {
+		err = arg.HeartbeatInterceptor.SetHeartbeatProcessor(hbh.monitor)
+	} else {
+		err = arg.Messenger.RegisterMessageProcessor(core.HeartbeatTopic, hbh.monitor)
+	}
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -139,4 +141,34 @@ func TestNewHeartbeatHandler_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNewHeartbeatHandler_WithHeartbeatInterceptorShouldNotRegisterOnTopic(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Messenger = &mock.MessengerStub{
+		HasTopicValidatorCalled: func(name string) bool {
+			return true
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			assert.Fail(t, "should have not registered the monitor on the heartbeat topic")
+			return nil
+		},
+	}
+	var heartbeatProcessor process.HeartbeatProcessor
+	arg.HeartbeatInterceptor = &mock.HeartbeatInterceptorStub{
+		SetHeartbeatProcessorCalled: func(processor process.HeartbeatProcessor) error {
+			heartbeatProcessor = processor
+			return nil
+		},
+	}
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.Nil(t, err)
+	require.False(t, check.IfNil(hbh))
+	assert.Equal(t, hbh.Monitor(), heartbeatProcessor)
+
+	err = hbh.Close()
+	assert.Nil(t, err)
+}
+
 //TODO(next PR) add more tests
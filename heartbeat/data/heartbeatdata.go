@@ -23,6 +23,23 @@ type PubKeyHeartbeat struct {
 	PeerType        string    `json:"peerType"`
 }
 
+// ShardHeartbeatSummary holds the aggregated heartbeat status of the peers from a shard
+type ShardHeartbeatSummary struct {
+	ShardID          uint32 `json:"shardID"`
+	Active           int    `json:"active"`
+	Inactive         int    `json:"inactive"`
+	ActiveValidators int    `json:"activeValidators"`
+}
+
+// HeartbeatSummary holds the aggregated heartbeat status of all known peers
+type HeartbeatSummary struct {
+	Shards           []ShardHeartbeatSummary `json:"shards"`
+	Active           int                     `json:"active"`
+	Inactive         int                     `json:"inactive"`
+	ActiveValidators int                     `json:"activeValidators"`
+	Total            int                     `json:"total"`
+}
+
 // Duration is a wrapper of the original Duration struct
 // that has JSON marshal and unmarshal capabilities
 // golang issue: https://github.com/golang/go/issues/10275
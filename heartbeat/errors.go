@@ -104,3 +104,18 @@ var ErrWrongValues = errors.New("wrong values for heartbeat parameters")
 
 // ErrValidatorAlreadySet signals that a topic validator has already been set
 var ErrValidatorAlreadySet = errors.New("topic validator has already been set")
+
+// ErrMissingHeartbeatField signals that a required field of the heartbeat message is missing
+var ErrMissingHeartbeatField = errors.New("missing heartbeat field")
+
+// ErrInvalidHeartbeatShard signals that the heartbeat message was sent from an invalid shard
+var ErrInvalidHeartbeatShard = errors.New("invalid heartbeat shard")
+
+// ErrStaleHeartbeat signals that the heartbeat message is too old
+var ErrStaleHeartbeat = errors.New("stale heartbeat")
+
+// ErrFutureHeartbeat signals that the heartbeat message is dated in the future
+var ErrFutureHeartbeat = errors.New("future dated heartbeat")
+
+// ErrInvalidHeartbeatAge signals that an invalid heartbeat age has been provided
+var ErrInvalidHeartbeatAge = errors.New("invalid heartbeat age")
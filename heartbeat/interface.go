@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // P2PMessenger defines a subset of the p2p.Messenger interface
@@ -30,6 +31,7 @@ type P2PMessenger interface {
 // MessageHandler defines what a message processor for heartbeat should do
 type MessageHandler interface {
 	CreateHeartbeatFromP2PMessage(message p2p.MessageP2P) (*data.Heartbeat, error)
+	VerifyHeartbeat(hbRecv *data.Heartbeat, message p2p.MessageP2P) error
 	IsInterfaceNil() bool
 }
 
@@ -103,3 +105,10 @@ type ValidatorStatisticsProcessor interface {
 	GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
 	IsInterfaceNil() bool
 }
+
+// HeartbeatInterceptor defines the interceptor that validates the heartbeat messages before forwarding them
+// to the heartbeat processor
+type HeartbeatInterceptor interface {
+	SetHeartbeatProcessor(heartbeatProcessor process.HeartbeatProcessor) error
+	IsInterfaceNil() bool
+}
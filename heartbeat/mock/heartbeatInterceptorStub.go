@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// HeartbeatInterceptorStub -
+type HeartbeatInterceptorStub struct {
+	SetHeartbeatProcessorCalled func(heartbeatProcessor process.HeartbeatProcessor) error
+}
+
+// SetHeartbeatProcessor -
+func (his *HeartbeatInterceptorStub) SetHeartbeatProcessor(heartbeatProcessor process.HeartbeatProcessor) error {
+	if his.SetHeartbeatProcessorCalled != nil {
+		return his.SetHeartbeatProcessorCalled(heartbeatProcessor)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (his *HeartbeatInterceptorStub) IsInterfaceNil() bool {
+	return his == nil
+}
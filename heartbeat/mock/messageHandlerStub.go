@@ -8,6 +8,7 @@ import (
 // MessageHandlerStub -
 type MessageHandlerStub struct {
 	CreateHeartbeatFromP2PMessageCalled func(message p2p.MessageP2P) (*data.Heartbeat, error)
+	VerifyHeartbeatCalled               func(hbRecv *data.Heartbeat, message p2p.MessageP2P) error
 }
 
 // IsInterfaceNil -
@@ -19,3 +20,12 @@ func (mhs *MessageHandlerStub) IsInterfaceNil() bool {
 func (mhs *MessageHandlerStub) CreateHeartbeatFromP2PMessage(message p2p.MessageP2P) (*data.Heartbeat, error) {
 	return mhs.CreateHeartbeatFromP2PMessageCalled(message)
 }
+
+// VerifyHeartbeat -
+func (mhs *MessageHandlerStub) VerifyHeartbeat(hbRecv *data.Heartbeat, message p2p.MessageP2P) error {
+	if mhs.VerifyHeartbeatCalled != nil {
+		return mhs.VerifyHeartbeatCalled(hbRecv, message)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// payloadTimeLayout is the layout used by the sender when writing the current time in the heartbeat payload
+const payloadTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+const monotonicClockMarker = " m="
+
+// ArgHeartbeatValidator represents the arguments for the heartbeat validator
+type ArgHeartbeatValidator struct {
+	ShardCoordinator sharding.Coordinator
+	MaxAge           time.Duration
+	MaxFutureDrift   time.Duration
+}
+
+// HeartbeatValidator is able to validate the heartbeat messages independently of the component receiving them
+type HeartbeatValidator struct {
+	shardCoordinator sharding.Coordinator
+	maxAge           time.Duration
+	maxFutureDrift   time.Duration
+}
+
+// NewHeartbeatValidator creates a new heartbeat validator
+func NewHeartbeatValidator(arg ArgHeartbeatValidator) (*HeartbeatValidator, error) {
+	if check.IfNil(arg.ShardCoordinator) {
+		return nil, heartbeat.ErrNilShardCoordinator
+	}
+	if arg.MaxAge <= 0 {
+		return nil, fmt.Errorf("%w for MaxAge", heartbeat.ErrInvalidHeartbeatAge)
+	}
+	if arg.MaxFutureDrift < 0 {
+		return nil, fmt.Errorf("%w for MaxFutureDrift", heartbeat.ErrInvalidHeartbeatAge)
+	}
+
+	return &HeartbeatValidator{
+		shardCoordinator: arg.ShardCoordinator,
+		maxAge:           arg.MaxAge,
+		maxFutureDrift:   arg.MaxFutureDrift,
+	}, nil
+}
+
+// ValidateHeartbeat checks that the required fields of the heartbeat message are present, that the shard is valid and
+// that the message is not too old or dated in the future, relative to the provided time. The age is checked only if
+// the payload holds the sending time, as the payload might also carry the hardfork trigger data
+func (hv *HeartbeatValidator) ValidateHeartbeat(hb *data.Heartbeat, now time.Time) error {
+	if hb == nil {
+		return heartbeat.ErrNilMessage
+	}
+
+	err := checkRequiredFields(hb)
+	if err != nil {
+		return err
+	}
+
+	err = verifyLengths(hb)
+	if err != nil {
+		return err
+	}
+
+	isValidShard := hb.ShardID < hv.shardCoordinator.NumberOfShards() || hb.ShardID == core.MetachainShardId
+	if !isValidShard {
+		return fmt.Errorf("%w: %d", heartbeat.ErrInvalidHeartbeatShard, hb.ShardID)
+	}
+
+	sendTime, isTimestamp := parsePayloadTime(hb.Payload)
+	if !isTimestamp {
+		return nil
+	}
+	if now.Sub(sendTime) > hv.maxAge {
+		return fmt.Errorf("%w: sent at %v, maximum age %v", heartbeat.ErrStaleHeartbeat, sendTime, hv.maxAge)
+	}
+	if sendTime.Sub(now) > hv.maxFutureDrift {
+		return fmt.Errorf("%w: sent at %v, maximum drift %v", heartbeat.ErrFutureHeartbeat, sendTime, hv.maxFutureDrift)
+	}
+
+	return nil
+}
+
+func checkRequiredFields(hb *data.Heartbeat) error {
+	if len(hb.Pubkey) == 0 {
+		return fmt.Errorf("%w: Pubkey", heartbeat.ErrMissingHeartbeatField)
+	}
+	if len(hb.Signature) == 0 {
+		return fmt.Errorf("%w: Signature", heartbeat.ErrMissingHeartbeatField)
+	}
+	if len(hb.Pid) == 0 {
+		return fmt.Errorf("%w: Pid", heartbeat.ErrMissingHeartbeatField)
+	}
+	if len(hb.Payload) == 0 {
+		return fmt.Errorf("%w: Payload", heartbeat.ErrMissingHeartbeatField)
+	}
+
+	return nil
+}
+
+func parsePayloadTime(payload []byte) (time.Time, bool) {
+	strTime := string(payload)
+	monotonicIndex := strings.Index(strTime, monotonicClockMarker)
+	if monotonicIndex >= 0 {
+		strTime = strTime[:monotonicIndex]
+	}
+
+	sendTime, err := time.Parse(payloadTimeLayout, strTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return sendTime, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hv *HeartbeatValidator) IsInterfaceNil() bool {
+	return hv == nil
+}
@@ -0,0 +1,143 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgHeartbeatValidator() process.ArgHeartbeatValidator {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+
+	return process.ArgHeartbeatValidator{
+		ShardCoordinator: shardCoordinator,
+		MaxAge:           time.Minute,
+		MaxFutureDrift:   time.Second * 10,
+	}
+}
+
+func createHeartbeatSentAt(sendTime time.Time) *data.Heartbeat {
+	return &data.Heartbeat{
+		Payload:   []byte(sendTime.String()),
+		Pubkey:    []byte("pubkey"),
+		Signature: []byte("signature"),
+		ShardID:   1,
+		Pid:       []byte("pid"),
+	}
+}
+
+func TestNewHeartbeatValidator_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatValidator()
+	arg.ShardCoordinator = nil
+	hv, err := process.NewHeartbeatValidator(arg)
+
+	assert.True(t, check.IfNil(hv))
+	assert.Equal(t, heartbeat.ErrNilShardCoordinator, err)
+}
+
+func TestNewHeartbeatValidator_InvalidMaxAgeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatValidator()
+	arg.MaxAge = 0
+	hv, err := process.NewHeartbeatValidator(arg)
+
+	assert.True(t, check.IfNil(hv))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidHeartbeatAge))
+}
+
+func TestNewHeartbeatValidator_NegativeMaxFutureDriftShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatValidator()
+	arg.MaxFutureDrift = -time.Second
+	hv, err := process.NewHeartbeatValidator(arg)
+
+	assert.True(t, check.IfNil(hv))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidHeartbeatAge))
+}
+
+func TestHeartbeatValidator_ValidateHeartbeatValidShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hv, _ := process.NewHeartbeatValidator(createMockArgHeartbeatValidator())
+	now := time.Now()
+
+	assert.Nil(t, hv.ValidateHeartbeat(createHeartbeatSentAt(now.Add(-time.Second*30)), now))
+
+	hb := createHeartbeatSentAt(now)
+	hb.ShardID = core.MetachainShardId
+	assert.Nil(t, hv.ValidateHeartbeat(hb, now))
+}
+
+func TestHeartbeatValidator_ValidateHeartbeatStaleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hv, _ := process.NewHeartbeatValidator(createMockArgHeartbeatValidator())
+	now := time.Now()
+
+	err := hv.ValidateHeartbeat(createHeartbeatSentAt(now.Add(-time.Minute*2)), now)
+
+	assert.True(t, errors.Is(err, heartbeat.ErrStaleHeartbeat))
+}
+
+func TestHeartbeatValidator_ValidateHeartbeatFutureDatedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hv, _ := process.NewHeartbeatValidator(createMockArgHeartbeatValidator())
+	now := time.Now()
+
+	err := hv.ValidateHeartbeat(createHeartbeatSentAt(now.Add(time.Minute)), now)
+
+	assert.True(t, errors.Is(err, heartbeat.ErrFutureHeartbeat))
+}
+
+func TestHeartbeatValidator_ValidateHeartbeatMissingFieldShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hv, _ := process.NewHeartbeatValidator(createMockArgHeartbeatValidator())
+	now := time.Now()
+
+	assert.Equal(t, heartbeat.ErrNilMessage, hv.ValidateHeartbeat(nil, now))
+
+	hb := createHeartbeatSentAt(now)
+	hb.Signature = nil
+	assert.True(t, errors.Is(hv.ValidateHeartbeat(hb, now), heartbeat.ErrMissingHeartbeatField))
+
+	hb = createHeartbeatSentAt(now)
+	hb.Pid = nil
+	assert.True(t, errors.Is(hv.ValidateHeartbeat(hb, now), heartbeat.ErrMissingHeartbeatField))
+}
+
+func TestHeartbeatValidator_ValidateHeartbeatInvalidShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hv, _ := process.NewHeartbeatValidator(createMockArgHeartbeatValidator())
+	now := time.Now()
+	hb := createHeartbeatSentAt(now)
+	hb.ShardID = 2
+
+	err := hv.ValidateHeartbeat(hb, now)
+
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidHeartbeatShard))
+}
+
+func TestHeartbeatValidator_ValidateHeartbeatPayloadWithoutTimestampShouldNotCheckAge(t *testing.T) {
+	t.Parallel()
+
+	hv, _ := process.NewHeartbeatValidator(createMockArgHeartbeatValidator())
+	hb := createHeartbeatSentAt(time.Now())
+	hb.Payload = []byte("hardfork trigger data")
+
+	assert.Nil(t, hv.ValidateHeartbeat(hb, time.Now().Add(time.Hour)))
+}
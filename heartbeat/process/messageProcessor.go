@@ -62,21 +62,39 @@ func (mp *MessageProcessor) CreateHeartbeatFromP2PMessage(message p2p.MessageP2P
 		return nil, err
 	}
 
-	err = verifyLengths(hbRecv)
+	err = mp.VerifyHeartbeat(hbRecv, message)
 	if err != nil {
 		return nil, err
 	}
 
+	return hbRecv, nil
+}
+
+// VerifyHeartbeat checks the fields and the signature of an already unmarshalled heartbeat and, if they are valid,
+// records the public key and the shard of the message originator
+func (mp *MessageProcessor) VerifyHeartbeat(hbRecv *data.Heartbeat, message p2p.MessageP2P) error {
+	if hbRecv == nil {
+		return heartbeat.ErrNilDataToProcess
+	}
+	if check.IfNil(message) {
+		return heartbeat.ErrNilMessage
+	}
+
+	err := verifyLengths(hbRecv)
+	if err != nil {
+		return err
+	}
+
 	err = mp.verifySignature(hbRecv)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	mp.networkShardingCollector.UpdatePeerIdPublicKey(message.Peer(), hbRecv.Pubkey)
 	//add into the last failsafe map. Useful for observers.
 	mp.networkShardingCollector.UpdatePeerIdShardId(message.Peer(), hbRecv.ShardID)
 
-	return hbRecv, nil
+	return nil
 }
 
 func (mp *MessageProcessor) verifySignature(hbRecv *data.Heartbeat) error {
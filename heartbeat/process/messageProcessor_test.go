@@ -96,6 +96,23 @@ func TestNewMessageProcessor_ShouldWork(t *testing.T) {
 	assert.False(t, mon.IsInterfaceNil())
 }
 
+func TestMessageProcessor_VerifyHeartbeatNilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mp, _ := process.NewMessageProcessor(
+		&mock.SinglesignMock{},
+		&mock.KeyGenMock{},
+		&mock.MarshalizerStub{},
+		&mock.NetworkShardingCollectorStub{},
+	)
+
+	err := mp.VerifyHeartbeat(nil, &mock.P2PMessageStub{})
+	assert.Equal(t, heartbeat.ErrNilDataToProcess, err)
+
+	err = mp.VerifyHeartbeat(&data.Heartbeat{}, nil)
+	assert.Equal(t, heartbeat.ErrNilMessage, err)
+}
+
 func TestNewMessageProcessor_VerifyMessageAllSmallerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +36,7 @@ type ArgHeartbeatMonitor struct {
 	ValidatorPubkeyConverter           core.PubkeyConverter
 	HeartbeatRefreshIntervalInSec      uint32
 	HideInactiveValidatorIntervalInSec uint32
+	PruneInactivePeerIntervalInSec     uint32
 }
 
 // Monitor represents the heartbeat component that processes received heartbeat messages
@@ -60,6 +61,7 @@ type Monitor struct {
 	validatorPubkeyConverter           core.PubkeyConverter
 	heartbeatRefreshIntervalInSec      uint32
 	hideInactiveValidatorIntervalInSec uint32
+	pruneInactivePeerIntervalInSec     uint32
 }
 
 // NewMonitor returns a new monitor instance
@@ -117,6 +119,7 @@ func NewMonitor(arg ArgHeartbeatMonitor) (*Monitor, error) {
 		validatorPubkeyConverter:           arg.ValidatorPubkeyConverter,
 		heartbeatRefreshIntervalInSec:      arg.HeartbeatRefreshIntervalInSec,
 		hideInactiveValidatorIntervalInSec: arg.HideInactiveValidatorIntervalInSec,
+		pruneInactivePeerIntervalInSec:     arg.PruneInactivePeerIntervalInSec,
 	}
 
 	err := mon.storer.UpdateGenesisTime(arg.GenesisTime)
@@ -274,6 +277,28 @@ func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPe
 		return err
 	}
 
+	return m.processHeartbeat(hbRecv, message, fromConnectedPeer)
+}
+
+// ProcessInterceptedHeartbeat processes a heartbeat message forwarded by the heartbeat interceptor. The message was
+// already charged to the antiflood handler and unmarshalled by the interceptor, so only the heartbeat is verified
+func (m *Monitor) ProcessInterceptedHeartbeat(hb *data.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return heartbeat.ErrNilMessage
+	}
+	if hb == nil {
+		return heartbeat.ErrNilDataToProcess
+	}
+
+	err := m.messageHandler.VerifyHeartbeat(hb, message)
+	if err != nil {
+		return err
+	}
+
+	return m.processHeartbeat(hb, message, fromConnectedPeer)
+}
+
+func (m *Monitor) processHeartbeat(hbRecv *data.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	isHardforkTrigger, err := m.hardforkTrigger.TriggerReceived(message.Data(), hbRecv.Payload, hbRecv.Pubkey)
 	if isHardforkTrigger {
 		return err
@@ -436,7 +461,7 @@ func (m *Monitor) GetHeartbeats() []data.PubKeyHeartbeat {
 	m.mutHeartbeatMessages.Lock()
 	status := make([]data.PubKeyHeartbeat, 0, len(m.heartbeatMessages))
 	for k, v := range m.heartbeatMessages {
-		if m.shouldSkipValidator(v) {
+		if m.shouldSkipValidator(v) || m.shouldPrunePeer(v) {
 			delete(m.heartbeatMessages, k)
 			continue
 		}
@@ -482,6 +507,18 @@ func (m *Monitor) shouldSkipValidator(v *heartbeatMessageInfo) bool {
 	return false
 }
 
+// shouldPrunePeer returns true if the peer, regardless of its type, did not send any heartbeat for longer than the
+// configured prune interval. A zero prune interval disables the pruning
+func (m *Monitor) shouldPrunePeer(v *heartbeatMessageInfo) bool {
+	if m.pruneInactivePeerIntervalInSec == 0 || v.GetIsActive() {
+		return false
+	}
+
+	lastInactiveInterval := m.timer.Now().Sub(v.timeStamp)
+
+	return lastInactiveInterval.Seconds() > float64(m.pruneInactivePeerIntervalInSec)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (m *Monitor) IsInterfaceNil() bool {
 	return m == nil
@@ -15,6 +15,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/storage"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -287,6 +289,102 @@ func TestMonitor_ProcessReceivedMessageShouldWork(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString([]byte(pubKey)), hbStatus[0].PublicKey)
 }
 
+func TestMonitor_ProcessInterceptedHeartbeatShouldNotCheckAntifloodAndUnmarshalAgain(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	pid := core.PeerID("pid")
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = time.Second * 1000
+	arg.PubKeysMap = map[uint32][]string{0: {pubKey}}
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			assert.Fail(t, "should have not checked the antiflood again")
+			return nil
+		},
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+			assert.Fail(t, "should have not checked the antiflood again")
+			return nil
+		},
+	}
+	numVerifyCalls := 0
+	arg.MessageHandler = &mock.MessageHandlerStub{
+		CreateHeartbeatFromP2PMessageCalled: func(message p2p.MessageP2P) (*data.Heartbeat, error) {
+			assert.Fail(t, "should have not unmarshalled the heartbeat again")
+			return nil, nil
+		},
+		VerifyHeartbeatCalled: func(hbRecv *data.Heartbeat, message p2p.MessageP2P) error {
+			numVerifyCalls++
+			return nil
+		},
+	}
+	mon, _ := process.NewMonitor(arg)
+
+	hb := &data.Heartbeat{
+		Pubkey: []byte(pubKey),
+		Pid:    pid.Bytes(),
+	}
+	err := mon.ProcessInterceptedHeartbeat(hb, &mock.P2PMessageStub{DataField: []byte("hb"), PeerField: pid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numVerifyCalls)
+
+	//a delay is mandatory for the go routine to finish its job
+	time.Sleep(time.Second)
+
+	hbStatus := mon.GetHeartbeats()
+	assert.Equal(t, 1, len(hbStatus))
+	assert.Equal(t, hex.EncodeToString([]byte(pubKey)), hbStatus[0].PublicKey)
+}
+
+func TestMonitor_ProcessInterceptedHeartbeatShouldChargeTheAntifloodOncePerMessage(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	numCanProcessMessage := 0
+	numCanProcessMessagesOnTopic := 0
+	antiflood := &processMock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			numCanProcessMessage++
+			return nil
+		},
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+			numCanProcessMessagesOnTopic++
+			return nil
+		},
+	}
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.AntifloodHandler = antiflood
+	mon, _ := process.NewMonitor(arg)
+
+	heartbeatValidator, _ := process.NewHeartbeatValidator(process.ArgHeartbeatValidator{
+		ShardCoordinator: processMock.NewMultipleShardsCoordinatorMock(),
+		MaxAge:           time.Minute,
+		MaxFutureDrift:   time.Minute,
+	})
+	throttler := &processMock.InterceptorThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	hi, _ := interceptors.NewHeartbeatInterceptor(marshalizer, throttler, antiflood, heartbeatValidator)
+	_ = hi.SetHeartbeatProcessor(mon)
+
+	hb := &data.Heartbeat{
+		Payload:   []byte("payload"),
+		Pubkey:    []byte("pk0"),
+		Signature: []byte("signature"),
+		Pid:       pid.Bytes(),
+	}
+	hbBytes, _ := marshalizer.Marshal(hb)
+	err := hi.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes, PeerField: pid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCanProcessMessage)
+	assert.Equal(t, 1, numCanProcessMessagesOnTopic)
+}
+
 func TestMonitor_ProcessReceivedMessageProcessTriggerErrorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -569,6 +667,49 @@ func TestMonitor_RemoveInactiveValidatorsIfIntervalExceeded(t *testing.T) {
 	assert.Equal(t, 2, len(hbStatus))
 }
 
+func TestMonitor_PruneInactivePeersIfIntervalExceeded(t *testing.T) {
+	t.Parallel()
+
+	pubKeyStale := "pk-eligible-stale"
+	pubKeyLive := "pk-eligible-live"
+
+	storer, _ := storage.NewHeartbeatDbStorer(mock.NewStorerMock(), &mock.MarshalizerMock{})
+	timer := mock.NewTimerMock()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = unresponsiveDuration
+	arg.PubKeysMap = map[uint32][]string{0: {pubKeyStale, pubKeyLive}}
+	arg.GenesisTime = timer.Now()
+	arg.Storer = storer
+	arg.Timer = timer
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			return core.EligibleList, 0, nil
+		},
+	}
+	arg.HideInactiveValidatorIntervalInSec = 600
+	arg.PruneInactivePeerIntervalInSec = 3600
+	mon, _ := process.NewMonitor(arg)
+
+	mon.SendHeartbeatMessage(&data.Heartbeat{Pubkey: []byte(pubKeyStale)})
+	mon.SendHeartbeatMessage(&data.Heartbeat{Pubkey: []byte(pubKeyLive)})
+	mon.RefreshHeartbeatMessageInfo()
+	assert.Equal(t, 2, len(mon.GetHeartbeats()))
+
+	// only the live peer keeps on sending heartbeats after the prune interval elapsed
+	timer.IncrementSeconds(int(arg.PruneInactivePeerIntervalInSec) + 10)
+	mon.SendHeartbeatMessage(&data.Heartbeat{Pubkey: []byte(pubKeyLive)})
+	mon.RefreshHeartbeatMessageInfo()
+	hbStatus := mon.GetHeartbeats()
+	assert.Equal(t, 1, len(hbStatus))
+	assert.Equal(t, hex.EncodeToString([]byte(pubKeyLive)), hbStatus[0].PublicKey)
+
+	// the pruned peer reappears as soon as it sends a new heartbeat
+	mon.SendHeartbeatMessage(&data.Heartbeat{Pubkey: []byte(pubKeyStale)})
+	mon.RefreshHeartbeatMessageInfo()
+	assert.Equal(t, 2, len(mon.GetHeartbeats()))
+}
+
 func TestMonitor_ProcessReceivedMessageImpersonatedMessageShouldErr(t *testing.T) {
 	t.Parallel()
 
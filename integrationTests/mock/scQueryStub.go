@@ -9,6 +9,7 @@ import (
 // ScQueryStub -
 type ScQueryStub struct {
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryAsyncCalled     func(query *process.SCQuery) <-chan process.SCQueryResult
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 }
 
@@ -20,6 +21,21 @@ func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput,
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueryAsync -
+func (s *ScQueryStub) ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	if s.ExecuteQueryAsyncCalled != nil {
+		return s.ExecuteQueryAsyncCalled(query)
+	}
+
+	chResult := make(chan process.SCQueryResult, 1)
+	vmOutput, err := s.ExecuteQuery(query)
+	chResult <- process.SCQueryResult{
+		VMOutput: vmOutput,
+		Err:      err,
+	}
+	return chResult
+}
+
 // ComputeScCallGasLimit --
 func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if s.ComputeScCallGasLimitCalled != nil {
@@ -173,6 +173,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		DataPool:               tpn.DataPool,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockChain:             tpn.BlockChain,
+		TxLogsProcessor:        &mock.TxLogsProcessorStub{},
 		BlockSizeThrottler:     TestBlockSizeThrottler,
 		Version:                string(SoftwareVersion),
 	}
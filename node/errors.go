@@ -165,3 +165,12 @@ var ErrNilApiTransactionByHashThrottler = errors.New("nil api transaction by has
 
 // ErrSystemBusyTxHash signals that too many requests occur in the same time on the transaction by hash provider
 var ErrSystemBusyTxHash = errors.New("system busy. try again later")
+
+// ErrNilSyncStateHandler signals that a nil sync state handler has been provided
+var ErrNilSyncStateHandler = errors.New("nil sync state handler")
+
+// ErrNilTxsPoolsCleaner signals that a nil transactions pools cleaner has been provided
+var ErrNilTxsPoolsCleaner = errors.New("nil txs pools cleaner")
+
+// ErrIndexerNotEnabled signals that the indexer is not enabled on this node
+var ErrIndexerNotEnabled = errors.New("indexer not enabled")
@@ -0,0 +1,134 @@
+package external
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// AccountResponse holds the balance and the nonce of an account returned by a bulk query
+type AccountResponse struct {
+	Address []byte
+	Nonce   uint64
+	Balance *big.Int
+}
+
+// ArgsAccountsBulkGetter is the argument structure used to create a new accounts bulk getter
+type ArgsAccountsBulkGetter struct {
+	AccountsTrie   data.Trie
+	BlockChain     data.ChainHandler
+	Hasher         hashing.Hasher
+	Marshalizer    marshal.Marshalizer
+	AccountFactory state.AccountFactory
+}
+
+type accountsBulkGetter struct {
+	accountsTrie   data.Trie
+	blockChain     data.ChainHandler
+	hasher         hashing.Hasher
+	marshalizer    marshal.Marshalizer
+	accountFactory state.AccountFactory
+}
+
+// NewAccountsBulkGetter creates a new instance able to read more accounts at once
+func NewAccountsBulkGetter(args ArgsAccountsBulkGetter) (*accountsBulkGetter, error) {
+	if check.IfNil(args.AccountsTrie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.AccountFactory) {
+		return nil, ErrNilAccountFactory
+	}
+
+	return &accountsBulkGetter{
+		accountsTrie:   args.AccountsTrie,
+		blockChain:     args.BlockChain,
+		hasher:         args.Hasher,
+		marshalizer:    args.Marshalizer,
+		accountFactory: args.AccountFactory,
+	}, nil
+}
+
+// GetAccountsBulk returns the accounts of the provided addresses, all read from the state committed by the last block.
+// The accounts are read from a trie recreated on the committed root hash, so the block being processed does not
+// affect the reading. Addresses not found in the state are returned as zeroed accounts
+func (abg *accountsBulkGetter) GetAccountsBulk(addresses [][]byte) ([]*AccountResponse, error) {
+	accounts, err := abg.createCommittedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*AccountResponse, 0, len(addresses))
+	for _, address := range addresses {
+		response, errGet := getAccount(accounts, address)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (abg *accountsBulkGetter) createCommittedAccounts() (state.AccountsAdapter, error) {
+	header := abg.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = abg.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, ErrNilBlockHeader
+	}
+
+	committedTrie, err := abg.accountsTrie.Recreate(header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(committedTrie, abg.hasher, abg.marshalizer, abg.accountFactory)
+}
+
+func getAccount(accounts state.AccountsAdapter, address []byte) (*AccountResponse, error) {
+	response := &AccountResponse{
+		Address: address,
+		Balance: big.NewInt(0),
+	}
+
+	account, err := accounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return response, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %x", err, address)
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for address %x", state.ErrWrongTypeAssertion, address)
+	}
+
+	response.Nonce = userAccount.GetNonce()
+	if userAccount.GetBalance() != nil {
+		response.Balance.Set(userAccount.GetBalance())
+	}
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (abg *accountsBulkGetter) IsInterfaceNil() bool {
+	return abg == nil
+}
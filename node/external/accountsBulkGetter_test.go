@@ -0,0 +1,275 @@
+package external_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAccountsBulkGetter() external.ArgsAccountsBulkGetter {
+	return external.ArgsAccountsBulkGetter{
+		AccountsTrie: &mock.TrieStub{},
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: []byte("root hash")}
+			},
+		},
+		Hasher:         &mock.HasherMock{},
+		Marshalizer:    &mock.MarshalizerMock{},
+		AccountFactory: factory.NewAccountCreator(),
+	}
+}
+
+func createAccountsDB(t *testing.T) (*state.AccountsDB, data.Trie) {
+	marshalizer := &mock.MarshalizerFake{}
+	hasher := &mock.HasherFake{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	maxTrieLevelInMemory := uint(5)
+	tr, err := trie.NewTrie(storageManager, marshalizer, hasher, maxTrieLevelInMemory)
+	require.Nil(t, err)
+
+	accounts, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	return accounts, tr
+}
+
+func saveUserAccount(t *testing.T, accounts state.AccountsAdapter, address []byte, nonce uint64, balance int64) {
+	account, err := accounts.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	userAccount.IncreaseNonce(nonce)
+	_ = userAccount.AddToBalance(big.NewInt(balance))
+	require.Nil(t, accounts.SaveAccount(userAccount))
+}
+
+func TestNewAccountsBulkGetter_NilAccountsTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsBulkGetter()
+	args.AccountsTrie = nil
+	abg, err := external.NewAccountsBulkGetter(args)
+
+	assert.True(t, check.IfNil(abg))
+	assert.Equal(t, external.ErrNilTrie, err)
+}
+
+func TestNewAccountsBulkGetter_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsBulkGetter()
+	args.BlockChain = nil
+	abg, err := external.NewAccountsBulkGetter(args)
+
+	assert.True(t, check.IfNil(abg))
+	assert.Equal(t, external.ErrNilBlockChain, err)
+}
+
+func TestNewAccountsBulkGetter_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsBulkGetter()
+	args.Hasher = nil
+	abg, err := external.NewAccountsBulkGetter(args)
+
+	assert.True(t, check.IfNil(abg))
+	assert.Equal(t, external.ErrNilHasher, err)
+}
+
+func TestNewAccountsBulkGetter_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsBulkGetter()
+	args.Marshalizer = nil
+	abg, err := external.NewAccountsBulkGetter(args)
+
+	assert.True(t, check.IfNil(abg))
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+}
+
+func TestNewAccountsBulkGetter_NilAccountFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsBulkGetter()
+	args.AccountFactory = nil
+	abg, err := external.NewAccountsBulkGetter(args)
+
+	assert.True(t, check.IfNil(abg))
+	assert.Equal(t, external.ErrNilAccountFactory, err)
+}
+
+func TestNewAccountsBulkGetter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	abg, err := external.NewAccountsBulkGetter(createMockArgsAccountsBulkGetter())
+
+	assert.False(t, check.IfNil(abg))
+	assert.Nil(t, err)
+}
+
+func TestAccountsBulkGetter_GetAccountsBulkShouldReadTheCommittedState(t *testing.T) {
+	t.Parallel()
+
+	existingAddress := []byte("existing")
+	missingAddress := []byte("missing")
+	accounts, tr := createAccountsDB(t)
+	saveUserAccount(t, accounts, existingAddress, 7, 100)
+	committedRootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	// a block is being processed, so the live state changes without being committed
+	saveUserAccount(t, accounts, existingAddress, 1, 50)
+	saveUserAccount(t, accounts, missingAddress, 1, 50)
+
+	args := createMockArgsAccountsBulkGetter()
+	args.AccountsTrie = tr
+	args.Hasher = &mock.HasherFake{}
+	args.Marshalizer = &mock.MarshalizerFake{}
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: committedRootHash}
+		},
+	}
+	abg, _ := external.NewAccountsBulkGetter(args)
+
+	responses, err := abg.GetAccountsBulk([][]byte{existingAddress, missingAddress})
+
+	require.Nil(t, err)
+	require.Equal(t, 2, len(responses))
+	assert.Equal(t, &external.AccountResponse{Address: existingAddress, Nonce: 7, Balance: big.NewInt(100)}, responses[0])
+	assert.Equal(t, &external.AccountResponse{Address: missingAddress, Nonce: 0, Balance: big.NewInt(0)}, responses[1])
+}
+
+func TestAccountsBulkGetter_GetAccountsBulkShouldRecreateTheTrieOnTheCurrentHeaderRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("current root hash")
+	args := createMockArgsAccountsBulkGetter()
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			assert.Fail(t, "should have not used the genesis header")
+			return nil
+		},
+	}
+	args.AccountsTrie = &mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			assert.Fail(t, "should have not read from the live trie")
+			return nil, nil
+		},
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return &mock.TrieStub{}, nil
+		},
+	}
+	abg, _ := external.NewAccountsBulkGetter(args)
+
+	responses, err := abg.GetAccountsBulk([][]byte{[]byte("address")})
+
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(responses))
+}
+
+func TestAccountsBulkGetter_GetAccountsBulkNoCurrentHeaderShouldUseTheGenesisHeader(t *testing.T) {
+	t.Parallel()
+
+	genesisRootHash := []byte("genesis root hash")
+	args := createMockArgsAccountsBulkGetter()
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: genesisRootHash}
+		},
+	}
+	recreateCalled := false
+	args.AccountsTrie = &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			recreateCalled = true
+			assert.Equal(t, genesisRootHash, root)
+			return &mock.TrieStub{}, nil
+		},
+	}
+	abg, _ := external.NewAccountsBulkGetter(args)
+
+	_, err := abg.GetAccountsBulk([][]byte{[]byte("address")})
+
+	assert.Nil(t, err)
+	assert.True(t, recreateCalled)
+}
+
+func TestAccountsBulkGetter_GetAccountsBulkNoHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsBulkGetter()
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+	}
+	abg, _ := external.NewAccountsBulkGetter(args)
+
+	responses, err := abg.GetAccountsBulk([][]byte{[]byte("address")})
+
+	assert.Nil(t, responses)
+	assert.Equal(t, external.ErrNilBlockHeader, err)
+}
+
+func TestAccountsBulkGetter_GetAccountsBulkRecreateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAccountsBulkGetter()
+	args.AccountsTrie = &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+	abg, _ := external.NewAccountsBulkGetter(args)
+
+	responses, err := abg.GetAccountsBulk([][]byte{[]byte("address")})
+
+	assert.Nil(t, responses)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestAccountsBulkGetter_GetAccountsBulkErrorShouldFailTheBatch(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAccountsBulkGetter()
+	args.AccountsTrie = &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return &mock.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return nil, expectedErr
+				},
+			}, nil
+		},
+	}
+	abg, _ := external.NewAccountsBulkGetter(args)
+
+	responses, err := abg.GetAccountsBulk([][]byte{[]byte("address")})
+
+	assert.Nil(t, responses)
+	assert.True(t, errors.Is(err, expectedErr))
+}
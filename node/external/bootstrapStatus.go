@@ -0,0 +1,8 @@
+package external
+
+// BootstrapStatus holds the sync state of the node, as seen by its bootstrapper
+type BootstrapStatus struct {
+	ProbableHighestNonce uint64 `json:"probableHighestNonce"`
+	CurrentNonce         uint64 `json:"currentNonce"`
+	IsSynced             bool   `json:"isSynced"`
+}
@@ -0,0 +1,10 @@
+package external
+
+// EpochInfo holds the current epoch of the node, together with the rounds needed to reach the next epoch
+type EpochInfo struct {
+	Epoch           uint32 `json:"epoch"`
+	CurrentRound    uint64 `json:"currentRound"`
+	EpochStartRound uint64 `json:"epochStartRound"`
+	RoundsPerEpoch  uint64 `json:"roundsPerEpoch"`
+	RoundsRemaining uint64 `json:"roundsRemaining"`
+}
@@ -10,3 +10,24 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 
 // ErrNilTransactionCostHandler signals that a nil transaction cost handler was provided
 var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
+
+// ErrNilAccountsBulkGetter signals that a nil accounts bulk getter was provided
+var ErrNilAccountsBulkGetter = errors.New("nil accounts bulk getter")
+
+// ErrNilTrie signals that a nil trie was provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNilBlockChain signals that a nil block chain was provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilAccountFactory signals that a nil account factory was provided
+var ErrNilAccountFactory = errors.New("nil account factory")
+
+// ErrNilBlockHeader signals that no committed block header is available
+var ErrNilBlockHeader = errors.New("nil block header")
@@ -9,6 +9,7 @@ import (
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
@@ -27,3 +28,9 @@ type TransactionCostHandler interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
+
+// AccountsBulkGetter defines the behavior of a component able to read more accounts from the same state
+type AccountsBulkGetter interface {
+	GetAccountsBulk(addresses [][]byte) ([]*AccountResponse, error)
+	IsInterfaceNil() bool
+}
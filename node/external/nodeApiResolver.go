@@ -12,6 +12,7 @@ type NodeApiResolver struct {
 	scQueryService       SCQueryService
 	statusMetricsHandler StatusMetricsHandler
 	txCostHandler        TransactionCostHandler
+	accountsBulkGetter   AccountsBulkGetter
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -19,6 +20,7 @@ func NewNodeApiResolver(
 	scQueryService SCQueryService,
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
+	accountsBulkGetter AccountsBulkGetter,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -29,11 +31,15 @@ func NewNodeApiResolver(
 	if check.IfNil(txCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
+	if check.IfNil(accountsBulkGetter) {
+		return nil, ErrNilAccountsBulkGetter
+	}
 
 	return &NodeApiResolver{
 		scQueryService:       scQueryService,
 		statusMetricsHandler: statusMetricsHandler,
 		txCostHandler:        txCostHandler,
+		accountsBulkGetter:   accountsBulkGetter,
 	}, nil
 }
 
@@ -42,6 +48,11 @@ func (nar *NodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueryAsync retrieves data stored in a SC account through a VM, delivering the result on the returned channel
+func (nar *NodeApiResolver) ExecuteSCQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	return nar.scQueryService.ExecuteQueryAsync(query)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *NodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -52,6 +63,11 @@ func (nar *NodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// GetAccountsBulk returns the accounts of the provided addresses, all read from the last committed state
+func (nar *NodeApiResolver) GetAccountsBulk(addresses [][]byte) ([]*AccountResponse, error) {
+	return nar.accountsBulkGetter.GetAccountsBulk(addresses)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
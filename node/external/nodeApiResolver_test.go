@@ -1,6 +1,7 @@
 package external_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -14,7 +15,7 @@ import (
 func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.AccountsBulkGetterStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -23,7 +24,7 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, &mock.AccountsBulkGetterStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -32,16 +33,25 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, &mock.AccountsBulkGetterStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
 }
 
+func TestNewNodeApiResolver_NilAccountsBulkGetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAccountsBulkGetter, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.AccountsBulkGetterStub{})
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -57,7 +67,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	},
-		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.AccountsBulkGetterStub{})
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
 		ScAddress: []byte{0},
@@ -67,6 +77,23 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ExecuteSCQueryAsyncShouldCall(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	nar, _ := external.NewNodeApiResolver(&mock.SCQueryServiceStub{
+		ExecuteQueryAsyncCalled: func(query *process.SCQuery) <-chan process.SCQueryResult {
+			wasCalled = true
+			return make(chan process.SCQueryResult, 1)
+		},
+	},
+		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.AccountsBulkGetterStub{})
+
+	_ = nar.ExecuteSCQueryAsync(&process.SCQuery{})
+
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
@@ -80,6 +107,7 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.AccountsBulkGetterStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -99,6 +127,7 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.AccountsBulkGetterStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -118,6 +147,7 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.AccountsBulkGetterStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -137,6 +167,7 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.AccountsBulkGetterStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -156,8 +187,32 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.AccountsBulkGetterStub{},
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetAccountsBulkShouldCall(t *testing.T) {
+	t.Parallel()
+
+	addresses := [][]byte{[]byte("address")}
+	expectedResponses := []*external.AccountResponse{{Address: addresses[0], Balance: big.NewInt(10)}}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.AccountsBulkGetterStub{
+			GetAccountsBulkCalled: func(providedAddresses [][]byte) ([]*external.AccountResponse, error) {
+				assert.Equal(t, addresses, providedAddresses)
+				return expectedResponses, nil
+			},
+		},
+	)
+
+	responses, err := nar.GetAccountsBulk(addresses)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponses, responses)
+}
@@ -0,0 +1,10 @@
+package external
+
+// PeerInfo holds the p2p identity of the node together with the number of connected peers, grouped by their shard
+type PeerInfo struct {
+	PeerID          string   `json:"peerID"`
+	Addresses       []string `json:"addresses"`
+	IntraShardPeers int      `json:"intraShardPeers"`
+	CrossShardPeers int      `json:"crossShardPeers"`
+	UnknownPeers    int      `json:"unknownPeers"`
+}
@@ -21,6 +21,8 @@ type P2PMessenger interface {
 	PeerAddress(pid core.PeerID) string
 	IsConnectedToTheNetwork() bool
 	ID() core.PeerID
+	Addresses() []string
+	GetConnectedPeersInfo() *p2p.ConnectedPeersInfo
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// AccountsBulkGetterStub -
+type AccountsBulkGetterStub struct {
+	GetAccountsBulkCalled func(addresses [][]byte) ([]*external.AccountResponse, error)
+}
+
+// GetAccountsBulk -
+func (abgs *AccountsBulkGetterStub) GetAccountsBulk(addresses [][]byte) ([]*external.AccountResponse, error) {
+	if abgs.GetAccountsBulkCalled != nil {
+		return abgs.GetAccountsBulkCalled(addresses)
+	}
+
+	return make([]*external.AccountResponse, 0), nil
+}
+
+// IsInterfaceNil -
+func (abgs *AccountsBulkGetterStub) IsInterfaceNil() bool {
+	return abgs == nil
+}
@@ -13,6 +13,7 @@ type ChainHandlerStub struct {
 	SetGenesisHeaderHashCalled  func(hash []byte)
 	SetCurrentBlockHeaderCalled func(bh data.HeaderHandler) error
 	CreateNewHeaderCalled       func() data.HeaderHandler
+	GetCurrentBlockHeaderCalled func() data.HeaderHandler
 }
 
 // GetGenesisHeader -
@@ -37,6 +38,10 @@ func (chs *ChainHandlerStub) SetGenesisHeaderHash(hash []byte) {
 
 // GetCurrentBlockHeader -
 func (chs *ChainHandlerStub) GetCurrentBlockHeader() data.HeaderHandler {
+	if chs.GetCurrentBlockHeaderCalled != nil {
+		return chs.GetCurrentBlockHeaderCalled()
+	}
+
 	return &block.Header{}
 }
 
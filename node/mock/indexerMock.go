@@ -5,12 +5,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // IndexerMock is a mock implementation fot the Indexer interface
 type IndexerMock struct {
-	SaveBlockCalled func(body *block.Body, header *block.Header)
+	SaveBlockCalled        func(body *block.Body, header *block.Header)
+	ReindexBlockCalled     func(headerHash []byte) error
+	VerifyContiguityCalled func(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error)
 }
 
 // SaveBlock -
@@ -32,21 +35,80 @@ func (im *IndexerMock) SaveRoundInfo(_ indexer.RoundInfo) {
 	panic("implement me")
 }
 
+// SaveLogs -
+func (im *IndexerMock) SaveLogs(_ map[string]data.LogHandler) {
+	panic("implement me")
+}
+
+// RevertIndexedBlock -
+func (im *IndexerMock) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) {
+	panic("implement me")
+}
+
+// SaveRoundsInfo -
+func (im *IndexerMock) SaveRoundsInfo(_ []indexer.RoundInfo) {
+	panic("implement me")
+}
+
+// ReindexBlock -
+func (im *IndexerMock) ReindexBlock(headerHash []byte) error {
+	if im.ReindexBlockCalled != nil {
+		return im.ReindexBlockCalled(headerHash)
+	}
+
+	return nil
+}
+
 // SaveValidatorsRating --
 func (im *IndexerMock) SaveValidatorsRating(_ string, _ []indexer.ValidatorRatingInfo) {
 
 }
 
+// SaveValidatorsRatingHistory -
+func (im *IndexerMock) SaveValidatorsRatingHistory(_ uint32, _ map[string]*state.ValidatorApiResponse) {
+}
+
 // SaveValidatorsPubKeys -
 func (im *IndexerMock) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 	panic("implement me")
 }
 
+// SaveAccountsHistory -
+func (im *IndexerMock) SaveAccountsHistory(_ uint64, _ []indexer.AccountBalanceChange) {
+}
+
+// SaveEpochStartEconomics -
+func (im *IndexerMock) SaveEpochStartEconomics(_ uint32, _ indexer.EpochEconomics) {
+}
+
+// SaveEpochStartInfo -
+func (im *IndexerMock) SaveEpochStartInfo(_ *block.MetaBlock) {
+}
+
+// VerifyContiguity -
+func (im *IndexerMock) VerifyContiguity(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	if im.VerifyContiguityCalled != nil {
+		return im.VerifyContiguityCalled(fromNonce, toNonce, shardID)
+	}
+
+	return nil, nil
+}
+
+// CheckHealth -
+func (im *IndexerMock) CheckHealth() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (im *IndexerMock) IsInterfaceNil() bool {
 	return im == nil
 }
 
+// Close -
+func (im *IndexerMock) Close() error {
+	return nil
+}
+
 // IsNilIndexer -
 func (im *IndexerMock) IsNilIndexer() bool {
 	return false
@@ -19,6 +19,26 @@ type MessengerStub struct {
 	PeerAddressCalled                func(pid core.PeerID) string
 	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
 	IsConnectedToTheNetworkCalled    func() bool
+	AddressesCalled                  func() []string
+	GetConnectedPeersInfoCalled      func() *p2p.ConnectedPeersInfo
+}
+
+// Addresses -
+func (ms *MessengerStub) Addresses() []string {
+	if ms.AddressesCalled != nil {
+		return ms.AddressesCalled()
+	}
+
+	return make([]string, 0)
+}
+
+// GetConnectedPeersInfo -
+func (ms *MessengerStub) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
+	if ms.GetConnectedPeersInfoCalled != nil {
+		return ms.GetConnectedPeersInfoCalled()
+	}
+
+	return nil
 }
 
 // ID -
@@ -9,6 +9,7 @@ import (
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
 	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryAsyncCalled      func(*process.SCQuery) <-chan process.SCQueryResult
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 }
 
@@ -17,6 +18,11 @@ func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vm
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
+// ExecuteQueryAsync -
+func (serviceStub *SCQueryServiceStub) ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	return serviceStub.ExecuteQueryAsyncCalled(query)
+}
+
 // ComputeScCallGasLimit -
 func (serviceStub *SCQueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return serviceStub.ComputeScCallGasLimitHandler(tx)
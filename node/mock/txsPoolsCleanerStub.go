@@ -0,0 +1,45 @@
+package mock
+
+// TxsPoolsCleanerStub -
+type TxsPoolsCleanerStub struct {
+	ForceCleanCalled     func() int
+	WhitelistTxsCalled   func(hashes [][]byte)
+	UnwhitelistTxsCalled func(hashes [][]byte)
+}
+
+// Close -
+func (tpcs *TxsPoolsCleanerStub) Close() error {
+	return nil
+}
+
+// StartCleaning -
+func (tpcs *TxsPoolsCleanerStub) StartCleaning() {
+}
+
+// ForceClean -
+func (tpcs *TxsPoolsCleanerStub) ForceClean() int {
+	if tpcs.ForceCleanCalled != nil {
+		return tpcs.ForceCleanCalled()
+	}
+
+	return 0
+}
+
+// WhitelistTxs -
+func (tpcs *TxsPoolsCleanerStub) WhitelistTxs(hashes [][]byte) {
+	if tpcs.WhitelistTxsCalled != nil {
+		tpcs.WhitelistTxsCalled(hashes)
+	}
+}
+
+// UnwhitelistTxs -
+func (tpcs *TxsPoolsCleanerStub) UnwhitelistTxs(hashes [][]byte) {
+	if tpcs.UnwhitelistTxsCalled != nil {
+		tpcs.UnwhitelistTxsCalled(hashes)
+	}
+}
+
+// IsInterfaceNil -
+func (tpcs *TxsPoolsCleanerStub) IsInterfaceNil() bool {
+	return tpcs == nil
+}
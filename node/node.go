@@ -32,10 +32,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/componentHandler"
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -93,6 +95,8 @@ type Node struct {
 	whiteListRequest              process.WhiteListHandler
 	whiteListerVerifiedTxs        process.WhiteListHandler
 	apiTransactionByHashThrottler Throttler
+	syncStateHandler              process.SyncStateHandler
+	txsPoolsCleaner               process.TxsPoolsCleaner
 
 	pubKey            crypto.PublicKey
 	privKey           crypto.PrivateKey
@@ -230,6 +234,10 @@ func (n *Node) StartConsensus() error {
 		log.Debug("cannot set app status handler for shard bootstrapper")
 	}
 
+	if !check.IfNil(n.syncStateHandler) {
+		bootstrapper.AddSyncStateListener(n.syncStateHandler.ReceivedSyncState)
+	}
+
 	bootstrapper.StartSyncingBlocks()
 
 	epoch := uint32(0)
@@ -968,6 +976,7 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		PeerShardMapper:          n.networkShardingCollector,
 		SizeCheckDelta:           n.sizeCheckDelta,
 		ValidatorsProvider:       n.validatorsProvider,
+		HeartbeatInterceptor:     n.getHeartbeatInterceptor(),
 	}
 
 	var err error
@@ -976,6 +985,43 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 	return err
 }
 
+func (n *Node) getHeartbeatInterceptor() heartbeat.HeartbeatInterceptor {
+	if check.IfNil(n.interceptorsContainer) {
+		return nil
+	}
+
+	interceptor, err := n.interceptorsContainer.Get(core.HeartbeatTopic)
+	if err != nil {
+		return nil
+	}
+
+	heartbeatInterceptor, ok := interceptor.(heartbeat.HeartbeatInterceptor)
+	if !ok {
+		return nil
+	}
+
+	return heartbeatInterceptor
+}
+
+// GetPeerInfo returns the p2p identity of the node and the number of its connected peers
+func (n *Node) GetPeerInfo() *external.PeerInfo {
+	peerInfo := &external.PeerInfo{
+		PeerID:    n.messenger.ID().Pretty(),
+		Addresses: n.messenger.Addresses(),
+	}
+
+	connectedPeersInfo := n.messenger.GetConnectedPeersInfo()
+	if connectedPeersInfo == nil {
+		return peerInfo
+	}
+
+	peerInfo.IntraShardPeers = len(connectedPeersInfo.IntraShardValidators) + len(connectedPeersInfo.IntraShardObservers)
+	peerInfo.CrossShardPeers = len(connectedPeersInfo.CrossShardValidators) + len(connectedPeersInfo.CrossShardObservers)
+	peerInfo.UnknownPeers = len(connectedPeersInfo.UnknownPeers)
+
+	return peerInfo
+}
+
 // GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
 	if check.IfNil(n.heartbeatHandler) {
@@ -994,6 +1040,12 @@ func (n *Node) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse,
 	return n.validatorsProvider.GetLatestValidators(), nil
 }
 
+// ValidatorsPageApi will return at most limit validators starting from the provided offset, sorted by the provided
+// criterion, along with the total number of validators
+func (n *Node) ValidatorsPageApi(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+	return n.validatorsProvider.GetValidatorsPage(offset, limit, sortBy)
+}
+
 func (n *Node) getLatestValidators() (map[uint32][]*state.ValidatorInfo, map[string]*state.ValidatorApiResponse, error) {
 	latestHash, err := n.validatorStatistics.RootHash()
 	if err != nil {
@@ -1071,6 +1123,77 @@ func (n *Node) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return qh, nil
 }
 
+// ForceCleanTxsPools removes right away the transactions kept in pools for more rounds than allowed and returns the
+// number of removed transactions
+func (n *Node) ForceCleanTxsPools() (int, error) {
+	if check.IfNil(n.txsPoolsCleaner) {
+		return 0, ErrNilTxsPoolsCleaner
+	}
+
+	return n.txsPoolsCleaner.ForceClean(), nil
+}
+
+// GetBootstrapStatus returns the probable highest nonce of the network, the nonce of the current block and whether
+// the node has caught up. Without a sync state handler, the node is considered synced once its current nonce reaches
+// the probable highest nonce
+func (n *Node) GetBootstrapStatus() (*external.BootstrapStatus, error) {
+	if check.IfNil(n.forkDetector) {
+		return nil, ErrNilForkDetector
+	}
+	if check.IfNil(n.blkc) {
+		return nil, ErrNilBlockchain
+	}
+
+	bootstrapStatus := &external.BootstrapStatus{
+		ProbableHighestNonce: n.forkDetector.ProbableHighestNonce(),
+	}
+	currentHeader := n.blkc.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		bootstrapStatus.CurrentNonce = currentHeader.GetNonce()
+	}
+
+	if check.IfNil(n.syncStateHandler) {
+		bootstrapStatus.IsSynced = bootstrapStatus.CurrentNonce >= bootstrapStatus.ProbableHighestNonce
+	} else {
+		bootstrapStatus.IsSynced = !n.syncStateHandler.IsSyncing()
+	}
+
+	return bootstrapStatus, nil
+}
+
+// CheckIndexerHealth verifies that the indexer can reach the elasticsearch server and that all its indexes exist
+func (n *Node) CheckIndexerHealth() error {
+	if check.IfNil(n.indexer) || n.indexer.IsNilIndexer() {
+		return ErrIndexerNotEnabled
+	}
+
+	return n.indexer.CheckHealth()
+}
+
+// ReindexBlock indexes again the block with the provided hex encoded header hash, loading it from the node storage
+func (n *Node) ReindexBlock(hash string) error {
+	if check.IfNil(n.indexer) || n.indexer.IsNilIndexer() {
+		return ErrIndexerNotEnabled
+	}
+
+	headerHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return err
+	}
+
+	return n.indexer.ReindexBlock(headerHash)
+}
+
+// GetIndexerGaps returns the nonces from the inclusive [fromNonce, toNonce] range for which no block of the provided
+// shard was found in the index
+func (n *Node) GetIndexerGaps(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+	if check.IfNil(n.indexer) || n.indexer.IsNilIndexer() {
+		return nil, ErrIndexerNotEnabled
+	}
+
+	return n.indexer.VerifyContiguity(fromNonce, toNonce, shardID)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	return n == nil
@@ -845,6 +845,29 @@ func TestNode_ValidatorStatisticsApi(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNode_ValidatorsPageApi(t *testing.T) {
+	t.Parallel()
+
+	pageToReturn := []state.ValidatorApiResponseWithKey{{PublicKey: "key0"}}
+	validatorProvider := &mock.ValidatorsProviderStub{
+		GetValidatorsPageCalled: func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+			require.Equal(t, 3, offset)
+			require.Equal(t, 1, limit)
+			require.Equal(t, "rating", sortBy)
+
+			return pageToReturn, 4
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithValidatorsProvider(validatorProvider),
+	)
+
+	page, total := n.ValidatorsPageApi(3, 1, "rating")
+	require.Equal(t, pageToReturn, page)
+	require.Equal(t, 4, total)
+}
+
 func TestNode_StartConsensusGenesisBlockNotInitializedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1896,3 +1919,211 @@ func TestNode_GetQueryHandlerShouldWork(t *testing.T) {
 	assert.Equal(t, qhRecovered, qh)
 	assert.Nil(t, err)
 }
+
+func TestNode_ForceCleanTxsPoolsWithoutCleanerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	numTxsCleaned, err := n.ForceCleanTxsPools()
+
+	assert.Equal(t, 0, numTxsCleaned)
+	assert.Equal(t, node.ErrNilTxsPoolsCleaner, err)
+}
+
+func TestNode_ForceCleanTxsPoolsShouldReturnTheNumberOfCleanedTxs(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithTxsPoolsCleaner(&mock.TxsPoolsCleanerStub{
+			ForceCleanCalled: func() int {
+				return 3
+			},
+		}),
+	)
+
+	numTxsCleaned, err := n.ForceCleanTxsPools()
+
+	assert.Equal(t, 3, numTxsCleaned)
+	assert.Nil(t, err)
+}
+
+func TestNode_GetPeerInfoShouldCountTheConnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	addresses := []string{"/ip4/127.0.0.1/tcp/37373"}
+	messenger := &mock.MessengerStub{
+		IDCalled: func() core.PeerID {
+			return "pid"
+		},
+		AddressesCalled: func() []string {
+			return addresses
+		},
+		GetConnectedPeersInfoCalled: func() *p2p.ConnectedPeersInfo {
+			return &p2p.ConnectedPeersInfo{
+				UnknownPeers:         []string{"unknown"},
+				IntraShardValidators: []string{"intra validator 1", "intra validator 2"},
+				IntraShardObservers:  []string{"intra observer"},
+				CrossShardValidators: []string{"cross validator"},
+				CrossShardObservers:  []string{"cross observer 1", "cross observer 2"},
+			}
+		},
+	}
+	n, _ := node.NewNode(node.WithMessenger(messenger))
+
+	peerInfo := n.GetPeerInfo()
+
+	assert.Equal(t, core.PeerID("pid").Pretty(), peerInfo.PeerID)
+	assert.Equal(t, addresses, peerInfo.Addresses)
+	assert.Equal(t, 3, peerInfo.IntraShardPeers)
+	assert.Equal(t, 3, peerInfo.CrossShardPeers)
+	assert.Equal(t, 1, peerInfo.UnknownPeers)
+}
+
+func TestNode_CheckIndexerHealthWithoutIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	err := n.CheckIndexerHealth()
+
+	assert.Equal(t, node.ErrIndexerNotEnabled, err)
+}
+
+func TestNode_CheckIndexerHealthHealthyIndexerShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithIndexer(&mock.IndexerMock{}))
+
+	err := n.CheckIndexerHealth()
+
+	assert.Nil(t, err)
+}
+
+func TestNode_ReindexBlockWithoutIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	err := n.ReindexBlock("aa")
+
+	assert.Equal(t, node.ErrIndexerNotEnabled, err)
+}
+
+func TestNode_ReindexBlockInvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithIndexer(&mock.IndexerMock{}))
+
+	err := n.ReindexBlock("not hex")
+
+	assert.NotNil(t, err)
+}
+
+func TestNode_ReindexBlockShouldReindexTheDecodedHash(t *testing.T) {
+	t.Parallel()
+
+	var reindexedHash []byte
+	n, _ := node.NewNode(node.WithIndexer(&mock.IndexerMock{
+		ReindexBlockCalled: func(headerHash []byte) error {
+			reindexedHash = headerHash
+			return nil
+		},
+	}))
+
+	err := n.ReindexBlock("aabb")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xaa, 0xbb}, reindexedHash)
+}
+
+func TestNode_GetIndexerGapsWithoutIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	missingNonces, err := n.GetIndexerGaps(10, 20, 0)
+
+	assert.Nil(t, missingNonces)
+	assert.Equal(t, node.ErrIndexerNotEnabled, err)
+}
+
+func TestNode_GetIndexerGapsShouldReturnTheIndexerMissingNonces(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithIndexer(&mock.IndexerMock{
+		VerifyContiguityCalled: func(fromNonce uint64, toNonce uint64, shardID uint32) ([]uint64, error) {
+			assert.Equal(t, uint64(10), fromNonce)
+			assert.Equal(t, uint64(20), toNonce)
+			assert.Equal(t, uint32(1), shardID)
+			return []uint64{12, 17}, nil
+		},
+	}))
+
+	missingNonces, err := n.GetIndexerGaps(10, 20, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{12, 17}, missingNonces)
+}
+
+func TestNode_GetBootstrapStatusNilForkDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithBlockChain(&mock.ChainHandlerStub{}))
+
+	bootstrapStatus, err := n.GetBootstrapStatus()
+
+	assert.Nil(t, bootstrapStatus)
+	assert.Equal(t, node.ErrNilForkDetector, err)
+}
+
+func TestNode_GetBootstrapStatusShouldUseTheSyncStateHandler(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithBlockChain(&mock.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 98}
+			},
+		}),
+		node.WithForkDetector(&mock.ForkDetectorMock{
+			ProbableHighestNonceCalled: func() uint64 {
+				return 100
+			},
+		}),
+		node.WithSyncStateHandler(&mock.SyncStateHandlerStub{
+			IsSyncingCalled: func() bool {
+				return true
+			},
+		}),
+	)
+
+	bootstrapStatus, err := n.GetBootstrapStatus()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), bootstrapStatus.ProbableHighestNonce)
+	assert.Equal(t, uint64(98), bootstrapStatus.CurrentNonce)
+	assert.False(t, bootstrapStatus.IsSynced)
+}
+
+func TestNode_GetBootstrapStatusWithoutSyncStateHandlerShouldCompareTheNonces(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithBlockChain(&mock.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 100}
+			},
+		}),
+		node.WithForkDetector(&mock.ForkDetectorMock{
+			ProbableHighestNonceCalled: func() uint64 {
+				return 100
+			},
+		}),
+	)
+
+	bootstrapStatus, err := n.GetBootstrapStatus()
+
+	assert.Nil(t, err)
+	assert.True(t, bootstrapStatus.IsSynced)
+}
@@ -637,6 +637,33 @@ func WithWhiteListHandlerVerified(whiteListHandler process.WhiteListHandler) Opt
 	}
 }
 
+// WithSyncStateHandler sets up a sync state handler option for the Node. The handler will be notified each time the
+// sync state of the node changes
+func WithSyncStateHandler(syncStateHandler process.SyncStateHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(syncStateHandler) {
+			return ErrNilSyncStateHandler
+		}
+
+		n.syncStateHandler = syncStateHandler
+
+		return nil
+	}
+}
+
+// WithTxsPoolsCleaner sets up a transactions pools cleaner for the Node, used to clean the pools on demand
+func WithTxsPoolsCleaner(txsPoolsCleaner process.TxsPoolsCleaner) Option {
+	return func(n *Node) error {
+		if check.IfNil(txsPoolsCleaner) {
+			return ErrNilTxsPoolsCleaner
+		}
+
+		n.txsPoolsCleaner = txsPoolsCleaner
+
+		return nil
+	}
+}
+
 // WithSignatureSize sets up a signatureSize option for the Node
 func WithSignatureSize(signatureSize int) Option {
 	return func(n *Node) error {
@@ -1261,6 +1261,54 @@ func TestWithWhiteListHandlerVerified_WhiteListHandlerShouldWork(t *testing.T) {
 	assert.True(t, node.whiteListerVerifiedTxs == whiteListHandler)
 }
 
+func TestWithSyncStateHandler_NilSyncStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSyncStateHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilSyncStateHandler, err)
+}
+
+func TestWithSyncStateHandler_SyncStateHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	syncStateHandler := &mock.SyncStateHandlerStub{}
+	opt := WithSyncStateHandler(syncStateHandler)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.syncStateHandler == syncStateHandler)
+}
+
+func TestWithTxsPoolsCleaner_NilTxsPoolsCleanerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxsPoolsCleaner(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilTxsPoolsCleaner, err)
+}
+
+func TestWithTxsPoolsCleaner_TxsPoolsCleanerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	txsPoolsCleaner := &mock.TxsPoolsCleanerStub{}
+	opt := WithTxsPoolsCleaner(txsPoolsCleaner)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.txsPoolsCleaner == txsPoolsCleaner)
+}
+
 func TestWithSignatureSize(t *testing.T) {
 	t.Parallel()
 
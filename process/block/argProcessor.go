@@ -39,6 +39,7 @@ type ArgBaseProcessor struct {
 	BlockChain             data.ChainHandler
 	StateCheckpointModulus uint
 	BlockSizeThrottler     process.BlockSizeThrottler
+	TxLogsProcessor        process.TransactionLogProcessorDatabase
 	Version                string
 }
 
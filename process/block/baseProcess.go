@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"sort"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -51,6 +53,7 @@ type baseProcessor struct {
 	store                   dataRetriever.StorageService
 	uint64Converter         typeConverters.Uint64ByteSliceConverter
 	blockSizeThrottler      process.BlockSizeThrottler
+	txLogsProcessor         process.TransactionLogProcessorDatabase
 	epochStartTrigger       process.EpochStartTriggerHandler
 	headerValidator         process.HeaderConstructionValidator
 	blockChainHook          process.BlockChainHookHandler
@@ -396,6 +399,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.BlockSizeThrottler) {
 		return process.ErrNilBlockSizeThrottler
 	}
+	if check.IfNil(arguments.TxLogsProcessor) {
+		return process.ErrNilTxLogsProcessor
+	}
 	if len(arguments.Version) == 0 {
 		return process.ErrEmptySoftwareVersion
 	}
@@ -403,6 +409,21 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	return nil
 }
 
+// getTxsLogsAndClean returns the logs generated by the provided transactions, keyed by the transactions hashes, and
+// removes all the logs kept in cache, so that they are handed to the indexer only once
+func (bp *baseProcessor) getTxsLogsAndClean(txPool map[string]data.TransactionHandler) map[string]data.LogHandler {
+	logs := make(map[string]data.LogHandler)
+	for txHash := range txPool {
+		txLog, ok := bp.txLogsProcessor.GetLogFromCache([]byte(txHash))
+		if ok {
+			logs[txHash] = txLog
+		}
+	}
+	bp.txLogsProcessor.Clean()
+
+	return logs
+}
+
 func (bp *baseProcessor) createBlockStarted() {
 	bp.hdrsForCurrBlock.resetMissingHdrs()
 	bp.hdrsForCurrBlock.initMaps()
@@ -1058,6 +1079,21 @@ func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 	}
 }
 
+// getBalancesBeforeCommit returns the balances, as of the last commit, of the user accounts changed by the block which
+// is going to be committed. It returns nil if the blocks are not indexed
+func (bp *baseProcessor) getBalancesBeforeCommit(coreServices serviceContainer.Core) map[string]*big.Int {
+	if check.IfNil(coreServices) || check.IfNil(coreServices.Indexer()) || coreServices.Indexer().IsNilIndexer() {
+		return nil
+	}
+
+	accounts, ok := bp.accountsDB[state.UserAccountsState].(balancesBeforeCommitHandler)
+	if !ok {
+		return nil
+	}
+
+	return accounts.GetBalancesBeforeCommit()
+}
+
 func (bp *baseProcessor) commitAll() error {
 	for key := range bp.accountsDB {
 		_, err := bp.accountsDB[key].Commit()
@@ -19,4 +19,8 @@ type ArgInterceptedBlockHeader struct {
 	ValidityAttester        process.ValidityAttester
 	EpochStartTrigger       process.EpochStartTriggerHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	SyncStateHandler        process.SyncStateHandler
+	// SkipHeaderEpochCheck disables the check of the header epoch against the epoch start trigger and should be set
+	// only when the trigger does not hold the epoch of the node, as it happens while bootstrapping from the network
+	SkipHeaderEpochCheck bool
 }
@@ -1,6 +1,8 @@
 package interceptedBlocks
 
 import (
+	"fmt"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -40,6 +42,9 @@ func checkBlockHeaderArgument(arg *ArgInterceptedBlockHeader) error {
 	if check.IfNil(arg.NonceConverter) {
 		return process.ErrNilUint64Converter
 	}
+	if !arg.SkipHeaderEpochCheck && check.IfNil(arg.SyncStateHandler) {
+		return process.ErrNilSyncStateHandler
+	}
 
 	return nil
 }
@@ -87,6 +92,24 @@ func checkHeaderHandler(hdr data.HeaderHandler) error {
 	return nil
 }
 
+// checkHeaderEpoch rejects the headers whose epoch is not the current epoch of the node or one of its neighbours. The
+// next epoch is accepted as the network can start it before the node processes the epoch start block, while the
+// previous epoch is accepted for the blocks produced in the grace period that follows an epoch change. It should be
+// called only for a synced node, as a lagging node receives, while catching up, headers from epochs it did not reach yet
+func checkHeaderEpoch(hdr data.HeaderHandler, epochStartTrigger process.EpochStartTriggerHandler) error {
+	currentEpoch := epochStartTrigger.Epoch()
+	headerEpoch := hdr.GetEpoch()
+
+	isFutureEpoch := headerEpoch > currentEpoch+1
+	isStaleEpoch := headerEpoch+1 < currentEpoch
+	if isFutureEpoch || isStaleEpoch {
+		return fmt.Errorf("%w: header epoch %d is out of range for the current epoch %d",
+			process.ErrEpochDoesNotMatch, headerEpoch, currentEpoch)
+	}
+
+	return nil
+}
+
 func checkMetaShardInfo(shardInfo []block.ShardData, coordinator sharding.Coordinator) error {
 	for _, sd := range shardInfo {
 		if sd.ShardID >= coordinator.NumberOfShards() && sd.ShardID != core.MetachainShardId {
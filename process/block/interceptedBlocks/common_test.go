@@ -1,6 +1,7 @@
 package interceptedBlocks
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -20,6 +21,7 @@ func createDefaultBlockHeaderArgument() *ArgInterceptedBlockHeader {
 		ValidityAttester:        &mock.ValidityAttesterStub{},
 		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
+		SyncStateHandler:        &mock.SyncStateHandlerStub{},
 	}
 
 	return arg
@@ -146,6 +148,17 @@ func TestCheckBlockHeaderArgument_NilNonceConverterShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilUint64Converter, err)
 }
 
+func TestCheckBlockHeaderArgument_NilSyncStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultBlockHeaderArgument()
+	arg.SyncStateHandler = nil
+
+	err := checkBlockHeaderArgument(arg)
+
+	assert.Equal(t, process.ErrNilSyncStateHandler, err)
+}
+
 func TestCheckBlockHeaderArgument_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -310,6 +323,59 @@ func TestCheckHeaderHandler_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+//-------- checkHeaderEpoch
+
+func createEpochStartTriggerStub(epoch uint32) *mock.EpochStartTriggerStub {
+	return &mock.EpochStartTriggerStub{
+		EpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+}
+
+func TestCheckHeaderEpoch_EpochInRangeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(5)
+	for _, epoch := range []uint32{currentEpoch - 1, currentEpoch, currentEpoch + 1} {
+		hdr := createDefaultHeaderHandler()
+		headerEpoch := epoch
+		hdr.GetEpochCalled = func() uint32 {
+			return headerEpoch
+		}
+
+		err := checkHeaderEpoch(hdr, createEpochStartTriggerStub(currentEpoch))
+
+		assert.Nil(t, err)
+	}
+}
+
+func TestCheckHeaderEpoch_FutureEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdr := createDefaultHeaderHandler()
+	hdr.GetEpochCalled = func() uint32 {
+		return 7
+	}
+
+	err := checkHeaderEpoch(hdr, createEpochStartTriggerStub(5))
+
+	assert.True(t, errors.Is(err, process.ErrEpochDoesNotMatch))
+}
+
+func TestCheckHeaderEpoch_TooOldEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdr := createDefaultHeaderHandler()
+	hdr.GetEpochCalled = func() uint32 {
+		return 3
+	}
+
+	err := checkHeaderEpoch(hdr, createEpochStartTriggerStub(5))
+
+	assert.True(t, errors.Is(err, process.ErrEpochDoesNotMatch))
+}
+
 //------- checkMetaShardInfo
 
 func TestCheckMetaShardInfo_WithNilOrEmptyShouldReturnNil(t *testing.T) {
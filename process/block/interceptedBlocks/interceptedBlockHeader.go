@@ -30,6 +30,8 @@ type InterceptedHeader struct {
 	validityAttester  process.ValidityAttester
 	epochStartTrigger process.EpochStartTriggerHandler
 	nonceConverter    typeConverters.Uint64ByteSliceConverter
+	skipEpochCheck    bool
+	syncStateHandler  process.SyncStateHandler
 }
 
 // NewInterceptedHeader creates a new instance of InterceptedHeader struct
@@ -53,6 +55,8 @@ func NewInterceptedHeader(arg *ArgInterceptedBlockHeader) (*InterceptedHeader, e
 		validityAttester:  arg.ValidityAttester,
 		epochStartTrigger: arg.EpochStartTrigger,
 		nonceConverter:    arg.NonceConverter,
+		skipEpochCheck:    arg.SkipHeaderEpochCheck,
+		syncStateHandler:  arg.SyncStateHandler,
 	}
 	inHdr.processFields(arg.HdrBuff)
 
@@ -141,6 +145,13 @@ func (inHdr *InterceptedHeader) integrity() error {
 		return err
 	}
 
+	if inHdr.shouldCheckHeaderEpoch() {
+		err = checkHeaderEpoch(inHdr.HeaderHandler(), inHdr.epochStartTrigger)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = inHdr.validityAttester.CheckBlockAgainstFinal(inHdr.HeaderHandler())
 	if err != nil {
 		return err
@@ -201,3 +212,13 @@ func (inHdr *InterceptedHeader) Identifiers() [][]byte {
 func (inHdr *InterceptedHeader) IsInterfaceNil() bool {
 	return inHdr == nil
 }
+
+// shouldCheckHeaderEpoch returns true if the header epoch should be checked against the current epoch of the node. The
+// check is skipped while the node is syncing so it can catch up with the headers of the epochs it did not reach yet
+func (inHdr *InterceptedHeader) shouldCheckHeaderEpoch() bool {
+	if inHdr.skipEpochCheck {
+		return false
+	}
+
+	return !inHdr.syncStateHandler.IsSyncing()
+}
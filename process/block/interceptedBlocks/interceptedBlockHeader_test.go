@@ -21,6 +21,14 @@ var hdrShardId = uint32(1)
 var hdrRound = uint64(67)
 var hdrEpoch = uint32(78)
 
+func createEpochStartTriggerStub(epoch uint32) *mock.EpochStartTriggerStub {
+	return &mock.EpochStartTriggerStub{
+		EpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+}
+
 func createDefaultShardArgument() *interceptedBlocks.ArgInterceptedBlockHeader {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
 		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
@@ -29,8 +37,9 @@ func createDefaultShardArgument() *interceptedBlocks.ArgInterceptedBlockHeader {
 		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
 		HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 		ValidityAttester:        &mock.ValidityAttesterStub{},
-		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
+		EpochStartTrigger:       createEpochStartTriggerStub(hdrEpoch),
 		NonceConverter:          mock.NewNonceHashConverterMock(),
+		SyncStateHandler:        &mock.SyncStateHandlerStub{},
 	}
 
 	hdr := createMockShardHeader()
@@ -177,7 +186,7 @@ func TestInterceptedHeader_CheckValidityLeaderSignatureNotCorrectShouldErr(t *te
 			return expectedErr
 		},
 	}
-	arg.EpochStartTrigger = &mock.EpochStartTriggerStub{}
+	arg.EpochStartTrigger = createEpochStartTriggerStub(hdrEpoch)
 	arg.HdrBuff = buff
 	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
 
@@ -237,6 +246,48 @@ func TestInterceptedHeader_CheckValidityShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInterceptedHeader_CheckValidityEpochOutOfRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultShardArgument()
+	arg.EpochStartTrigger = createEpochStartTriggerStub(hdrEpoch + 2)
+	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.True(t, errors.Is(err, process.ErrEpochDoesNotMatch))
+}
+
+func TestInterceptedHeader_CheckValidityEpochOutOfRangeWhileSyncingShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultShardArgument()
+	arg.EpochStartTrigger = createEpochStartTriggerStub(hdrEpoch + 2)
+	arg.SyncStateHandler = &mock.SyncStateHandlerStub{
+		IsSyncingCalled: func() bool {
+			return true
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedHeader_CheckValiditySkipHeaderEpochCheckShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultShardArgument()
+	arg.EpochStartTrigger = createEpochStartTriggerStub(0)
+	arg.SkipHeaderEpochCheck = true
+	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
 func TestInterceptedHeader_CheckAgainstRounderErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
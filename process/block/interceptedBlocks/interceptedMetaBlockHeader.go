@@ -26,6 +26,8 @@ type InterceptedMetaHeader struct {
 	validityAttester  process.ValidityAttester
 	epochStartTrigger process.EpochStartTriggerHandler
 	nonceConverter    typeConverters.Uint64ByteSliceConverter
+	skipEpochCheck    bool
+	syncStateHandler  process.SyncStateHandler
 }
 
 // NewInterceptedMetaHeader creates a new instance of InterceptedMetaHeader struct
@@ -49,6 +51,8 @@ func NewInterceptedMetaHeader(arg *ArgInterceptedBlockHeader) (*InterceptedMetaH
 		validityAttester:  arg.ValidityAttester,
 		epochStartTrigger: arg.EpochStartTrigger,
 		nonceConverter:    arg.NonceConverter,
+		skipEpochCheck:    arg.SkipHeaderEpochCheck,
+		syncStateHandler:  arg.SyncStateHandler,
 	}
 	inHdr.processFields(arg.HdrBuff)
 
@@ -118,6 +122,13 @@ func (imh *InterceptedMetaHeader) integrity() error {
 		return err
 	}
 
+	if imh.shouldCheckHeaderEpoch() {
+		err = checkHeaderEpoch(imh.HeaderHandler(), imh.epochStartTrigger)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = checkMetaShardInfo(imh.hdr.ShardInfo, imh.shardCoordinator)
 	if err != nil {
 		return err
@@ -156,3 +167,13 @@ func (imh *InterceptedMetaHeader) Identifiers() [][]byte {
 func (imh *InterceptedMetaHeader) IsInterfaceNil() bool {
 	return imh == nil
 }
+
+// shouldCheckHeaderEpoch returns true if the header epoch should be checked against the current epoch of the node. The
+// check is skipped while the node is syncing so it can catch up with the headers of the epochs it did not reach yet
+func (imh *InterceptedMetaHeader) shouldCheckHeaderEpoch() bool {
+	if imh.skipEpochCheck {
+		return false
+	}
+
+	return !imh.syncStateHandler.IsSyncing()
+}
@@ -21,8 +21,9 @@ func createDefaultMetaArgument() *interceptedBlocks.ArgInterceptedBlockHeader {
 		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
 		HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 		ValidityAttester:        &mock.ValidityAttesterStub{},
-		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
+		EpochStartTrigger:       createEpochStartTriggerStub(hdrEpoch),
 		NonceConverter:          mock.NewNonceHashConverterMock(),
+		SyncStateHandler:        &mock.SyncStateHandlerStub{},
 	}
 
 	hdr := createMockMetaHeader()
@@ -1,9 +1,15 @@
 package block
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
 type blockProcessor interface {
 	removeStartOfEpochBlockDataFromPools(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) error
 }
+
+type balancesBeforeCommitHandler interface {
+	GetBalancesBeforeCommit() map[string]*big.Int
+}
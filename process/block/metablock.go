@@ -105,6 +105,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		blockChain:             arguments.BlockChain,
 		stateCheckpointModulus: arguments.StateCheckpointModulus,
 		genesisNonce:           genesisHdr.GetNonce(),
+		txLogsProcessor:        arguments.TxLogsProcessor,
 		version:                core.TrimSoftwareVersion(arguments.Version),
 	}
 
@@ -506,6 +507,7 @@ func (mp *metaProcessor) indexBlock(
 	lastMetaBlock data.HeaderHandler,
 	notarizedHeadersHashes []string,
 	rewardsTxs map[string]data.TransactionHandler,
+	balancesBeforeCommit map[string]*big.Int,
 ) {
 	if mp.core == nil || mp.core.Indexer() == nil {
 		return
@@ -556,7 +558,12 @@ func (mp *metaProcessor) indexBlock(
 		return
 	}
 
+	logs := mp.getTxsLogsAndClean(txPool)
 	go mp.core.Indexer().SaveBlock(body, metaBlock, txPool, signersIndexes, notarizedHeadersHashes)
+	if len(logs) > 0 {
+		go mp.core.Indexer().SaveLogs(logs)
+	}
+	indexAccountsHistory(mp.core.Indexer(), mp.accountsDB[state.UserAccountsState], metaBlock.GetNonce(), balancesBeforeCommit)
 
 	indexRoundInfo(mp.core.Indexer(), mp.nodesCoordinator, core.MetachainShardId, metaBlock, lastMetaBlock, signersIndexes)
 
@@ -565,6 +572,9 @@ func (mp *metaProcessor) indexBlock(
 	}
 
 	indexValidatorsRating(mp.core.Indexer(), mp.validatorStatisticsProcessor, metaBlock)
+	indexEpochStartEconomics(mp.core.Indexer(), metaBlock)
+	// the finalization information is merged in the epoch info document, so it is indexed after the economics
+	indexEpochStartInfo(mp.core.Indexer(), metaBlock)
 }
 
 // RestoreBlockIntoPools restores the block into associated pools
@@ -616,6 +626,10 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
+	if !check.IfNil(mp.core) && !check.IfNil(mp.core.Indexer()) {
+		mp.core.Indexer().RevertIndexedBlock(headerHandler, bodyHandler)
+	}
+
 	return nil
 }
 
@@ -1031,6 +1045,7 @@ func (mp *metaProcessor) CommitBlock(
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body)
 
+	balancesBeforeCommit := mp.getBalancesBeforeCommit(mp.core)
 	err = mp.commitAll()
 	if err != nil {
 		return err
@@ -1094,7 +1109,7 @@ func (mp *metaProcessor) CommitBlock(
 		mp.core.TPSBenchmark().Update(header)
 	}
 
-	mp.indexBlock(header, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
+	mp.indexBlock(header, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs, balancesBeforeCommit)
 
 	saveMetachainCommitBlockMetrics(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator)
 
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
+	"sort"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -12,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -209,6 +212,10 @@ func indexRoundInfo(
 		BlockWasProposed: true,
 		ShardId:          shardId,
 		Timestamp:        time.Duration(header.GetTimeStamp()),
+		Epoch:            header.GetEpoch(),
+	}
+	if !check.IfNil(lastHeader) {
+		roundInfo.Randomness = lastHeader.GetRandSeed()
 	}
 
 	go indexerHandler.SaveRoundInfo(roundInfo)
@@ -220,6 +227,7 @@ func indexRoundInfo(
 	lastBlockRound := lastHeader.GetRound()
 	currentBlockRound := header.GetRound()
 	roundDuration := calculateRoundDuration(lastHeader.GetTimeStamp(), header.GetTimeStamp(), lastBlockRound, currentBlockRound)
+	missedRoundsInfos := make([]indexer.RoundInfo, 0)
 	for i := lastBlockRound + 1; i < currentBlockRound; i++ {
 		publicKeys, err := nodesCoordinator.GetConsensusValidatorsPublicKeys(lastHeader.GetRandSeed(), i, shardId, lastHeader.GetEpoch())
 		if err != nil {
@@ -237,10 +245,62 @@ func indexRoundInfo(
 			BlockWasProposed: false,
 			ShardId:          shardId,
 			Timestamp:        time.Duration(header.GetTimeStamp() - ((currentBlockRound - i) * roundDuration)),
+			Epoch:            lastHeader.GetEpoch(),
+			Randomness:       lastHeader.GetRandSeed(),
+		}
+
+		missedRoundsInfos = append(missedRoundsInfos, roundInfo)
+	}
+
+	if len(missedRoundsInfos) > 0 {
+		go indexerHandler.SaveRoundsInfo(missedRoundsInfos)
+	}
+}
+
+func indexAccountsHistory(
+	indexerHandler indexer.Indexer,
+	accounts state.AccountsAdapter,
+	blockNonce uint64,
+	balancesBeforeCommit map[string]*big.Int,
+) {
+	if len(balancesBeforeCommit) == 0 {
+		return
+	}
+
+	changes := make([]indexer.AccountBalanceChange, 0, len(balancesBeforeCommit))
+	for address, oldBalance := range balancesBeforeCommit {
+		newBalance := big.NewInt(0)
+		account, err := accounts.GetExistingAccount([]byte(address))
+		if err != nil && err != state.ErrAccNotFound {
+			log.Debug("indexAccountsHistory: GetExistingAccount",
+				"address", []byte(address),
+				"error", err.Error())
+			continue
+		}
+		if err == nil {
+			userAccount, ok := account.(state.UserAccountHandler)
+			if !ok {
+				continue
+			}
+			newBalance = userAccount.GetBalance()
+		}
+
+		if oldBalance.Cmp(newBalance) == 0 {
+			continue
 		}
 
-		go indexerHandler.SaveRoundInfo(roundInfo)
+		changes = append(changes, indexer.AccountBalanceChange{
+			Address:    []byte(address),
+			OldBalance: oldBalance,
+			NewBalance: newBalance,
+		})
 	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Address, changes[j].Address) < 0
+	})
+
+	go indexerHandler.SaveAccountsHistory(blockNonce, changes)
 }
 
 func indexValidatorsRating(
@@ -259,6 +319,7 @@ func indexValidatorsRating(
 		return
 	}
 
+	ratingsHistory := make(map[string]*state.ValidatorApiResponse)
 	for shardID, validatorInfosInShard := range validators {
 		validatorsInfos := make([]indexer.ValidatorRatingInfo, 0)
 		for _, validatorInfo := range validatorInfosInShard {
@@ -267,11 +328,56 @@ func indexValidatorsRating(
 				Rating:    float32(validatorInfo.Rating) * 100 / 10000000,
 			})
 
+			ratingsHistory[string(validatorInfo.PublicKey)] = &state.ValidatorApiResponse{
+				ShardId:             validatorInfo.ShardId,
+				Rating:              float32(validatorInfo.Rating) * 100 / 10000000,
+				TempRating:          float32(validatorInfo.TempRating) * 100 / 10000000,
+				NumLeaderSuccess:    validatorInfo.LeaderSuccess,
+				NumLeaderFailure:    validatorInfo.LeaderFailure,
+				NumValidatorSuccess: validatorInfo.ValidatorSuccess,
+				NumValidatorFailure: validatorInfo.ValidatorFailure,
+			}
 		}
 
 		indexID := fmt.Sprintf("%d_%d", shardID, metaBlock.GetEpoch())
 		indexerHandler.SaveValidatorsRating(indexID, validatorsInfos)
 	}
+
+	indexerHandler.SaveValidatorsRatingHistory(metaBlock.GetEpoch(), ratingsHistory)
+}
+
+func indexEpochStartEconomics(indexerHandler indexer.Indexer, header data.HeaderHandler) {
+	if !header.IsStartOfEpochBlock() {
+		return
+	}
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return
+	}
+
+	economics := metaBlock.EpochStart.Economics
+	indexerHandler.SaveEpochStartEconomics(metaBlock.GetEpoch(), indexer.EpochEconomics{
+		TotalSupply:         economics.TotalSupply,
+		Inflation:           economics.TotalNewlyMinted,
+		RewardsDistributed:  economics.TotalToDistribute,
+		RewardsForCommunity: economics.RewardsForCommunity,
+		AccumulatedFees:     metaBlock.AccumulatedFeesInEpoch,
+		NodePrice:           economics.NodePrice,
+	})
+}
+
+func indexEpochStartInfo(indexerHandler indexer.Indexer, header data.HeaderHandler) {
+	if !header.IsStartOfEpochBlock() {
+		return
+	}
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return
+	}
+
+	indexerHandler.SaveEpochStartInfo(metaBlock)
 }
 
 func calculateRoundDuration(
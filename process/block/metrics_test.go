@@ -1,12 +1,17 @@
 package block
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMetrics_CalculateRoundDuration(t *testing.T) {
@@ -90,3 +95,123 @@ func TestMetrics_IncrementCountAcceptedBlocks_ShouldWork(t *testing.T) {
 	incrementCountAcceptedBlocks(nodesCoord, statusHandler, &block.Header{PubKeysBitmap: []byte{2, 0}})
 	assert.True(t, incrementWasCalled)
 }
+
+func TestMetrics_IndexEpochStartEconomicsNotStartOfEpochShouldNotIndex(t *testing.T) {
+	t.Parallel()
+
+	indexerMock := &mock.IndexerMock{
+		SaveEpochStartEconomicsCalled: func(_ uint32, _ indexer.EpochEconomics) {
+			assert.Fail(t, "should have not indexed the epoch economics")
+		},
+	}
+
+	indexEpochStartEconomics(indexerMock, &block.MetaBlock{Epoch: 2})
+}
+
+func TestMetrics_IndexEpochStartEconomicsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metaBlock := &block.MetaBlock{
+		Epoch: 2,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{}},
+			Economics: block.Economics{
+				TotalSupply:         big.NewInt(1000),
+				TotalToDistribute:   big.NewInt(30),
+				TotalNewlyMinted:    big.NewInt(20),
+				RewardsForCommunity: big.NewInt(5),
+				NodePrice:           big.NewInt(100),
+			},
+		},
+		AccumulatedFeesInEpoch: big.NewInt(10),
+	}
+
+	var savedEpoch uint32
+	var savedEconomics indexer.EpochEconomics
+	indexerMock := &mock.IndexerMock{
+		SaveEpochStartEconomicsCalled: func(epoch uint32, econ indexer.EpochEconomics) {
+			savedEpoch = epoch
+			savedEconomics = econ
+		},
+	}
+
+	indexEpochStartEconomics(indexerMock, metaBlock)
+
+	assert.Equal(t, metaBlock.Epoch, savedEpoch)
+	assert.Equal(t, big.NewInt(1000), savedEconomics.TotalSupply)
+	assert.Equal(t, big.NewInt(20), savedEconomics.Inflation)
+	assert.Equal(t, big.NewInt(30), savedEconomics.RewardsDistributed)
+	assert.Equal(t, big.NewInt(5), savedEconomics.RewardsForCommunity)
+	assert.Equal(t, big.NewInt(10), savedEconomics.AccumulatedFees)
+	assert.Equal(t, big.NewInt(100), savedEconomics.NodePrice)
+}
+
+func TestMetrics_IndexAccountsHistoryShouldIndexTheChangedBalances(t *testing.T) {
+	t.Parallel()
+
+	increasedAddress := []byte("increased")
+	decreasedAddress := []byte("decreased")
+	unchangedAddress := []byte("unchanged")
+	removedAddress := []byte("removed")
+	newBalances := map[string]*big.Int{
+		string(increasedAddress): big.NewInt(150),
+		string(decreasedAddress): big.NewInt(20),
+		string(unchangedAddress): big.NewInt(10),
+	}
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			balance, ok := newBalances[string(address)]
+			if !ok {
+				return nil, state.ErrAccNotFound
+			}
+
+			account, _ := state.NewUserAccount(address)
+			_ = account.AddToBalance(balance)
+
+			return account, nil
+		},
+	}
+	balancesBeforeCommit := map[string]*big.Int{
+		string(increasedAddress): big.NewInt(100),
+		string(decreasedAddress): big.NewInt(50),
+		string(unchangedAddress): big.NewInt(10),
+		string(removedAddress):   big.NewInt(5),
+	}
+
+	blockNonce := uint64(37)
+	chSavedChanges := make(chan []indexer.AccountBalanceChange, 1)
+	indexerMock := &mock.IndexerMock{
+		SaveAccountsHistoryCalled: func(nonce uint64, changes []indexer.AccountBalanceChange) {
+			assert.Equal(t, blockNonce, nonce)
+			chSavedChanges <- changes
+		},
+	}
+
+	indexAccountsHistory(indexerMock, accounts, blockNonce, balancesBeforeCommit)
+
+	var changes []indexer.AccountBalanceChange
+	select {
+	case changes = <-chSavedChanges:
+	case <-time.After(time.Second):
+		require.Fail(t, "the accounts history should have been indexed")
+	}
+
+	expectedChanges := []indexer.AccountBalanceChange{
+		{Address: decreasedAddress, OldBalance: big.NewInt(50), NewBalance: big.NewInt(20)},
+		{Address: increasedAddress, OldBalance: big.NewInt(100), NewBalance: big.NewInt(150)},
+		{Address: removedAddress, OldBalance: big.NewInt(5), NewBalance: big.NewInt(0)},
+	}
+	assert.Equal(t, expectedChanges, changes)
+}
+
+func TestMetrics_IndexAccountsHistoryNoChangedAccountsShouldNotIndex(t *testing.T) {
+	t.Parallel()
+
+	indexerMock := &mock.IndexerMock{
+		SaveAccountsHistoryCalled: func(_ uint64, _ []indexer.AccountBalanceChange) {
+			assert.Fail(t, "should have not indexed the accounts history")
+		},
+	}
+
+	indexAccountsHistory(indexerMock, &mock.AccountsStub{}, 37, nil)
+}
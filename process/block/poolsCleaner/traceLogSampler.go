@@ -0,0 +1,70 @@
+package poolsCleaner
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// traceLogSampler limits the number of trace log lines emitted each second. The lines exceeding the limit are dropped
+// and a summary containing the number of dropped lines is emitted at the beginning of the next second
+type traceLogSampler struct {
+	log              logger.Logger
+	maxLogsPerSecond uint32
+	getTimeHandler   func() time.Time
+
+	mutSampler      sync.Mutex
+	currentSecond   int64
+	numLogsInSecond uint32
+	numDropped      uint64
+}
+
+// newTraceLogSampler creates a new trace log sampler. A zero maxLogsPerSecond value disables the rate limiting
+func newTraceLogSampler(log logger.Logger, maxLogsPerSecond uint32) *traceLogSampler {
+	return &traceLogSampler{
+		log:              log,
+		maxLogsPerSecond: maxLogsPerSecond,
+		getTimeHandler:   time.Now,
+	}
+}
+
+// Trace will emit the provided trace log line if the rate limit for the current second has not been reached
+func (tls *traceLogSampler) Trace(message string, args ...interface{}) {
+	if tls.log.GetLevel() > logger.LogTrace {
+		return
+	}
+	if tls.maxLogsPerSecond == 0 {
+		tls.log.Trace(message, args...)
+		return
+	}
+
+	tls.mutSampler.Lock()
+	defer tls.mutSampler.Unlock()
+
+	currentSecond := tls.getTimeHandler().Unix()
+	if currentSecond != tls.currentSecond {
+		tls.emitDroppedSummary()
+		tls.currentSecond = currentSecond
+		tls.numLogsInSecond = 0
+	}
+
+	if tls.numLogsInSecond >= tls.maxLogsPerSecond {
+		tls.numDropped++
+		return
+	}
+
+	tls.numLogsInSecond++
+	tls.log.Trace(message, args...)
+}
+
+func (tls *traceLogSampler) emitDroppedSummary() {
+	if tls.numDropped == 0 {
+		return
+	}
+
+	tls.log.Trace("trace log lines dropped by sampler",
+		"num dropped", tls.numDropped,
+		"max logs per second", tls.maxLogsPerSecond)
+	tls.numDropped = 0
+}
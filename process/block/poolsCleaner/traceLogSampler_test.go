@@ -0,0 +1,71 @@
+package poolsCleaner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLoggerStubWithLevel(level logger.LogLevel, messages *[]string) *mock.LoggerStub {
+	return &mock.LoggerStub{
+		GetLevelCalled: func() logger.LogLevel {
+			return level
+		},
+		LogCalled: func(_ string, message string, _ ...interface{}) {
+			*messages = append(*messages, message)
+		},
+	}
+}
+
+func TestTraceLogSampler_TraceLevelNotEnabledShouldNotLog(t *testing.T) {
+	t.Parallel()
+
+	messages := make([]string, 0)
+	tls := newTraceLogSampler(createLoggerStubWithLevel(logger.LogDebug, &messages), 2)
+
+	tls.Trace("message")
+	assert.Equal(t, 0, len(messages))
+}
+
+func TestTraceLogSampler_ZeroMaxLogsPerSecondShouldNotLimit(t *testing.T) {
+	t.Parallel()
+
+	messages := make([]string, 0)
+	tls := newTraceLogSampler(createLoggerStubWithLevel(logger.LogTrace, &messages), 0)
+
+	numLogs := 10
+	for i := 0; i < numLogs; i++ {
+		tls.Trace("message")
+	}
+	assert.Equal(t, numLogs, len(messages))
+}
+
+func TestTraceLogSampler_BeyondRateShouldEmitOnlySummary(t *testing.T) {
+	t.Parallel()
+
+	messages := make([]string, 0)
+	maxLogsPerSecond := uint32(3)
+	tls := newTraceLogSampler(createLoggerStubWithLevel(logger.LogTrace, &messages), maxLogsPerSecond)
+	currentTime := time.Unix(100, 0)
+	tls.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	numDropped := 5
+	for i := 0; i < int(maxLogsPerSecond)+numDropped; i++ {
+		tls.Trace("message")
+	}
+	assert.Equal(t, int(maxLogsPerSecond), len(messages))
+	assert.Equal(t, uint64(numDropped), tls.numDropped)
+
+	currentTime = currentTime.Add(time.Second)
+	tls.Trace("new message")
+
+	assert.Equal(t, int(maxLogsPerSecond)+2, len(messages))
+	assert.Equal(t, "trace log lines dropped by sampler", messages[maxLogsPerSecond])
+	assert.Equal(t, "new message", messages[maxLogsPerSecond+1])
+	assert.Equal(t, uint64(0), tls.numDropped)
+}
@@ -3,16 +3,19 @@ package poolsCleaner
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/close"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
@@ -22,33 +25,91 @@ var _ close.Closer = (*txsPoolsCleaner)(nil)
 // sleepTime defines the time between each iteration made in clean...Pools methods
 const sleepTime = time.Minute
 
+// the names of the pools registered by default in the txs pools cleaner
 const (
-	blockTx = iota
-	rewardTx
-	unsignedTx
+	blockTx    = "blockTx"
+	rewardTx   = "rewardTx"
+	unsignedTx = "unsignedTx"
 )
 
+// RoundsToKeepUnprocessed holds, for each transaction type, the maximum number of rounds for which an unprocessed
+// transaction is kept in pool. A 0 value means process.MaxRoundsToKeepUnprocessedTransactions will be used
+type RoundsToKeepUnprocessed struct {
+	BlockTxs    int64
+	RewardTxs   int64
+	UnsignedTxs int64
+}
+
+// TxPoolType holds what the txs pools cleaner needs in order to track and clean the transactions of a pool
+type TxPoolType struct {
+	Name string
+	Pool dataRetriever.ShardedDataCacherNotifier
+	// RoundsToKeepUnprocessed is the maximum number of rounds for which an unprocessed transaction is kept in pool.
+	// A 0 value means process.MaxRoundsToKeepUnprocessedTransactions will be used
+	RoundsToKeepUnprocessed int64
+	// ComputeShards returns the sender and the receiver shards of a transaction received in pool, or false if the
+	// transaction can not be tracked
+	ComputeShards func(value interface{}) (senderShardID uint32, receiverShardID uint32, ok bool)
+}
+
+// txPoolIterator is implemented by the pools which can list the transactions they hold, so that the transactions
+// already in pool when the cleaner registers, as the ones loaded from a pool snapshot, are tracked and cleaned as well
+type txPoolIterator interface {
+	ForEachTransaction(function func(key []byte, value interface{}))
+}
+
 type txInfo struct {
 	round           int64
 	senderShardID   uint32
 	receiverShardID uint32
-	txType          int8
+	poolType        *TxPoolType
 	txStore         storage.Cacher
 }
 
 // txsPoolsCleaner represents a pools cleaner that checks and cleans txs which should not be in pool anymore
 type txsPoolsCleaner struct {
-	addressPubkeyConverter   core.PubkeyConverter
-	blockTransactionsPool    dataRetriever.ShardedDataCacherNotifier
-	rewardTransactionsPool   dataRetriever.ShardedDataCacherNotifier
-	unsignedTransactionsPool dataRetriever.ShardedDataCacherNotifier
-	rounder                  process.Rounder
-	shardCoordinator         sharding.Coordinator
+	addressPubkeyConverter core.PubkeyConverter
+	rounder                process.Rounder
+	shardCoordinator       sharding.Coordinator
+	appStatusHandler       core.AppStatusHandler
+	// txPoolTypes is filled at construction and is only read afterwards
+	txPoolTypes map[string]*TxPoolType
 
 	mutMapTxsRounds sync.RWMutex
 	mapTxsRounds    map[string]*txInfo
 	emptyAddress    []byte
 	cancelFunc      func()
+	traceLog        *traceLogSampler
+
+	mutWhitelist   sync.RWMutex
+	whitelistedTxs map[string]struct{}
+
+	numWrongTypeAssertionsBlockTx    atomic.Counter
+	numWrongTypeAssertionsUnsignedTx atomic.Counter
+}
+
+// TxsPoolsCleanerStats holds the counters exposed by the txs pools cleaner
+type TxsPoolsCleanerStats struct {
+	NumWrongTypeAssertionsBlockTx    uint64
+	NumWrongTypeAssertionsUnsignedTx uint64
+}
+
+// ArgTxsPoolsCleaner is the argument DTO used to create a txs pools cleaner from already resolved pools
+type ArgTxsPoolsCleaner struct {
+	AddressPubkeyConverter   core.PubkeyConverter
+	BlockTransactionsPool    dataRetriever.ShardedDataCacherNotifier
+	RewardTransactionsPool   dataRetriever.ShardedDataCacherNotifier
+	UnsignedTransactionsPool dataRetriever.ShardedDataCacherNotifier
+	Rounder                  process.Rounder
+	ShardCoordinator         sharding.Coordinator
+	MaxTraceLogsPerSecond    uint32
+	RoundsToKeepUnprocessed  RoundsToKeepUnprocessed
+	AutoStartCleaning        bool
+	AppStatusHandler         core.AppStatusHandler
+	// WhitelistedTxHashes holds the hashes of the transactions which are never removed from pools by the cleaner
+	WhitelistedTxHashes [][]byte
+	// AdditionalTxPoolTypes holds the pools to be cleaned besides the block, reward and unsigned transactions pools
+	AdditionalTxPoolTypes []TxPoolType
 }
 
 // NewTxsPoolsCleaner will return a new txs pools cleaner
@@ -57,6 +118,8 @@ func NewTxsPoolsCleaner(
 	dataPool dataRetriever.PoolsHolder,
 	rounder process.Rounder,
 	shardCoordinator sharding.Coordinator,
+	maxTraceLogsPerSecond uint32,
+	roundsToKeepUnprocessed RoundsToKeepUnprocessed,
 ) (*txsPoolsCleaner, error) {
 
 	if check.IfNil(addressPubkeyConverter) {
@@ -65,42 +128,136 @@ func NewTxsPoolsCleaner(
 	if check.IfNil(dataPool) {
 		return nil, process.ErrNilPoolsHolder
 	}
-	if check.IfNil(dataPool.Transactions()) {
+
+	return NewTxsPoolsCleanerWithArgs(ArgTxsPoolsCleaner{
+		AddressPubkeyConverter:   addressPubkeyConverter,
+		BlockTransactionsPool:    dataPool.Transactions(),
+		RewardTransactionsPool:   dataPool.RewardTransactions(),
+		UnsignedTransactionsPool: dataPool.UnsignedTransactions(),
+		Rounder:                  rounder,
+		ShardCoordinator:         shardCoordinator,
+		MaxTraceLogsPerSecond:    maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed:  roundsToKeepUnprocessed,
+		AutoStartCleaning:        false,
+		AppStatusHandler:         statusHandler.NewNilStatusHandler(),
+	})
+}
+
+// NewTxsPoolsCleanerWithArgs will return a new txs pools cleaner built on the provided pools. The background
+// cleaning go routine is started only if AutoStartCleaning is set
+func NewTxsPoolsCleanerWithArgs(args ArgTxsPoolsCleaner) (*txsPoolsCleaner, error) {
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.BlockTransactionsPool) {
 		return nil, process.ErrNilTransactionPool
 	}
-	if check.IfNil(dataPool.RewardTransactions()) {
+	if check.IfNil(args.RewardTransactionsPool) {
 		return nil, process.ErrNilRewardTxDataPool
 	}
-	if check.IfNil(dataPool.UnsignedTransactions()) {
+	if check.IfNil(args.UnsignedTransactionsPool) {
 		return nil, process.ErrNilUnsignedTxDataPool
 	}
-	if check.IfNil(rounder) {
+	if check.IfNil(args.Rounder) {
 		return nil, process.ErrNilRounder
 	}
-	if check.IfNil(shardCoordinator) {
+	if check.IfNil(args.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
 
 	tpc := txsPoolsCleaner{
-		addressPubkeyConverter:   addressPubkeyConverter,
-		blockTransactionsPool:    dataPool.Transactions(),
-		rewardTransactionsPool:   dataPool.RewardTransactions(),
-		unsignedTransactionsPool: dataPool.UnsignedTransactions(),
-		rounder:                  rounder,
-		shardCoordinator:         shardCoordinator,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		rounder:                args.Rounder,
+		shardCoordinator:       args.ShardCoordinator,
+		appStatusHandler:       args.AppStatusHandler,
+		traceLog:               newTraceLogSampler(log, args.MaxTraceLogsPerSecond),
+		txPoolTypes:            make(map[string]*TxPoolType),
 	}
 
 	tpc.mapTxsRounds = make(map[string]*txInfo)
+	tpc.whitelistedTxs = make(map[string]struct{})
+	tpc.WhitelistTxs(args.WhitelistedTxHashes)
+	tpc.emptyAddress = make([]byte, tpc.addressPubkeyConverter.Len())
 
-	tpc.blockTransactionsPool.RegisterHandler(tpc.receivedBlockTx)
-	tpc.rewardTransactionsPool.RegisterHandler(tpc.receivedRewardTx)
-	tpc.unsignedTransactionsPool.RegisterHandler(tpc.receivedUnsignedTx)
+	txPoolTypes := []TxPoolType{
+		{
+			Name:                    blockTx,
+			Pool:                    args.BlockTransactionsPool,
+			RoundsToKeepUnprocessed: args.RoundsToKeepUnprocessed.BlockTxs,
+			ComputeShards:           tpc.computeBlockTxShards,
+		},
+		{
+			Name:                    rewardTx,
+			Pool:                    args.RewardTransactionsPool,
+			RoundsToKeepUnprocessed: args.RoundsToKeepUnprocessed.RewardTxs,
+			ComputeShards:           tpc.computeRewardTxShards,
+		},
+		{
+			Name:                    unsignedTx,
+			Pool:                    args.UnsignedTransactionsPool,
+			RoundsToKeepUnprocessed: args.RoundsToKeepUnprocessed.UnsignedTxs,
+			ComputeShards:           tpc.computeUnsignedTxShards,
+		},
+	}
+	txPoolTypes = append(txPoolTypes, args.AdditionalTxPoolTypes...)
+	for _, txPoolType := range txPoolTypes {
+		err := tpc.registerTxPoolType(txPoolType)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	tpc.emptyAddress = make([]byte, tpc.addressPubkeyConverter.Len())
+	if args.AutoStartCleaning {
+		tpc.StartCleaning()
+	}
 
 	return &tpc, nil
 }
 
+// registerTxPoolType should be called only at construction, before the cleaning go routine is started
+func (tpc *txsPoolsCleaner) registerTxPoolType(txPoolType TxPoolType) error {
+	if len(txPoolType.Name) == 0 {
+		return fmt.Errorf("%w: empty pool type name", process.ErrInvalidTxPoolType)
+	}
+	if check.IfNil(txPoolType.Pool) {
+		return fmt.Errorf("%w: nil pool for %s", process.ErrInvalidTxPoolType, txPoolType.Name)
+	}
+	if txPoolType.ComputeShards == nil {
+		return fmt.Errorf("%w: nil shards computing function for %s", process.ErrInvalidTxPoolType, txPoolType.Name)
+	}
+	if _, exists := tpc.txPoolTypes[txPoolType.Name]; exists {
+		return fmt.Errorf("%w: %s is already registered", process.ErrInvalidTxPoolType, txPoolType.Name)
+	}
+
+	txPoolType.RoundsToKeepUnprocessed = roundsToKeepOrDefault(txPoolType.RoundsToKeepUnprocessed)
+	tpc.txPoolTypes[txPoolType.Name] = &txPoolType
+
+	poolName := txPoolType.Name
+	txPoolType.Pool.RegisterHandler(func(key []byte, value interface{}) {
+		tpc.receivedTx(poolName, key, value)
+	})
+
+	iterablePool, ok := txPoolType.Pool.(txPoolIterator)
+	if ok {
+		iterablePool.ForEachTransaction(func(key []byte, value interface{}) {
+			tpc.receivedTx(poolName, key, value)
+		})
+	}
+
+	return nil
+}
+
+func roundsToKeepOrDefault(rounds int64) int64 {
+	if rounds <= 0 {
+		return process.MaxRoundsToKeepUnprocessedTransactions
+	}
+
+	return rounds
+}
+
 // StartCleaning actually starts the pools cleaning mechanism
 func (tpc *txsPoolsCleaner) StartCleaning() {
 	var ctx context.Context
@@ -122,73 +279,72 @@ func (tpc *txsPoolsCleaner) cleanTxsPools(ctx context.Context) {
 	}
 }
 
-func (tpc *txsPoolsCleaner) receivedBlockTx(key []byte, value interface{}) {
+func (tpc *txsPoolsCleaner) receivedTx(poolName string, key []byte, value interface{}) {
 	if key == nil {
 		return
 	}
 
-	log.Trace("txsPoolsCleaner.receivedBlockTx", "hash", key)
+	tpc.traceLog.Trace("txsPoolsCleaner.receivedTx", "type", poolName, "hash", key)
 
-	wrappedTx, ok := value.(*txcache.WrappedTransaction)
+	txPoolType, ok := tpc.txPoolTypes[poolName]
 	if !ok {
-		log.Warn("txsPoolsCleaner.receivedBlockTx", "error", process.ErrWrongTypeAssertion)
 		return
 	}
 
-	tpc.processReceivedTx(key, wrappedTx.SenderShardID, wrappedTx.ReceiverShardID, blockTx)
-}
-
-func (tpc *txsPoolsCleaner) receivedRewardTx(key []byte, _ interface{}) {
-	if key == nil {
+	senderShardID, receiverShardID, ok := txPoolType.ComputeShards(value)
+	if !ok {
 		return
 	}
 
-	log.Trace("txsPoolsCleaner.receivedRewardTx", "hash", key)
-
-	senderShardID := core.MetachainShardId
-	receiverShardID := tpc.shardCoordinator.SelfId()
-	tpc.processReceivedTx(key, senderShardID, receiverShardID, rewardTx)
+	tpc.processReceivedTx(key, senderShardID, receiverShardID, txPoolType)
 }
 
-func (tpc *txsPoolsCleaner) receivedUnsignedTx(key []byte, value interface{}) {
-	if key == nil {
-		return
+func (tpc *txsPoolsCleaner) computeBlockTxShards(value interface{}) (uint32, uint32, bool) {
+	wrappedTx, ok := value.(*txcache.WrappedTransaction)
+	if !ok {
+		tpc.numWrongTypeAssertionsBlockTx.Increment()
+		tpc.publishStatsMetrics()
+		log.Warn("txsPoolsCleaner.computeBlockTxShards", "error", process.ErrWrongTypeAssertion)
+		return 0, 0, false
 	}
 
-	log.Trace("txsPoolsCleaner.receivedUnsignedTx", "hash", key)
+	return wrappedTx.SenderShardID, wrappedTx.ReceiverShardID, true
+}
+
+func (tpc *txsPoolsCleaner) computeRewardTxShards(_ interface{}) (uint32, uint32, bool) {
+	return core.MetachainShardId, tpc.shardCoordinator.SelfId(), true
+}
 
+func (tpc *txsPoolsCleaner) computeUnsignedTxShards(value interface{}) (uint32, uint32, bool) {
 	tx, ok := value.(data.TransactionHandler)
 	if !ok {
-		log.Warn("txsPoolsCleaner.receivedUnsignedTx", "error", process.ErrWrongTypeAssertion)
-		return
+		tpc.numWrongTypeAssertionsUnsignedTx.Increment()
+		tpc.publishStatsMetrics()
+		log.Warn("txsPoolsCleaner.computeUnsignedTxShards", "error", process.ErrWrongTypeAssertion)
+		return 0, 0, false
 	}
 
 	senderShardID, receiverShardID, err := tpc.computeSenderAndReceiverShards(tx)
 	if err != nil {
-		log.Debug("txsPoolsCleaner.receivedUnsignedTx", "error", err.Error())
-		return
+		log.Debug("txsPoolsCleaner.computeUnsignedTxShards", "error", err.Error())
+		return 0, 0, false
 	}
 
-	tpc.processReceivedTx(key, senderShardID, receiverShardID, unsignedTx)
+	return senderShardID, receiverShardID, true
 }
 
 func (tpc *txsPoolsCleaner) processReceivedTx(
 	key []byte,
 	senderShardID uint32,
 	receiverShardID uint32,
-	txType int8,
+	txPoolType *TxPoolType,
 ) {
 	tpc.mutMapTxsRounds.Lock()
 	defer tpc.mutMapTxsRounds.Unlock()
 
 	if _, ok := tpc.mapTxsRounds[string(key)]; !ok {
-		transactionPool := tpc.getTransactionPool(txType)
-		if transactionPool == nil {
-			return
-		}
-
 		strCache := process.ShardCacherIdentifier(senderShardID, receiverShardID)
-		txStore := transactionPool.ShardDataStore(strCache)
+		txStore := txPoolType.Pool.ShardDataStore(strCache)
 		if txStore == nil {
 			return
 		}
@@ -197,18 +353,18 @@ func (tpc *txsPoolsCleaner) processReceivedTx(
 			round:           tpc.rounder.Index(),
 			senderShardID:   senderShardID,
 			receiverShardID: receiverShardID,
-			txType:          txType,
+			poolType:        txPoolType,
 			txStore:         txStore,
 		}
 
 		tpc.mapTxsRounds[string(key)] = currTxInfo
 
-		log.Trace("transaction has been added",
+		tpc.traceLog.Trace("transaction has been added",
 			"hash", key,
 			"round", currTxInfo.round,
 			"sender", currTxInfo.senderShardID,
 			"receiver", currTxInfo.receiverShardID,
-			"type", getTxTypeName(currTxInfo.txType))
+			"type", currTxInfo.poolType.Name)
 	}
 }
 
@@ -216,29 +372,64 @@ func (tpc *txsPoolsCleaner) cleanTxsPoolsIfNeeded() int {
 	tpc.mutMapTxsRounds.Lock()
 	defer tpc.mutMapTxsRounds.Unlock()
 
+	numTxsCleaned := tpc.removeStaleTxs()
+	if numTxsCleaned > 0 {
+		log.Debug("txsPoolsCleaner.cleanTxsPoolsIfNeeded", "num txs cleaned", numTxsCleaned)
+	}
+	tpc.publishTrackedTxsMetrics()
+
+	return len(tpc.mapTxsRounds)
+}
+
+// ForceClean removes right away the transactions kept in pools for more rounds than allowed, without waiting for the
+// next iteration of the cleaning go routine, and returns the number of removed transactions
+func (tpc *txsPoolsCleaner) ForceClean() int {
+	tpc.mutMapTxsRounds.Lock()
+	defer tpc.mutMapTxsRounds.Unlock()
+
+	numTxsCleaned := tpc.removeStaleTxs()
+	tpc.publishTrackedTxsMetrics()
+	log.Info("txsPoolsCleaner.ForceClean",
+		"num txs cleaned", numTxsCleaned,
+		"num txs in map", len(tpc.mapTxsRounds))
+
+	return numTxsCleaned
+}
+
+// removeStaleTxs should be called under mutMapTxsRounds mutex protection
+func (tpc *txsPoolsCleaner) removeStaleTxs() int {
 	numTxsCleaned := 0
 
 	for hash, currTxInfo := range tpc.mapTxsRounds {
 		_, ok := currTxInfo.txStore.Get([]byte(hash))
 		if !ok {
-			log.Trace("transaction not found in pool",
+			tpc.traceLog.Trace("transaction not found in pool",
 				"hash", []byte(hash),
 				"round", currTxInfo.round,
 				"sender", currTxInfo.senderShardID,
 				"receiver", currTxInfo.receiverShardID,
-				"type", getTxTypeName(currTxInfo.txType))
+				"type", currTxInfo.poolType.Name)
 			delete(tpc.mapTxsRounds, hash)
 			continue
 		}
 
+		if tpc.isWhitelisted(hash) {
+			tpc.traceLog.Trace("cleaning whitelisted transaction not allowed",
+				"hash", []byte(hash),
+				"round", currTxInfo.round,
+				"type", currTxInfo.poolType.Name)
+
+			continue
+		}
+
 		roundDif := tpc.rounder.Index() - currTxInfo.round
-		if roundDif <= process.MaxRoundsToKeepUnprocessedTransactions {
-			log.Trace("cleaning transaction not yet allowed",
+		if roundDif <= currTxInfo.poolType.RoundsToKeepUnprocessed {
+			tpc.traceLog.Trace("cleaning transaction not yet allowed",
 				"hash", []byte(hash),
 				"round", currTxInfo.round,
 				"sender", currTxInfo.senderShardID,
 				"receiver", currTxInfo.receiverShardID,
-				"type", getTxTypeName(currTxInfo.txType),
+				"type", currTxInfo.poolType.Name,
 				"round dif", roundDif)
 
 			continue
@@ -248,45 +439,56 @@ func (tpc *txsPoolsCleaner) cleanTxsPoolsIfNeeded() int {
 		delete(tpc.mapTxsRounds, hash)
 		numTxsCleaned++
 
-		log.Trace("transaction has been cleaned",
+		tpc.traceLog.Trace("transaction has been cleaned",
 			"hash", []byte(hash),
 			"round", currTxInfo.round,
 			"sender", currTxInfo.senderShardID,
 			"receiver", currTxInfo.receiverShardID,
-			"type", getTxTypeName(currTxInfo.txType))
+			"type", currTxInfo.poolType.Name)
 	}
 
-	if numTxsCleaned > 0 {
-		log.Debug("txsPoolsCleaner.cleanTxsPoolsIfNeeded", "num txs cleaned", numTxsCleaned)
-	}
+	return numTxsCleaned
+}
 
-	return len(tpc.mapTxsRounds)
+// WhitelistTxs adds the provided transaction hashes to the whitelist, so that the transactions will be kept in pools
+// regardless of their age. A whitelisted transaction which leaves the pool is still untracked
+func (tpc *txsPoolsCleaner) WhitelistTxs(hashes [][]byte) {
+	tpc.mutWhitelist.Lock()
+	for _, hash := range hashes {
+		tpc.whitelistedTxs[string(hash)] = struct{}{}
+	}
+	tpc.mutWhitelist.Unlock()
 }
 
-func (tpc *txsPoolsCleaner) getTransactionPool(txType int8) dataRetriever.ShardedDataCacherNotifier {
-	switch txType {
-	case blockTx:
-		return tpc.blockTransactionsPool
-	case rewardTx:
-		return tpc.rewardTransactionsPool
-	case unsignedTx:
-		return tpc.unsignedTransactionsPool
+// UnwhitelistTxs removes the provided transaction hashes from the whitelist, so that the transactions will be
+// cleaned once they are kept in pools for more rounds than allowed
+func (tpc *txsPoolsCleaner) UnwhitelistTxs(hashes [][]byte) {
+	tpc.mutWhitelist.Lock()
+	for _, hash := range hashes {
+		delete(tpc.whitelistedTxs, string(hash))
 	}
+	tpc.mutWhitelist.Unlock()
+}
 
-	return nil
+func (tpc *txsPoolsCleaner) isWhitelisted(hash string) bool {
+	tpc.mutWhitelist.RLock()
+	_, ok := tpc.whitelistedTxs[hash]
+	tpc.mutWhitelist.RUnlock()
+
+	return ok
 }
 
-func getTxTypeName(txType int8) string {
-	switch txType {
-	case blockTx:
-		return "blockTx"
-	case rewardTx:
-		return "rewardTx"
-	case unsignedTx:
-		return "unsignedTx"
+// publishTrackedTxsMetrics should be called under mutMapTxsRounds mutex protection
+func (tpc *txsPoolsCleaner) publishTrackedTxsMetrics() {
+	numTxsPerType := make(map[string]uint64)
+	for _, currTxInfo := range tpc.mapTxsRounds {
+		numTxsPerType[currTxInfo.poolType.Name]++
 	}
 
-	return "unknownTx"
+	tpc.appStatusHandler.SetUInt64Value(core.MetricTxPoolTrackedCount, uint64(len(tpc.mapTxsRounds)))
+	tpc.appStatusHandler.SetUInt64Value(core.MetricTxPoolTrackedBlockTxs, numTxsPerType[blockTx])
+	tpc.appStatusHandler.SetUInt64Value(core.MetricTxPoolTrackedRewardTxs, numTxsPerType[rewardTx])
+	tpc.appStatusHandler.SetUInt64Value(core.MetricTxPoolTrackedUnsignedTxs, numTxsPerType[unsignedTx])
 }
 
 func (tpc *txsPoolsCleaner) computeSenderAndReceiverShards(tx data.TransactionHandler) (uint32, uint32, error) {
@@ -312,6 +514,20 @@ func (tpc *txsPoolsCleaner) getShardFromAddress(address []byte) (uint32, error)
 	return tpc.shardCoordinator.ComputeId(address), nil
 }
 
+// GetStats returns the current statistics of the txs pools cleaner
+func (tpc *txsPoolsCleaner) GetStats() TxsPoolsCleanerStats {
+	return TxsPoolsCleanerStats{
+		NumWrongTypeAssertionsBlockTx:    tpc.numWrongTypeAssertionsBlockTx.GetUint64(),
+		NumWrongTypeAssertionsUnsignedTx: tpc.numWrongTypeAssertionsUnsignedTx.GetUint64(),
+	}
+}
+
+func (tpc *txsPoolsCleaner) publishStatsMetrics() {
+	stats := tpc.GetStats()
+	tpc.appStatusHandler.SetUInt64Value(core.MetricTxPoolWrongTypeAssertionsBlockTxs, stats.NumWrongTypeAssertionsBlockTx)
+	tpc.appStatusHandler.SetUInt64Value(core.MetricTxPoolWrongTypeAssertionsUnsignedTxs, stats.NumWrongTypeAssertionsUnsignedTx)
+}
+
 // Close will close the endless running go routine
 func (tpc *txsPoolsCleaner) Close() error {
 	if tpc.cancelFunc != nil {
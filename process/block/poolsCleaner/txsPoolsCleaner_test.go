@@ -1,22 +1,35 @@
 package poolsCleaner
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/close"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/assert"
 )
 
+const maxTraceLogsPerSecond = 100
+
 func TestNewTxsPoolsCleaner_NilAddrConverterErr(t *testing.T) {
 	t.Parallel()
 
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		nil, &mock.PoolsHolderMock{}, &mock.RounderMock{}, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
@@ -27,6 +40,8 @@ func TestNewTxsPoolsCleaner_NilDataPoolHolderErr(t *testing.T) {
 
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, nil, &mock.RounderMock{}, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilPoolsHolder, err)
@@ -42,6 +57,8 @@ func TestNewTxsPoolsCleaner_NilTxsPoolErr(t *testing.T) {
 	}
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, dataPool, &mock.RounderMock{}, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilTransactionPool, err)
@@ -60,6 +77,8 @@ func TestNewTxsPoolsCleaner_NilRewardTxsPoolErr(t *testing.T) {
 	}
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, dataPool, &mock.RounderMock{}, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilRewardTxDataPool, err)
@@ -81,6 +100,8 @@ func TestNewTxsPoolsCleaner_NilUnsignedTxsPoolErr(t *testing.T) {
 	}
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, dataPool, &mock.RounderMock{}, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilUnsignedTxDataPool, err)
@@ -102,6 +123,8 @@ func TestNewTxsPoolsCleaner_NilRounderErr(t *testing.T) {
 	}
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, dataPool, nil, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilRounder, err)
@@ -123,6 +146,8 @@ func TestNewTxsPoolsCleaner_NilShardCoordinatorErr(t *testing.T) {
 	}
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, dataPool, &mock.RounderMock{}, nil,
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -145,6 +170,8 @@ func TestNewTxsPoolsCleaner_ShouldWork(t *testing.T) {
 
 	txsPoolsCleaner, err := NewTxsPoolsCleaner(
 		&mock.PubkeyConverterStub{}, dataPool, &mock.RounderMock{}, mock.NewMultipleShardsCoordinatorMock(),
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 	assert.Nil(t, err)
 	assert.NotNil(t, txsPoolsCleaner)
@@ -169,6 +196,8 @@ func TestGetShardFromAddress(t *testing.T) {
 				return expectedShard
 			},
 		},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	emptyAddr := make([]byte, addrLen)
@@ -197,6 +226,8 @@ func TestReceivedBlockTx_ShouldBeAddedInMapTxsRounds(t *testing.T) {
 		},
 		&mock.RounderMock{},
 		&mock.CoordinatorStub{},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	txWrap := &txcache.WrappedTransaction{
@@ -204,7 +235,7 @@ func TestReceivedBlockTx_ShouldBeAddedInMapTxsRounds(t *testing.T) {
 		SenderShardID: 2,
 	}
 	txBlockKey := []byte("key")
-	txsPoolsCleaner.receivedBlockTx(txBlockKey, txWrap)
+	txsPoolsCleaner.receivedTx(blockTx, txBlockKey, txWrap)
 	assert.NotNil(t, txsPoolsCleaner.mapTxsRounds[string(txBlockKey)])
 }
 
@@ -224,10 +255,12 @@ func TestReceivedRewardTx_ShouldBeAddedInMapTxsRounds(t *testing.T) {
 		},
 		&mock.RounderMock{},
 		&mock.CoordinatorStub{},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	txKey := []byte("key")
-	txsPoolsCleaner.receivedRewardTx(txKey, nil)
+	txsPoolsCleaner.receivedTx(rewardTx, txKey, nil)
 	assert.NotNil(t, txsPoolsCleaner.mapTxsRounds[string(txKey)])
 }
 
@@ -252,13 +285,15 @@ func TestReceivedUnsignedTx_ShouldBeAddedInMapTxsRounds(t *testing.T) {
 				return 2
 			},
 		},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	txKey := []byte("key")
 	tx := &transaction.Transaction{
 		SndAddr: sndAddr,
 	}
-	txsPoolsCleaner.receivedUnsignedTx(txKey, tx)
+	txsPoolsCleaner.receivedTx(unsignedTx, txKey, tx)
 	assert.NotNil(t, txsPoolsCleaner.mapTxsRounds[string(txKey)])
 }
 
@@ -283,13 +318,15 @@ func TestCleanTxsPoolsIfNeeded_CannotFindTxInPoolShouldBeRemovedFromMap(t *testi
 				return 2
 			},
 		},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	txKey := []byte("key")
 	tx := &transaction.Transaction{
 		SndAddr: sndAddr,
 	}
-	txsPoolsCleaner.receivedUnsignedTx(txKey, tx)
+	txsPoolsCleaner.receivedTx(unsignedTx, txKey, tx)
 
 	numTxsInMap := txsPoolsCleaner.cleanTxsPoolsIfNeeded()
 	assert.Equal(t, 0, numTxsInMap)
@@ -320,13 +357,15 @@ func TestCleanTxsPoolsIfNeeded_RoundDiffTooSmallShouldNotBeRemoved(t *testing.T)
 				return 2
 			},
 		},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	txKey := []byte("key")
 	tx := &transaction.Transaction{
 		SndAddr: sndAddr,
 	}
-	txsPoolsCleaner.receivedUnsignedTx(txKey, tx)
+	txsPoolsCleaner.receivedTx(unsignedTx, txKey, tx)
 
 	numTxsInMap := txsPoolsCleaner.cleanTxsPoolsIfNeeded()
 	assert.Equal(t, 1, numTxsInMap)
@@ -364,13 +403,15 @@ func TestCleanTxsPoolsIfNeeded_RoundDiffTooBigShouldBeRemoved(t *testing.T) {
 				return 2
 			},
 		},
+		maxTraceLogsPerSecond,
+		RoundsToKeepUnprocessed{},
 	)
 
 	txKey := []byte("key")
 	tx := &transaction.Transaction{
 		SndAddr: sndAddr,
 	}
-	txsPoolsCleaner.receivedUnsignedTx(txKey, tx)
+	txsPoolsCleaner.receivedTx(unsignedTx, txKey, tx)
 
 	rounder.IndexCalled = func() int64 {
 		return process.MaxRoundsToKeepUnprocessedTransactions + 1
@@ -380,3 +421,489 @@ func TestCleanTxsPoolsIfNeeded_RoundDiffTooBigShouldBeRemoved(t *testing.T) {
 	assert.Nil(t, txsPoolsCleaner.mapTxsRounds[string(txKey)])
 	assert.True(t, called)
 }
+
+func TestTxsPoolsCleaner_ForceCleanShouldRemoveOnlyTheStaleTxs(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	txsInPool := make(map[string]struct{})
+	pool := &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					_, ok = txsInPool[string(key)]
+					return nil, ok
+				},
+				RemoveCalled: func(key []byte) {
+					delete(txsInPool, string(key))
+				},
+			}
+		},
+	}
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = &mock.RoundStub{IndexCalled: func() int64 {
+		return currentRound
+	}}
+	args.BlockTransactionsPool = pool
+	args.RoundsToKeepUnprocessed = RoundsToKeepUnprocessed{
+		BlockTxs: 5,
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	staleTxKeys := []string{"stale tx 1", "stale tx 2", "stale tx 3"}
+	for _, key := range staleTxKeys {
+		txsInPool[key] = struct{}{}
+		txsPoolsCleaner.receivedTx(blockTx, []byte(key), &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+	}
+	// already removed from pool, should not be counted as cleaned
+	txsPoolsCleaner.receivedTx(blockTx, []byte("processed tx"), &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+
+	currentRound = 4
+	freshTxKey := "fresh tx"
+	txsInPool[freshTxKey] = struct{}{}
+	txsPoolsCleaner.receivedTx(blockTx, []byte(freshTxKey), &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+
+	currentRound = 6
+	numTxsCleaned := txsPoolsCleaner.ForceClean()
+
+	assert.Equal(t, len(staleTxKeys), numTxsCleaned)
+	assert.Equal(t, map[string]struct{}{freshTxKey: {}}, txsInPool)
+	assert.Equal(t, 1, len(txsPoolsCleaner.mapTxsRounds))
+	assert.Equal(t, 0, txsPoolsCleaner.ForceClean())
+}
+
+func createMockArgTxsPoolsCleaner() ArgTxsPoolsCleaner {
+	return ArgTxsPoolsCleaner{
+		AddressPubkeyConverter:   &mock.PubkeyConverterStub{},
+		BlockTransactionsPool:    &mock.ShardedDataStub{},
+		RewardTransactionsPool:   &mock.ShardedDataStub{},
+		UnsignedTransactionsPool: &mock.ShardedDataStub{},
+		Rounder:                  &mock.RounderMock{},
+		ShardCoordinator:         mock.NewMultipleShardsCoordinatorMock(),
+		MaxTraceLogsPerSecond:    maxTraceLogsPerSecond,
+		AutoStartCleaning:        false,
+		AppStatusHandler:         statusHandler.NewNilStatusHandler(),
+	}
+}
+
+func TestNewTxsPoolsCleanerWithArgs_NilTxsPoolErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxsPoolsCleaner()
+	args.BlockTransactionsPool = nil
+	txsPoolsCleaner, err := NewTxsPoolsCleanerWithArgs(args)
+
+	assert.Nil(t, txsPoolsCleaner)
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+}
+
+func TestNewTxsPoolsCleanerWithArgs_NilRounderErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = nil
+	txsPoolsCleaner, err := NewTxsPoolsCleanerWithArgs(args)
+
+	assert.Nil(t, txsPoolsCleaner)
+	assert.Equal(t, process.ErrNilRounder, err)
+}
+
+func TestNewTxsPoolsCleanerWithArgs_NilAppStatusHandlerErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxsPoolsCleaner()
+	args.AppStatusHandler = nil
+	txsPoolsCleaner, err := NewTxsPoolsCleanerWithArgs(args)
+
+	assert.Nil(t, txsPoolsCleaner)
+	assert.Equal(t, process.ErrNilAppStatusHandler, err)
+}
+
+func TestNewTxsPoolsCleanerWithArgs_AutoStartCleaningShouldStartGoRoutine(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxsPoolsCleaner()
+	args.AutoStartCleaning = true
+	txsPoolsCleaner, err := NewTxsPoolsCleanerWithArgs(args)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, txsPoolsCleaner.cancelFunc)
+	assert.Nil(t, txsPoolsCleaner.Close())
+}
+
+func TestNewTxsPoolsCleanerWithArgs_CleanWithoutGoRoutineShouldWork(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	rounder := &mock.RoundStub{IndexCalled: func() int64 {
+		return currentRound
+	}}
+	numRemoveCalls := 0
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = rounder
+	args.BlockTransactionsPool = &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					return nil, true
+				},
+				RemoveCalled: func(key []byte) {
+					numRemoveCalls++
+				},
+			}
+		},
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+	assert.Nil(t, txsPoolsCleaner.cancelFunc)
+
+	txBlockKey := []byte("key")
+	txsPoolsCleaner.receivedTx(blockTx, txBlockKey, &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+
+	numTxsInMap := txsPoolsCleaner.cleanTxsPoolsIfNeeded()
+	assert.Equal(t, 1, numTxsInMap)
+	assert.Equal(t, 0, numRemoveCalls)
+
+	currentRound = process.MaxRoundsToKeepUnprocessedTransactions + 1
+	numTxsInMap = txsPoolsCleaner.cleanTxsPoolsIfNeeded()
+	assert.Equal(t, 0, numTxsInMap)
+	assert.Equal(t, 1, numRemoveCalls)
+}
+
+func TestNewTxsPoolsCleanerWithArgs_TxsRestoredFromSnapshotShouldBeCleaned(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := lrucache.NewCache(10)
+	snapshotStorer, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+	argsTxPool := txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		MinGasPrice:    200000000000,
+		NumberOfShards: 1,
+		SelfShardID:    0,
+		SnapshotConfig: config.TxPoolSnapshotConfig{
+			Enabled:           true,
+			IntervalInSeconds: 3600,
+			MaxAgeInSeconds:   600,
+			MaxNumTxs:         100,
+		},
+		SnapshotStorer: snapshotStorer,
+		Marshalizer:    &mock.MarshalizerMock{},
+	}
+	txHash := []byte("tx hash")
+	previousPool, _ := txpool.NewShardedTxPool(argsTxPool)
+	previousPool.AddData(txHash, &transaction.Transaction{Nonce: 1, SndAddr: []byte("sender")}, 0, "0")
+	// closing the pool saves the snapshot which is loaded by the pool created afterwards
+	_ = previousPool.(close.Closer).Close()
+
+	restoredPool, _ := txpool.NewShardedTxPool(argsTxPool)
+	defer func() {
+		_ = restoredPool.(close.Closer).Close()
+	}()
+	_, ok := restoredPool.SearchFirstData(txHash)
+	assert.True(t, ok)
+
+	currentRound := int64(0)
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = &mock.RoundStub{IndexCalled: func() int64 {
+		return currentRound
+	}}
+	args.BlockTransactionsPool = restoredPool
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	numTxsInMap := txsPoolsCleaner.cleanTxsPoolsIfNeeded()
+	assert.Equal(t, 1, numTxsInMap)
+
+	currentRound = process.MaxRoundsToKeepUnprocessedTransactions + 1
+	numTxsInMap = txsPoolsCleaner.cleanTxsPoolsIfNeeded()
+	assert.Equal(t, 0, numTxsInMap)
+	_, ok = restoredPool.SearchFirstData(txHash)
+	assert.False(t, ok)
+}
+
+func TestReceivedBlockTx_WrongTypeShouldIncrementCounter(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	args := createMockArgTxsPoolsCleaner()
+	args.AppStatusHandler = &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	txBlockKey := []byte("key")
+	txsPoolsCleaner.receivedTx(blockTx, txBlockKey, "wrong type")
+
+	assert.Nil(t, txsPoolsCleaner.mapTxsRounds[string(txBlockKey)])
+	assert.Equal(t, uint64(1), txsPoolsCleaner.GetStats().NumWrongTypeAssertionsBlockTx)
+	assert.Equal(t, uint64(0), txsPoolsCleaner.GetStats().NumWrongTypeAssertionsUnsignedTx)
+	assert.Equal(t, uint64(1), metrics[core.MetricTxPoolWrongTypeAssertionsBlockTxs])
+	assert.Equal(t, uint64(0), metrics[core.MetricTxPoolWrongTypeAssertionsUnsignedTxs])
+}
+
+func TestReceivedUnsignedTx_WrongTypeShouldIncrementCounter(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	args := createMockArgTxsPoolsCleaner()
+	args.AppStatusHandler = &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	txKey := []byte("key")
+	txsPoolsCleaner.receivedTx(unsignedTx, txKey, &txcache.WrappedTransaction{})
+	txsPoolsCleaner.receivedTx(unsignedTx, txKey, nil)
+
+	assert.Nil(t, txsPoolsCleaner.mapTxsRounds[string(txKey)])
+	assert.Equal(t, uint64(0), txsPoolsCleaner.GetStats().NumWrongTypeAssertionsBlockTx)
+	assert.Equal(t, uint64(2), txsPoolsCleaner.GetStats().NumWrongTypeAssertionsUnsignedTx)
+	assert.Equal(t, uint64(0), metrics[core.MetricTxPoolWrongTypeAssertionsBlockTxs])
+	assert.Equal(t, uint64(2), metrics[core.MetricTxPoolWrongTypeAssertionsUnsignedTxs])
+}
+
+func TestCleanTxsPoolsIfNeeded_PerTypeRoundsToKeepShouldCleanEachTypeOnItsSchedule(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	removedKeys := make(map[string]struct{})
+	pool := &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					return nil, true
+				},
+				RemoveCalled: func(key []byte) {
+					removedKeys[string(key)] = struct{}{}
+				},
+			}
+		},
+	}
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = &mock.RoundStub{IndexCalled: func() int64 {
+		return currentRound
+	}}
+	args.BlockTransactionsPool = pool
+	args.RewardTransactionsPool = pool
+	args.UnsignedTransactionsPool = pool
+	args.RoundsToKeepUnprocessed = RoundsToKeepUnprocessed{
+		BlockTxs:  5,
+		RewardTxs: 10,
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	blockTxKey := []byte("block tx")
+	rewardTxKey := []byte("reward tx")
+	unsignedTxKey := []byte("unsigned tx")
+	txsPoolsCleaner.receivedTx(blockTx, blockTxKey, &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+	txsPoolsCleaner.receivedTx(rewardTx, rewardTxKey, nil)
+	txsPoolsCleaner.receivedTx(unsignedTx, unsignedTxKey, &transaction.Transaction{SndAddr: []byte("sndAddr")})
+
+	currentRound = 6
+	assert.Equal(t, 2, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+	assert.Contains(t, removedKeys, string(blockTxKey))
+
+	currentRound = 11
+	assert.Equal(t, 1, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+	assert.Contains(t, removedKeys, string(rewardTxKey))
+
+	currentRound = process.MaxRoundsToKeepUnprocessedTransactions
+	assert.Equal(t, 1, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+
+	currentRound = process.MaxRoundsToKeepUnprocessedTransactions + 1
+	assert.Equal(t, 0, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+	assert.Contains(t, removedKeys, string(unsignedTxKey))
+}
+
+func TestNewTxsPoolsCleanerWithArgs_InvalidAdditionalTxPoolTypeErr(t *testing.T) {
+	t.Parallel()
+
+	computeShards := func(_ interface{}) (uint32, uint32, bool) {
+		return 0, 0, true
+	}
+	invalidTxPoolTypes := []TxPoolType{
+		{Name: "", Pool: &mock.ShardedDataStub{}, ComputeShards: computeShards},
+		{Name: "newTx", Pool: nil, ComputeShards: computeShards},
+		{Name: "newTx", Pool: &mock.ShardedDataStub{}, ComputeShards: nil},
+		{Name: blockTx, Pool: &mock.ShardedDataStub{}, ComputeShards: computeShards},
+	}
+
+	for _, txPoolType := range invalidTxPoolTypes {
+		args := createMockArgTxsPoolsCleaner()
+		args.AdditionalTxPoolTypes = []TxPoolType{txPoolType}
+		txsPoolsCleaner, err := NewTxsPoolsCleanerWithArgs(args)
+
+		assert.Nil(t, txsPoolsCleaner)
+		assert.True(t, errors.Is(err, process.ErrInvalidTxPoolType))
+	}
+}
+
+func TestCleanTxsPoolsIfNeeded_AdditionalTxPoolTypeShouldBeTrackedAndCleaned(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	var receivedTxHandler func(key []byte, value interface{})
+	removedKeys := make(map[string]struct{})
+	newTxsPool := &mock.ShardedDataStub{
+		RegisterHandlerCalled: func(handler func(key []byte, value interface{})) {
+			receivedTxHandler = handler
+		},
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					return nil, true
+				},
+				RemoveCalled: func(key []byte) {
+					removedKeys[string(key)] = struct{}{}
+				},
+			}
+		},
+	}
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = &mock.RoundStub{IndexCalled: func() int64 {
+		return currentRound
+	}}
+	args.AdditionalTxPoolTypes = []TxPoolType{
+		{
+			Name:                    "newTx",
+			Pool:                    newTxsPool,
+			RoundsToKeepUnprocessed: 3,
+			ComputeShards: func(_ interface{}) (uint32, uint32, bool) {
+				return 0, 1, true
+			},
+		},
+	}
+	txsPoolsCleaner, err := NewTxsPoolsCleanerWithArgs(args)
+	assert.Nil(t, err)
+	assert.NotNil(t, receivedTxHandler)
+
+	newTxKey := []byte("new tx")
+	receivedTxHandler(newTxKey, &transaction.Transaction{})
+
+	currentTxInfo := txsPoolsCleaner.mapTxsRounds[string(newTxKey)]
+	assert.NotNil(t, currentTxInfo)
+	assert.Equal(t, "newTx", currentTxInfo.poolType.Name)
+	assert.Equal(t, uint32(0), currentTxInfo.senderShardID)
+	assert.Equal(t, uint32(1), currentTxInfo.receiverShardID)
+
+	currentRound = 3
+	assert.Equal(t, 1, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+
+	currentRound = 4
+	assert.Equal(t, 0, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+	assert.Contains(t, removedKeys, string(newTxKey))
+}
+
+func TestCleanTxsPoolsIfNeeded_ShouldPublishTheTrackedTxsMetrics(t *testing.T) {
+	t.Parallel()
+
+	pool := &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					return nil, true
+				},
+			}
+		},
+	}
+	metrics := make(map[string]uint64)
+	args := createMockArgTxsPoolsCleaner()
+	args.BlockTransactionsPool = pool
+	args.RewardTransactionsPool = pool
+	args.UnsignedTransactionsPool = pool
+	args.AppStatusHandler = &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	txsPoolsCleaner.receivedTx(blockTx, []byte("block tx 1"), &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+	txsPoolsCleaner.receivedTx(blockTx, []byte("block tx 2"), &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+	txsPoolsCleaner.receivedTx(rewardTx, []byte("reward tx"), nil)
+	assert.Equal(t, 0, len(metrics))
+
+	numTxsInMap := txsPoolsCleaner.cleanTxsPoolsIfNeeded()
+
+	assert.Equal(t, 3, numTxsInMap)
+	assert.Equal(t, uint64(3), metrics[core.MetricTxPoolTrackedCount])
+	assert.Equal(t, uint64(2), metrics[core.MetricTxPoolTrackedBlockTxs])
+	assert.Equal(t, uint64(1), metrics[core.MetricTxPoolTrackedRewardTxs])
+	assert.Equal(t, uint64(0), metrics[core.MetricTxPoolTrackedUnsignedTxs])
+}
+
+func TestCleanTxsPoolsIfNeeded_WhitelistedTxShouldNotBeCleaned(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	rounder := &mock.RoundStub{IndexCalled: func() int64 {
+		return currentRound
+	}}
+	whitelistedKey := []byte("whitelisted")
+	otherKey := []byte("other")
+	removedKeys := make(map[string]struct{})
+	args := createMockArgTxsPoolsCleaner()
+	args.Rounder = rounder
+	args.WhitelistedTxHashes = [][]byte{whitelistedKey}
+	args.BlockTransactionsPool = &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					_, isRemoved := removedKeys[string(key)]
+					return nil, !isRemoved
+				},
+				RemoveCalled: func(key []byte) {
+					removedKeys[string(key)] = struct{}{}
+				},
+			}
+		},
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	txsPoolsCleaner.receivedTx(blockTx, whitelistedKey, &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+	txsPoolsCleaner.receivedTx(blockTx, otherKey, &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+
+	currentRound = process.MaxRoundsToKeepUnprocessedTransactions * 10
+	numTxsInMap := txsPoolsCleaner.cleanTxsPoolsIfNeeded()
+	assert.Equal(t, 1, numTxsInMap)
+	assert.Equal(t, 0, txsPoolsCleaner.ForceClean())
+	assert.Contains(t, removedKeys, string(otherKey))
+	assert.NotContains(t, removedKeys, string(whitelistedKey))
+
+	txsPoolsCleaner.UnwhitelistTxs([][]byte{whitelistedKey})
+	assert.Equal(t, 1, txsPoolsCleaner.ForceClean())
+	assert.Contains(t, removedKeys, string(whitelistedKey))
+}
+
+func TestCleanTxsPoolsIfNeeded_WhitelistedTxLeavingThePoolShouldBeUntracked(t *testing.T) {
+	t.Parallel()
+
+	isInPool := true
+	args := createMockArgTxsPoolsCleaner()
+	args.BlockTransactionsPool = &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				GetCalled: func(key []byte) (value interface{}, ok bool) {
+					return nil, isInPool
+				},
+			}
+		},
+	}
+	txsPoolsCleaner, _ := NewTxsPoolsCleanerWithArgs(args)
+
+	whitelistedKey := []byte("whitelisted")
+	txsPoolsCleaner.WhitelistTxs([][]byte{whitelistedKey})
+	txsPoolsCleaner.receivedTx(blockTx, whitelistedKey, &txcache.WrappedTransaction{Tx: &transaction.Transaction{}})
+	assert.Equal(t, 1, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+
+	isInPool = false
+	assert.Equal(t, 0, txsPoolsCleaner.cleanTxsPoolsIfNeeded())
+}
@@ -76,6 +76,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		blockChain:             arguments.BlockChain,
 		feeHandler:             arguments.FeeHandler,
 		genesisNonce:           genesisHdr.GetNonce(),
+		txLogsProcessor:        arguments.TxLogsProcessor,
 		version:                core.TrimSoftwareVersion(arguments.Version),
 	}
 
@@ -501,6 +502,7 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 	body data.BodyHandler,
 	header data.HeaderHandler,
 	lastBlockHeader data.HeaderHandler,
+	balancesBeforeCommit map[string]*big.Int,
 ) {
 	if check.IfNil(sp.core) || check.IfNil(sp.core.Indexer()) {
 		return
@@ -575,7 +577,12 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 		return
 	}
 
+	logs := sp.getTxsLogsAndClean(txPool)
 	go sp.core.Indexer().SaveBlock(body, header, txPool, signersIndexes, nil)
+	if len(logs) > 0 {
+		go sp.core.Indexer().SaveLogs(logs)
+	}
+	indexAccountsHistory(sp.core.Indexer(), sp.accountsDB[state.UserAccountsState], header.GetNonce(), balancesBeforeCommit)
 
 	indexRoundInfo(sp.core.Indexer(), sp.nodesCoordinator, shardId, header, lastBlockHeader, signersIndexes)
 }
@@ -601,6 +608,10 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 
 	sp.blockTracker.RemoveLastNotarizedHeaders()
 
+	if !check.IfNil(sp.core) && !check.IfNil(sp.core.Indexer()) {
+		sp.core.Indexer().RevertIndexedBlock(header, bodyHandler)
+	}
+
 	return nil
 }
 
@@ -804,6 +815,7 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	balancesBeforeCommit := sp.getBalancesBeforeCommit(sp.core)
 	err = sp.commitAll()
 	if err != nil {
 		return err
@@ -849,7 +861,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
-	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader, balancesBeforeCommit)
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
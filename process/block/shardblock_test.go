@@ -297,6 +297,17 @@ func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilTxLogsProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxLogsProcessor = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilTxLogsProcessor, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1868,13 +1879,25 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	mbHdrs = append(mbHdrs, mbHdr)
 	hdr.MiniBlockHeaders = mbHdrs
 
+	changedAddress := []byte("changed address")
+	committed := false
 	accounts := &mock.AccountsStub{
 		CommitCalled: func() (i []byte, e error) {
+			committed = true
 			return rootHash, nil
 		},
 		RootHashCalled: func() ([]byte, error) {
 			return rootHash, nil
 		},
+		GetBalancesBeforeCommitCalled: func() map[string]*big.Int {
+			assert.False(t, committed)
+			return map[string]*big.Int{string(changedAddress): big.NewInt(10)}
+		},
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			_ = account.AddToBalance(big.NewInt(25))
+			return account, nil
+		},
 	}
 	fd := &mock.ForkDetectorMock{
 		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
@@ -1894,6 +1917,8 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	store := initStore()
 
 	var saveBlockCalled map[string]data.TransactionHandler
+	var saveLogsCalled map[string]data.LogHandler
+	var saveAccountsHistoryCalled []indexer.AccountBalanceChange
 	saveBlockCalledMutex := sync.Mutex{}
 
 	arguments := CreateMockArgumentsMultiShard()
@@ -1905,7 +1930,34 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 					saveBlockCalled = txPool
 					saveBlockCalledMutex.Unlock()
 				},
+				SaveLogsCalled: func(logs map[string]data.LogHandler) {
+					saveBlockCalledMutex.Lock()
+					saveLogsCalled = logs
+					saveBlockCalledMutex.Unlock()
+				},
+				SaveAccountsHistoryCalled: func(blockNonce uint64, changes []indexer.AccountBalanceChange) {
+					saveBlockCalledMutex.Lock()
+					saveAccountsHistoryCalled = changes
+					saveBlockCalledMutex.Unlock()
+				},
+				IsNilIndexerCalled: func() bool {
+					return false
+				},
+			}
+		},
+	}
+	txLog := &transaction.Log{Address: []byte("address")}
+	cleanCalled := false
+	arguments.TxLogsProcessor = &mock.TxLogsProcessorStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			if string(txHash) == "tx_1" {
+				return txLog, true
 			}
+
+			return nil, false
+		},
+		CleanCalled: func() {
+			cleanCalled = true
 		},
 	}
 	arguments.DataPool = tdp
@@ -1956,9 +2008,17 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 
 	saveBlockCalledMutex.Lock()
 	wasCalled := saveBlockCalled
+	savedLogs := saveLogsCalled
+	savedAccountsHistory := saveAccountsHistoryCalled
 	saveBlockCalledMutex.Unlock()
 
 	assert.Equal(t, 4, len(wasCalled))
+	assert.Equal(t, map[string]data.LogHandler{"tx_1": txLog}, savedLogs)
+	assert.True(t, cleanCalled)
+	expectedAccountsHistory := []indexer.AccountBalanceChange{
+		{Address: changedAddress, OldBalance: big.NewInt(10), NewBalance: big.NewInt(25)},
+	}
+	assert.Equal(t, expectedAccountsHistory, savedAccountsHistory)
 }
 
 func TestShardProcessor_CreateTxBlockBodyWithDirtyAccStateShouldReturnEmptyBody(t *testing.T) {
@@ -3017,6 +3077,16 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	arguments.Hasher = hasherMock
 	arguments.Marshalizer = marshalizerMock
 	arguments.TxCoordinator = tc
+	revertIndexedBlockCalled := false
+	arguments.Core = &mock.ServiceContainerMock{
+		IndexerCalled: func() indexer.Indexer {
+			return &mock.IndexerMock{
+				RevertIndexedBlockCalled: func(header data.HeaderHandler, body data.BodyHandler) {
+					revertIndexedBlockCalled = true
+				},
+			}
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	txHashes := make([][]byte, 0)
@@ -3062,6 +3132,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, &miniblock, miniblockFromPool)
 	assert.Equal(t, tx, txFromPool)
+	assert.True(t, revertIndexedBlockCalled)
 }
 
 func TestShardProcessor_DecodeBlockBody(t *testing.T) {
@@ -116,6 +116,9 @@ var ErrAccountStateDirty = errors.New("accountState was dirty before starting to
 // ErrInvalidShardId signals that the shard id is invalid
 var ErrInvalidShardId = errors.New("invalid shard id")
 
+// ErrInterceptedDataNotForCurrentShard signals that the intercepted data is not meant for the current shard
+var ErrInterceptedDataNotForCurrentShard = errors.New("intercepted data not for current shard")
+
 // ErrMissingHeader signals that header of the block is missing
 var ErrMissingHeader = errors.New("missing header")
 
@@ -152,6 +155,9 @@ var ErrNilHeadersDataPool = errors.New("nil headers data pool")
 // ErrNilCacher signals that a nil cache has been provided
 var ErrNilCacher = errors.New("nil cacher")
 
+// ErrNilSCQueryService signals that a nil SC query service has been provided
+var ErrNilSCQueryService = errors.New("nil SC query service")
+
 // ErrNilRcvAddr signals that an operation has been attempted to or with a nil receiver address
 var ErrNilRcvAddr = errors.New("nil receiver address")
 
@@ -239,6 +245,9 @@ var ErrInvalidContainerKey = errors.New("element does not exist in container")
 // ErrContainerKeyAlreadyExists signals that an element was already set in the container's map
 var ErrContainerKeyAlreadyExists = errors.New("provided key already exists in container")
 
+// ErrDuplicateTopic signals that an interceptor was already registered on the provided topic
+var ErrDuplicateTopic = errors.New("duplicate topic")
+
 // ErrNilRequestHandler signals that a nil request handler interface was provided
 var ErrNilRequestHandler = errors.New("nil request handler")
 
@@ -569,6 +578,9 @@ var ErrInvalidValue = errors.New("invalid value provided")
 // ErrNilQuotaStatusHandler signals that a nil quota status handler has been provided
 var ErrNilQuotaStatusHandler = errors.New("nil quota status handler")
 
+// ErrNilRepeatedOverQuotaHandler signals that a nil repeated over quota handler has been provided
+var ErrNilRepeatedOverQuotaHandler = errors.New("nil repeated over quota handler")
+
 // ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
 var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
 
@@ -790,3 +802,48 @@ var ErrOriginatorIsBlacklisted = errors.New("originator is blacklisted")
 
 // ErrShardIsStuck signals that a shard is stuck
 var ErrShardIsStuck = errors.New("shard is stuck")
+
+// ErrNilHeartbeatProcessor signals that a nil heartbeat processor has been provided
+var ErrNilHeartbeatProcessor = errors.New("nil heartbeat processor")
+
+// ErrInvalidHeartbeat signals that an invalid heartbeat message has been received
+var ErrInvalidHeartbeat = errors.New("invalid heartbeat")
+
+// ErrNilHeartbeatValidator signals that a nil heartbeat validator has been provided
+var ErrNilHeartbeatValidator = errors.New("nil heartbeat validator")
+
+// ErrHeartbeatProcessorNotSet signals that a heartbeat message was received before the heartbeat processor was set
+var ErrHeartbeatProcessorNotSet = errors.New("heartbeat processor not set")
+
+// ErrInvalidMaxTxNonceDeltaAllowed signals that an invalid max tx nonce delta allowed value has been provided
+var ErrInvalidMaxTxNonceDeltaAllowed = errors.New("invalid max tx nonce delta allowed")
+
+// ErrFunctionNotFound signals that the called smart contract function was not found
+var ErrFunctionNotFound = errors.New("function not found")
+
+// ErrAccountNotContract signals that the called account is not a valid smart contract
+var ErrAccountNotContract = errors.New("account is not a smart contract")
+
+// ErrExecutionFailed signals that the smart contract execution failed
+var ErrExecutionFailed = errors.New("smart contract execution failed")
+
+// ErrNilSyncStateHandler signals that a nil sync state handler has been provided
+var ErrNilSyncStateHandler = errors.New("nil sync state handler")
+
+// ErrValidatorsForEpochNotAvailable signals that the validators set of the requested epoch can not be provided
+var ErrValidatorsForEpochNotAvailable = errors.New("validators for epoch not available")
+
+// ErrNilInterceptorMetricsSink signals that a nil interceptor metrics sink has been provided
+var ErrNilInterceptorMetricsSink = errors.New("nil interceptor metrics sink")
+
+// ErrNilInterceptor signals that a nil interceptor has been provided
+var ErrNilInterceptor = errors.New("nil interceptor")
+
+// ErrNilRateLimiter signals that a nil rate limiter has been provided
+var ErrNilRateLimiter = errors.New("nil rate limiter")
+
+// ErrSCQueryRateLimited signals that the smart contract query was rejected because the allowed rate was exceeded
+var ErrSCQueryRateLimited = errors.New("smart contract query rate limited")
+
+// ErrInvalidTxPoolType signals that an invalid transactions pool type has been provided to the txs pools cleaner
+var ErrInvalidTxPoolType = errors.New("invalid tx pool type")
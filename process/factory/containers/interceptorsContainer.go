@@ -39,7 +39,7 @@ func (ic *interceptorsContainer) Get(key string) (process.Interceptor, error) {
 }
 
 // Add will add an object at a given key. Returns
-// an error if the element already exists
+// an error naming the topic if the element already exists
 func (ic *interceptorsContainer) Add(key string, interceptor process.Interceptor) error {
 	if check.IfNil(interceptor) {
 		return process.ErrNilContainerElement
@@ -47,19 +47,21 @@ func (ic *interceptorsContainer) Add(key string, interceptor process.Interceptor
 
 	ok := ic.objects.Insert(key, interceptor)
 	if !ok {
-		return process.ErrContainerKeyAlreadyExists
+		return fmt.Errorf("%w in interceptors container: %s", process.ErrDuplicateTopic, key)
 	}
 
 	return nil
 }
 
 // AddMultiple will add objects with given keys. Returns
-// an error if one element already exists, lengths mismatch or an interceptor is nil
+// an error if one element already exists, lengths mismatch or an interceptor is nil.
+// The operation is atomic: on error, the keys already added by this call are removed
 func (ic *interceptorsContainer) AddMultiple(keys []string, interceptors []process.Interceptor) error {
 	if len(keys) != len(interceptors) {
 		return process.ErrLenMismatch
 	}
 
+	addedKeys := make([]string, 0, len(keys))
 	for idx, key := range keys {
 		if len(key) == 0 {
 			continue
@@ -67,13 +69,22 @@ func (ic *interceptorsContainer) AddMultiple(keys []string, interceptors []proce
 
 		err := ic.Add(key, interceptors[idx])
 		if err != nil {
+			ic.removeKeys(addedKeys)
 			return err
 		}
+
+		addedKeys = append(addedKeys, key)
 	}
 
 	return nil
 }
 
+func (ic *interceptorsContainer) removeKeys(keys []string) {
+	for _, key := range keys {
+		ic.objects.Remove(key)
+	}
+}
+
 // Replace will add (or replace if it already exists) an object at a given key
 func (ic *interceptorsContainer) Replace(key string, interceptor process.Interceptor) error {
 	if check.IfNil(interceptor) {
@@ -2,6 +2,7 @@ package containers_test
 
 import (
 	"errors"
+	"strings"
 	"sync/atomic"
 	"testing"
 
@@ -30,7 +31,8 @@ func TestInterceptorsContainer_AddAlreadyExistingShouldErr(t *testing.T) {
 	_ = c.Add("key", &mock.InterceptorStub{})
 	err := c.Add("key", &mock.InterceptorStub{})
 
-	assert.Equal(t, process.ErrContainerKeyAlreadyExists, err)
+	assert.True(t, errors.Is(err, process.ErrDuplicateTopic))
+	assert.True(t, strings.Contains(err.Error(), "key"))
 }
 
 func TestInterceptorsContainer_AddNilShouldErr(t *testing.T) {
@@ -66,7 +68,43 @@ func TestInterceptorsContainer_AddMultipleAlreadyExistingShouldErr(t *testing.T)
 
 	err := c.AddMultiple(keys, interceptors)
 
-	assert.Equal(t, process.ErrContainerKeyAlreadyExists, err)
+	assert.True(t, errors.Is(err, process.ErrDuplicateTopic))
+	assert.True(t, strings.Contains(err.Error(), "key"))
+}
+
+func TestInterceptorsContainer_AddMultipleCollisionShouldRollbackTheAddedKeys(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	existingInterceptor := &mock.InterceptorStub{}
+	_ = c.Add("existing", existingInterceptor)
+
+	keys := []string{"key1", "existing", "key2"}
+	interceptors := []process.Interceptor{&mock.InterceptorStub{}, &mock.InterceptorStub{}, &mock.InterceptorStub{}}
+
+	err := c.AddMultiple(keys, interceptors)
+
+	assert.True(t, errors.Is(err, process.ErrDuplicateTopic))
+	assert.Equal(t, 1, c.Len())
+	_, err = c.Get("key1")
+	assert.True(t, errors.Is(err, process.ErrInvalidContainerKey))
+	recovered, err := c.Get("existing")
+	assert.Nil(t, err)
+	assert.True(t, recovered == existingInterceptor)
+}
+
+func TestInterceptorsContainer_AddMultipleNilInterceptorShouldRollbackTheAddedKeys(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+
+	keys := []string{"key1", "key2"}
+	interceptors := []process.Interceptor{&mock.InterceptorStub{}, nil}
+
+	err := c.AddMultiple(keys, interceptors)
+
+	assert.Equal(t, process.ErrNilContainerElement, err)
+	assert.Equal(t, 0, c.Len())
 }
 
 func TestInterceptorsContainer_AddMultipleLenMismatchShouldErr(t *testing.T) {
@@ -1,6 +1,7 @@
 package interceptorscontainer
 
 import (
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -30,6 +31,8 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	DataPool                dataRetriever.PoolsHolder
 	AddressPubkeyConverter  core.PubkeyConverter
 	MaxTxNonceDeltaAllowed  int
+	IntraShardTxNonceDelta  int
+	CrossShardTxNonceDelta  int
 	TxFeeHandler            process.FeeHandler
 	BlackList               process.BlackListHandler
 	HeaderSigVerifier       process.InterceptedHeaderSigVerifier
@@ -40,7 +43,11 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	WhiteListHandler        process.WhiteListHandler
 	WhiteListerVerifiedTxs  process.WhiteListHandler
 	AntifloodHandler        process.P2PAntifloodHandler
+	PeerBlackListHandler    process.PeerBlackListHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	TrieNodesAntiflood      config.TrieNodesAntifloodConfig
+	SyncStateHandler        process.SyncStateHandler
+	InterceptorMetricsSink  process.InterceptorMetricsSink
 }
 
 // MetaInterceptorsContainerFactoryArgs holds the arguments needed for MetaInterceptorsContainerFactory
@@ -71,5 +78,9 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	WhiteListHandler        process.WhiteListHandler
 	WhiteListerVerifiedTxs  process.WhiteListHandler
 	AntifloodHandler        process.P2PAntifloodHandler
+	PeerBlackListHandler    process.PeerBlackListHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	TrieNodesAntiflood      config.TrieNodesAntifloodConfig
+	SyncStateHandler        process.SyncStateHandler
+	InterceptorMetricsSink  process.InterceptorMetricsSink
 }
@@ -1,6 +1,10 @@
 package interceptorscontainer
 
 import (
+	"errors"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -15,11 +19,14 @@ import (
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 const numGoRoutines = 2000
 
+var log = logger.GetOrCreate("process/factory/interceptorscontainer")
+
 type baseInterceptorsContainerFactory struct {
 	container              process.InterceptorsContainer
 	shardCoordinator       sharding.Coordinator
@@ -35,10 +42,15 @@ type baseInterceptorsContainerFactory struct {
 	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
 	globalThrottler        process.InterceptorThrottler
 	maxTxNonceDeltaAllowed int
+	intraShardTxNonceDelta int
+	crossShardTxNonceDelta int
 	antifloodHandler       process.P2PAntifloodHandler
+	trieNodesAntiflood     process.P2PAntifloodHandler
 	whiteListHandler       process.WhiteListHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
 	addressPubkeyConverter core.PubkeyConverter
+	metricsSink            process.InterceptorMetricsSink
+	registeredTopics       []string
 }
 
 func checkBaseParams(
@@ -115,7 +127,55 @@ func (bicf *baseInterceptorsContainerFactory) createTopicAndAssignHandler(
 		return nil, err
 	}
 
-	return interceptor, bicf.messenger.RegisterMessageProcessor(topic, interceptor)
+	messageProcessor, err := bicf.instrumentInterceptor(topic, interceptor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bicf.messenger.RegisterMessageProcessor(topic, messageProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	bicf.registeredTopics = append(bicf.registeredTopics, topic)
+
+	return interceptor, nil
+}
+
+// instrumentInterceptor wraps the interceptor so that its processing latency is recorded, if a metrics sink was provided.
+// Without a sink the interceptor is registered as it is, avoiding any additional overhead
+func (bicf *baseInterceptorsContainerFactory) instrumentInterceptor(
+	topic string,
+	interceptor process.Interceptor,
+) (process.Interceptor, error) {
+	if check.IfNil(bicf.metricsSink) {
+		return interceptor, nil
+	}
+
+	return interceptors.NewInstrumentedInterceptor(topic, interceptor, bicf.metricsSink)
+}
+
+// Close unregisters the interceptors from all the topics registered by this factory, removes them from the produced
+// container and releases the global throttler
+func (bicf *baseInterceptorsContainerFactory) Close() error {
+	var lastErr error
+	for _, topic := range bicf.registeredTopics {
+		err := bicf.messenger.UnregisterMessageProcessor(topic)
+		if err != nil {
+			log.Warn("baseInterceptorsContainerFactory.Close: unregister message processor",
+				"topic", topic,
+				"error", err.Error(),
+			)
+			lastErr = err
+		}
+
+		bicf.container.Remove(topic)
+	}
+
+	bicf.registeredTopics = nil
+	bicf.globalThrottler = nil
+
+	return lastErr
 }
 
 //------- Tx interceptors
@@ -131,7 +191,7 @@ func (bicf *baseInterceptorsContainerFactory) generateTxInterceptors() error {
 	for idx := uint32(0); idx < noOfShards; idx++ {
 		identifierTx := factory.TransactionTopic + shardC.CommunicationIdentifier(idx)
 
-		interceptor, err := bicf.createOneTxInterceptor(identifierTx)
+		interceptor, err := bicf.createOneTxInterceptor(identifierTx, bicf.maxTxNonceDeltaAllowedForShard(idx))
 		if err != nil {
 			return err
 		}
@@ -143,7 +203,7 @@ func (bicf *baseInterceptorsContainerFactory) generateTxInterceptors() error {
 	//tx interceptor for metachain topic
 	identifierTx := factory.TransactionTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
 
-	interceptor, err := bicf.createOneTxInterceptor(identifierTx)
+	interceptor, err := bicf.createOneTxInterceptor(identifierTx, bicf.maxTxNonceDeltaAllowedForShard(core.MetachainShardId))
 	if err != nil {
 		return err
 	}
@@ -154,13 +214,27 @@ func (bicf *baseInterceptorsContainerFactory) generateTxInterceptors() error {
 	return bicf.container.AddMultiple(keys, interceptorSlice)
 }
 
-func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic string) (process.Interceptor, error) {
+// maxTxNonceDeltaAllowedForShard returns the nonce delta used by the tx interceptor communicating with the provided
+// shard. The intra and cross shard overrides are applied when set, otherwise the common value is used
+func (bicf *baseInterceptorsContainerFactory) maxTxNonceDeltaAllowedForShard(shardID uint32) int {
+	override := bicf.crossShardTxNonceDelta
+	if shardID == bicf.shardCoordinator.SelfId() {
+		override = bicf.intraShardTxNonceDelta
+	}
+	if override > 0 {
+		return override
+	}
+
+	return bicf.maxTxNonceDeltaAllowed
+}
+
+func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic string, maxTxNonceDeltaAllowed int) (process.Interceptor, error) {
 	txValidator, err := dataValidators.NewTxValidator(
 		bicf.accounts,
 		bicf.shardCoordinator,
 		bicf.whiteListHandler,
 		bicf.addressPubkeyConverter,
-		bicf.maxTxNonceDeltaAllowed,
+		maxTxNonceDeltaAllowed,
 	)
 	if err != nil {
 		return nil, err
@@ -461,7 +535,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 		trieNodesFactory,
 		trieNodesProcessor,
 		bicf.globalThrottler,
-		bicf.antifloodHandler,
+		bicf.trieNodesAntiflood,
 		bicf.whiteListHandler,
 	)
 	if err != nil {
@@ -471,6 +545,51 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 	return bicf.createTopicAndAssignHandler(topic, interceptor, true)
 }
 
+// createTrieNodesAntiflood returns the blacklist check chained with the dedicated trie nodes rate limiter if enabled,
+// the general antiflood otherwise. The general per peer quota is not applied on top of the dedicated rate limiter as
+// it would reject the trie nodes messages well before the dedicated limits are reached
+func createTrieNodesAntiflood(
+	antifloodHandler process.P2PAntifloodHandler,
+	peerBlackListHandler process.PeerBlackListHandler,
+	trieNodesAntifloodConfig config.TrieNodesAntifloodConfig,
+	syncStateHandler process.SyncStateHandler,
+) (process.P2PAntifloodHandler, error) {
+	if !trieNodesAntifloodConfig.Enabled {
+		return antifloodHandler, nil
+	}
+
+	trieNodesAntiflood, err := antiflood.NewTrieNodesAntiflood(
+		syncStateHandler,
+		trieNodesAntifloodConfig.MaxMessagesPerSecDuringSync,
+		trieNodesAntifloodConfig.MaxMessagesPerSec,
+		trieNodesAntifloodConfig.MaxTotalSizePerSecDuringSync,
+		trieNodesAntifloodConfig.MaxTotalSizePerSec,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	blacklistAntiflood, err := antiflood.NewBlacklistAntiflood(peerBlackListHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return antiflood.NewChainedAntiflood(blacklistAntiflood, trieNodesAntiflood)
+}
+
+// checkGeneratorError logs the generator that tried to register an interceptor on an already used topic as this
+// signals a wiring bug in the topic identifiers computation
+func (bicf *baseInterceptorsContainerFactory) checkGeneratorError(generatorName string, err error) error {
+	if errors.Is(err, process.ErrDuplicateTopic) {
+		log.Error("interceptors container factory: duplicate topic",
+			"generator", generatorName,
+			"error", err.Error(),
+		)
+	}
+
+	return err
+}
+
 func (bicf *baseInterceptorsContainerFactory) generateUnsignedTxsInterceptors() error {
 	shardC := bicf.shardCoordinator
 
@@ -0,0 +1,26 @@
+package interceptorscontainer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// MaxTxNonceDeltaAllowedForShard -
+func (sicf *shardInterceptorsContainerFactory) MaxTxNonceDeltaAllowedForShard(shardID uint32) int {
+	return sicf.maxTxNonceDeltaAllowedForShard(shardID)
+}
+
+// GlobalThrottler -
+func (sicf *shardInterceptorsContainerFactory) GlobalThrottler() process.InterceptorThrottler {
+	return sicf.globalThrottler
+}
+
+// CreateTrieNodesAntiflood -
+func CreateTrieNodesAntiflood(
+	antifloodHandler process.P2PAntifloodHandler,
+	peerBlackListHandler process.PeerBlackListHandler,
+	trieNodesAntifloodConfig config.TrieNodesAntifloodConfig,
+	syncStateHandler process.SyncStateHandler,
+) (process.P2PAntifloodHandler, error) {
+	return createTrieNodesAntiflood(antifloodHandler, peerBlackListHandler, trieNodesAntifloodConfig, syncStateHandler)
+}
@@ -76,6 +76,19 @@ func NewMetaInterceptorsContainerFactory(
 	if check.IfNil(args.ValidityAttester) {
 		return nil, process.ErrNilValidityAttester
 	}
+	if check.IfNil(args.SyncStateHandler) {
+		return nil, process.ErrNilSyncStateHandler
+	}
+
+	trieNodesAntiflood, err := createTrieNodesAntiflood(
+		args.AntifloodHandler,
+		args.PeerBlackListHandler,
+		args.TrieNodesAntiflood,
+		args.SyncStateHandler,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		ProtoMarshalizer:        args.ProtoMarshalizer,
@@ -95,6 +108,7 @@ func NewMetaInterceptorsContainerFactory(
 		ValidityAttester:        args.ValidityAttester,
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
+		SyncStateHandler:        args.SyncStateHandler,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
 	}
 
@@ -114,9 +128,11 @@ func NewMetaInterceptorsContainerFactory(
 		maxTxNonceDeltaAllowed: args.MaxTxNonceDeltaAllowed,
 		accounts:               args.Accounts,
 		antifloodHandler:       args.AntifloodHandler,
+		trieNodesAntiflood:     trieNodesAntiflood,
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		metricsSink:            args.InterceptorMetricsSink,
 	}
 
 	icf := &metaInterceptorsContainerFactory{
@@ -135,37 +151,37 @@ func NewMetaInterceptorsContainerFactory(
 func (micf *metaInterceptorsContainerFactory) Create() (process.InterceptorsContainer, error) {
 	err := micf.generateMetachainHeaderInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateMetachainHeaderInterceptors", err)
 	}
 
 	err = micf.generateShardHeaderInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateShardHeaderInterceptors", err)
 	}
 
 	err = micf.generateTxInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateTxInterceptors", err)
 	}
 
 	err = micf.generateUnsignedTxsInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateUnsignedTxsInterceptors", err)
 	}
 
 	err = micf.generateRewardTxInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateRewardTxInterceptors", err)
 	}
 
 	err = micf.generateMiniBlocksInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateMiniBlocksInterceptors", err)
 	}
 
 	err = micf.generateTrieNodesInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, micf.checkGeneratorError("generateTrieNodesInterceptors", err)
 	}
 
 	return micf.container, nil
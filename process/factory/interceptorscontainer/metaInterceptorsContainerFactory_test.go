@@ -4,7 +4,9 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -292,6 +294,17 @@ func TestNewMetaInterceptorsContainerFactory_EpochStartTriggerShouldErr(t *testi
 	assert.Equal(t, process.ErrNilEpochStartTrigger, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilSyncStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.SyncStateHandler = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilSyncStateHandler, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -401,6 +414,44 @@ func TestMetaInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMetaInterceptorsContainerFactory_CreateWithMetricsSinkShouldRecordTheLatencyPerTopic(t *testing.T) {
+	t.Parallel()
+
+	registeredProcessors := make(map[string]p2p.MessageProcessor)
+	args := getArgumentsMeta()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredProcessors[topic] = handler
+			return nil
+		},
+	}
+	observedTopics := make(map[string]int)
+	args.InterceptorMetricsSink = &mock.InterceptorMetricsSinkStub{
+		ObserveProcessingLatencyCalled: func(topic string, latency time.Duration) {
+			observedTopics[topic]++
+		},
+	}
+
+	icf, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+	_, err := icf.Create()
+	require.Nil(t, err)
+
+	trieNodesTopic := factory.AccountTrieNodesTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	trieNodesProcessor, ok := registeredProcessors[trieNodesTopic]
+	require.True(t, ok)
+
+	numMessages := 3
+	for i := 0; i < numMessages; i++ {
+		_ = trieNodesProcessor.ProcessReceivedMessage(&mock.P2PMessageMock{}, "pid")
+	}
+
+	assert.Equal(t, numMessages, observedTopics[trieNodesTopic])
+	assert.Equal(t, 1, len(observedTopics))
+}
+
 func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -474,8 +525,10 @@ func getArgumentsMeta() interceptorscontainer.MetaInterceptorsContainerFactoryAr
 		ValidityAttester:        &mock.ValidityAttesterStub{},
 		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
 		AntifloodHandler:        &mock.P2PAntifloodHandlerStub{},
+		PeerBlackListHandler:    &mock.PeerBlackListHandlerStub{},
 		WhiteListHandler:        &mock.WhiteListHandlerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
 		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
+		SyncStateHandler:        &mock.SyncStateHandlerStub{},
 	}
 }
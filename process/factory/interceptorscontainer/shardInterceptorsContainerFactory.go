@@ -1,19 +1,29 @@
 package interceptorscontainer
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 )
 
 var _ process.InterceptorsContainerFactory = (*shardInterceptorsContainerFactory)(nil)
 
+// the heartbeat messages are sent every few seconds so the limits only need to accommodate the propagation delays
+// and the clock differences between nodes
+const maxHeartbeatAge = time.Minute * 5
+const maxHeartbeatFutureDrift = time.Minute
+
 // shardInterceptorsContainerFactory will handle the creation the interceptors container for shards
 type shardInterceptorsContainerFactory struct {
 	*baseInterceptorsContainerFactory
@@ -73,9 +83,28 @@ func NewShardInterceptorsContainerFactory(
 	if check.IfNil(args.ValidityAttester) {
 		return nil, process.ErrNilValidityAttester
 	}
+	if check.IfNil(args.SyncStateHandler) {
+		return nil, process.ErrNilSyncStateHandler
+	}
 	if check.IfNil(args.EpochStartTrigger) {
 		return nil, process.ErrNilEpochStartTrigger
 	}
+	if args.IntraShardTxNonceDelta < 0 {
+		return nil, fmt.Errorf("%w for intra shard transactions", process.ErrInvalidMaxTxNonceDeltaAllowed)
+	}
+	if args.CrossShardTxNonceDelta < 0 {
+		return nil, fmt.Errorf("%w for cross shard transactions", process.ErrInvalidMaxTxNonceDeltaAllowed)
+	}
+
+	trieNodesAntiflood, err := createTrieNodesAntiflood(
+		args.AntifloodHandler,
+		args.PeerBlackListHandler,
+		args.TrieNodesAntiflood,
+		args.SyncStateHandler,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		ProtoMarshalizer:        args.ProtoMarshalizer,
@@ -95,6 +124,7 @@ func NewShardInterceptorsContainerFactory(
 		ValidityAttester:        args.ValidityAttester,
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
+		SyncStateHandler:        args.SyncStateHandler,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
 	}
 
@@ -113,10 +143,14 @@ func NewShardInterceptorsContainerFactory(
 		argInterceptorFactory:  argInterceptorFactory,
 		blackList:              args.BlackList,
 		maxTxNonceDeltaAllowed: args.MaxTxNonceDeltaAllowed,
+		intraShardTxNonceDelta: args.IntraShardTxNonceDelta,
+		crossShardTxNonceDelta: args.CrossShardTxNonceDelta,
 		antifloodHandler:       args.AntifloodHandler,
+		trieNodesAntiflood:     trieNodesAntiflood,
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		metricsSink:            args.InterceptorMetricsSink,
 	}
 
 	icf := &shardInterceptorsContainerFactory{
@@ -137,37 +171,42 @@ func NewShardInterceptorsContainerFactory(
 func (sicf *shardInterceptorsContainerFactory) Create() (process.InterceptorsContainer, error) {
 	err := sicf.generateTxInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateTxInterceptors", err)
 	}
 
 	err = sicf.generateUnsignedTxsInterceptorsForShard()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateUnsignedTxsInterceptorsForShard", err)
 	}
 
 	err = sicf.generateRewardTxInterceptor()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateRewardTxInterceptor", err)
 	}
 
 	err = sicf.generateHeaderInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateHeaderInterceptors", err)
 	}
 
 	err = sicf.generateMiniBlocksInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateMiniBlocksInterceptors", err)
 	}
 
 	err = sicf.generateMetachainHeaderInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateMetachainHeaderInterceptors", err)
 	}
 
 	err = sicf.generateTrieNodesInterceptors()
 	if err != nil {
-		return nil, err
+		return nil, sicf.checkGeneratorError("generateTrieNodesInterceptors", err)
+	}
+
+	err = sicf.generateHeartbeatInterceptor()
+	if err != nil {
+		return nil, sicf.checkGeneratorError("generateHeartbeatInterceptor", err)
 	}
 
 	return sicf.container, nil
@@ -248,6 +287,39 @@ func (sicf *shardInterceptorsContainerFactory) generateRewardTxInterceptor() err
 	return sicf.container.AddMultiple(keys, interceptorSlice)
 }
 
+//------- Heartbeat interceptor
+
+func (sicf *shardInterceptorsContainerFactory) generateHeartbeatInterceptor() error {
+	identifierHeartbeat := core.HeartbeatTopic
+
+	argHeartbeatValidator := heartbeatProcess.ArgHeartbeatValidator{
+		ShardCoordinator: sicf.shardCoordinator,
+		MaxAge:           maxHeartbeatAge,
+		MaxFutureDrift:   maxHeartbeatFutureDrift,
+	}
+	heartbeatValidator, err := heartbeatProcess.NewHeartbeatValidator(argHeartbeatValidator)
+	if err != nil {
+		return err
+	}
+
+	interceptor, err := interceptors.NewHeartbeatInterceptor(
+		sicf.marshalizer,
+		sicf.globalThrottler,
+		sicf.antifloodHandler,
+		heartbeatValidator,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = sicf.createTopicAndAssignHandler(identifierHeartbeat, interceptor, true)
+	if err != nil {
+		return err
+	}
+
+	return sicf.container.Add(identifierHeartbeat, interceptor)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sicf *shardInterceptorsContainerFactory) IsInterfaceNil() bool {
 	return sicf == nil
@@ -1,9 +1,14 @@
 package interceptorscontainer_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -12,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createShardStubTopicHandler(matchStrToErrOnCreate string, matchStrToErrOnRegister string) process.TopicHandler {
@@ -290,6 +296,136 @@ func TestNewShardInterceptorsContainerFactory_EmptyEpochStartTriggerShouldErr(t
 	assert.Equal(t, process.ErrNilEpochStartTrigger, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NegativeIntraShardTxNonceDeltaShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.IntraShardTxNonceDelta = -1
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.True(t, errors.Is(err, process.ErrInvalidMaxTxNonceDeltaAllowed))
+}
+
+func TestNewShardInterceptorsContainerFactory_NegativeCrossShardTxNonceDeltaShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.CrossShardTxNonceDelta = -1
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.True(t, errors.Is(err, process.ErrInvalidMaxTxNonceDeltaAllowed))
+}
+
+func TestNewShardInterceptorsContainerFactory_NilSyncStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.SyncStateHandler = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilSyncStateHandler, err)
+}
+
+func TestNewShardInterceptorsContainerFactory_TrieNodesAntifloodWithInvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.TrieNodesAntiflood = config.TrieNodesAntifloodConfig{
+		Enabled:                      true,
+		MaxMessagesPerSecDuringSync:  1,
+		MaxMessagesPerSec:            10,
+		MaxTotalSizePerSecDuringSync: 1000,
+		MaxTotalSizePerSec:           100,
+	}
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewShardInterceptorsContainerFactory_TrieNodesAntifloodWithNilPeerBlackListHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.PeerBlackListHandler = nil
+	args.TrieNodesAntiflood = config.TrieNodesAntifloodConfig{
+		Enabled:                      true,
+		MaxMessagesPerSecDuringSync:  100,
+		MaxMessagesPerSec:            10,
+		MaxTotalSizePerSecDuringSync: 1000,
+		MaxTotalSizePerSec:           100,
+	}
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilBlackListHandler, err)
+}
+
+func TestCreateTrieNodesAntiflood_DisabledShouldReturnTheGeneralAntiflood(t *testing.T) {
+	t.Parallel()
+
+	generalAntiflood := &mock.P2PAntifloodHandlerStub{}
+	trieNodesAntiflood, err := interceptorscontainer.CreateTrieNodesAntiflood(
+		generalAntiflood,
+		nil,
+		config.TrieNodesAntifloodConfig{},
+		&mock.SyncStateHandlerStub{},
+	)
+
+	assert.Nil(t, err)
+	assert.True(t, trieNodesAntiflood == generalAntiflood)
+}
+
+func TestCreateTrieNodesAntiflood_EnabledShouldNotApplyTheGeneralQuota(t *testing.T) {
+	t.Parallel()
+
+	generalAntiflood := &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			assert.Fail(t, "the general antiflood should not have been called")
+			return process.ErrSystemBusy
+		},
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+			assert.Fail(t, "the general antiflood should not have been called")
+			return process.ErrSystemBusy
+		},
+	}
+	blacklistedOriginator := core.PeerID("blacklisted originator")
+	peerBlackListHandler := &mock.PeerBlackListHandlerStub{
+		HasCalled: func(pid core.PeerID) bool {
+			return pid == blacklistedOriginator
+		},
+	}
+	maxMessagesPerSec := uint32(10)
+	trieNodesAntiflood, err := interceptorscontainer.CreateTrieNodesAntiflood(
+		generalAntiflood,
+		peerBlackListHandler,
+		config.TrieNodesAntifloodConfig{
+			Enabled:                      true,
+			MaxMessagesPerSecDuringSync:  maxMessagesPerSec,
+			MaxMessagesPerSec:            maxMessagesPerSec,
+			MaxTotalSizePerSecDuringSync: 1000,
+			MaxTotalSizePerSec:           1000,
+		},
+		&mock.SyncStateHandlerStub{},
+	)
+	require.Nil(t, err)
+
+	err = trieNodesAntiflood.CanProcessMessage(&mock.P2PMessageMock{PeerField: blacklistedOriginator}, "connected peer")
+	assert.True(t, errors.Is(err, process.ErrOriginatorIsBlacklisted))
+
+	msg := &mock.P2PMessageMock{PeerField: "originator"}
+	for i := uint32(0); i < maxMessagesPerSec; i++ {
+		assert.Nil(t, trieNodesAntiflood.CanProcessMessage(msg, "connected peer"))
+		assert.Nil(t, trieNodesAntiflood.CanProcessMessagesOnTopic("connected peer", "topic", 1, 0))
+	}
+
+	err = trieNodesAntiflood.CanProcessMessage(msg, "connected peer")
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
+
 func TestNewShardInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -453,6 +589,72 @@ func TestShardInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestShardInterceptorsContainerFactory_CreateWithTrieNodesAntifloodShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			return nil
+		},
+	}
+	args.TrieNodesAntiflood = config.TrieNodesAntifloodConfig{
+		Enabled:                      true,
+		MaxMessagesPerSecDuringSync:  100,
+		MaxMessagesPerSec:            10,
+		MaxTotalSizePerSecDuringSync: 1000,
+		MaxTotalSizePerSec:           100,
+	}
+
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+
+	assert.NotNil(t, container)
+	assert.Nil(t, err)
+}
+
+func TestShardInterceptorsContainerFactory_CreateWithMetricsSinkShouldRecordTheLatencyPerTopic(t *testing.T) {
+	t.Parallel()
+
+	registeredProcessors := make(map[string]p2p.MessageProcessor)
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredProcessors[topic] = handler
+			return nil
+		},
+	}
+	observedTopics := make(map[string]int)
+	args.InterceptorMetricsSink = &mock.InterceptorMetricsSinkStub{
+		ObserveProcessingLatencyCalled: func(topic string, latency time.Duration) {
+			observedTopics[topic]++
+		},
+	}
+
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+	_, err := icf.Create()
+	require.Nil(t, err)
+
+	trieNodesTopic := factory.AccountTrieNodesTopic + core.CommunicationIdentifierBetweenShards(0, core.MetachainShardId)
+	trieNodesProcessor, ok := registeredProcessors[trieNodesTopic]
+	require.True(t, ok)
+
+	numMessages := 3
+	for i := 0; i < numMessages; i++ {
+		_ = trieNodesProcessor.ProcessReceivedMessage(&mock.P2PMessageMock{}, "pid")
+	}
+
+	assert.Equal(t, numMessages, observedTopics[trieNodesTopic])
+	assert.Equal(t, 1, len(observedTopics))
+}
+
 func TestShardInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -495,13 +697,54 @@ func TestShardInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numInterceptorMiniBlocks := noOfShards + 2
 	numInterceptorMetachainHeaders := 1
 	numInterceptorTrieNodes := 3
+	numInterceptorHeartbeat := 1
 	totalInterceptors := numInterceptorTxs + numInterceptorsUnsignedTxs + numInterceptorsRewardTxs +
-		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders + numInterceptorTrieNodes
+		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders + numInterceptorTrieNodes +
+		numInterceptorHeartbeat
 
 	assert.Nil(t, err)
 	assert.Equal(t, totalInterceptors, container.Len())
 }
 
+func TestShardInterceptorsContainerFactory_CreateRegisterHeartbeatFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.Messenger = createShardStubTopicHandler("", core.HeartbeatTopic)
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestShardInterceptorsContainerFactory_CreateShouldAddHeartbeatInterceptor(t *testing.T) {
+	t.Parallel()
+
+	registeredTopics := make(map[string]struct{})
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopics[topic] = struct{}{}
+			return nil
+		},
+	}
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+	require.Nil(t, err)
+
+	interceptor, err := container.Get(core.HeartbeatTopic)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(interceptor))
+	_, isRegistered := registeredTopics[core.HeartbeatTopic]
+	assert.True(t, isRegistered)
+}
+
 func getArgumentsShard() interceptorscontainer.ShardInterceptorsContainerFactoryArgs {
 	return interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
 		Accounts:                &mock.AccountsStub{},
@@ -528,8 +771,88 @@ func getArgumentsShard() interceptorscontainer.ShardInterceptorsContainerFactory
 		ValidityAttester:        &mock.ValidityAttesterStub{},
 		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
 		AntifloodHandler:        &mock.P2PAntifloodHandlerStub{},
+		PeerBlackListHandler:    &mock.PeerBlackListHandlerStub{},
 		WhiteListHandler:        &mock.WhiteListHandlerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
 		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
+		SyncStateHandler:        &mock.SyncStateHandlerStub{},
+	}
+}
+
+func TestShardInterceptorsContainerFactory_TxNonceDeltaShouldApplyOverrides(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.SetNoShards(2)
+	shardCoordinator.CurrentShard = 1
+
+	args := getArgumentsShard()
+	args.ShardCoordinator = shardCoordinator
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+	assert.Equal(t, maxTxNonceDeltaAllowed, icf.MaxTxNonceDeltaAllowedForShard(1))
+	assert.Equal(t, maxTxNonceDeltaAllowed, icf.MaxTxNonceDeltaAllowedForShard(0))
+
+	args.IntraShardTxNonceDelta = 10
+	args.CrossShardTxNonceDelta = 200
+	icf, _ = interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+	assert.Equal(t, args.IntraShardTxNonceDelta, icf.MaxTxNonceDeltaAllowedForShard(1))
+	assert.Equal(t, args.CrossShardTxNonceDelta, icf.MaxTxNonceDeltaAllowedForShard(0))
+	assert.Equal(t, args.CrossShardTxNonceDelta, icf.MaxTxNonceDeltaAllowedForShard(core.MetachainShardId))
+}
+
+func TestShardInterceptorsContainerFactory_CloseShouldUnregisterTopicsAndReleaseThrottler(t *testing.T) {
+	t.Parallel()
+
+	registeredTopics := make(map[string]struct{})
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopics[topic] = struct{}{}
+			return nil
+		},
+		UnregisterMessageProcessorCalled: func(topic string) error {
+			delete(registeredTopics, topic)
+			return nil
+		},
+	}
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+	require.Nil(t, err)
+	require.Equal(t, container.Len(), len(registeredTopics))
+	require.False(t, check.IfNil(icf.GlobalThrottler()))
+
+	err = icf.Close()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(registeredTopics))
+	assert.Equal(t, 0, container.Len())
+	assert.True(t, check.IfNil(icf.GlobalThrottler()))
+}
+
+func TestShardInterceptorsContainerFactory_CloseUnregisterErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			return nil
+		},
+		UnregisterMessageProcessorCalled: func(topic string) error {
+			return errExpected
+		},
 	}
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, _ := icf.Create()
+	err := icf.Close()
+
+	assert.Equal(t, errExpected, err)
+	assert.Equal(t, 0, container.Len())
 }
@@ -1,6 +1,7 @@
 package interceptors
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -109,3 +110,49 @@ func receivedDebugInterceptedData(
 	identifiers := interceptedData.Identifiers()
 	debugHandler.LogReceivedHashes(topic, identifiers)
 }
+
+// createNotForCurrentShardError creates the error recorded when the intercepted data is rejected for being meant for
+// other shards. The claimed sender and receiver shards are included, together with the self shard, if the intercepted
+// data is able to report them
+func createNotForCurrentShardError(interceptedData process.InterceptedData) error {
+	shardsHandler, ok := interceptedData.(process.InterceptedDataShards)
+	if !ok {
+		return process.ErrInterceptedDataNotForCurrentShard
+	}
+
+	return fmt.Errorf("%w, sender shard: %d, receiver shard: %d, self shard: %d",
+		process.ErrInterceptedDataNotForCurrentShard,
+		shardsHandler.SenderShardId(),
+		shardsHandler.ReceiverShardId(),
+		shardsHandler.SelfShardId(),
+	)
+}
+
+// getNotForCurrentShardLogArgs returns the key/value pairs logged when the intercepted data is rejected for being
+// meant for other shards, extended with the claimed sender and receiver shards and the self shard when available
+func getNotForCurrentShardLogArgs(
+	message p2p.MessageP2P,
+	interceptedData process.InterceptedData,
+	isForCurrentShard bool,
+	isWhiteListed bool,
+) []interface{} {
+	args := []interface{}{
+		"pid", p2p.MessageOriginatorPid(message),
+		"seq no", p2p.MessageOriginatorSeq(message),
+		"topics", message.Topics(),
+		"hash", interceptedData.Hash(),
+		"is for current shard", isForCurrentShard,
+		"is white listed", isWhiteListed,
+	}
+
+	shardsHandler, ok := interceptedData.(process.InterceptedDataShards)
+	if !ok {
+		return args
+	}
+
+	return append(args,
+		"sender shard", shardsHandler.SenderShardId(),
+		"receiver shard", shardsHandler.ReceiverShardId(),
+		"self shard", shardsHandler.SelfShardId(),
+	)
+}
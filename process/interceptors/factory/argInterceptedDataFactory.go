@@ -31,4 +31,6 @@ type ArgInterceptedDataFactory struct {
 	ValidityAttester        process.ValidityAttester
 	EpochStartTrigger       process.EpochStartTriggerHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	SyncStateHandler        process.SyncStateHandler
+	SkipHeaderEpochCheck    bool
 }
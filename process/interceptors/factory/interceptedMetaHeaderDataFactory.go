@@ -22,6 +22,8 @@ type interceptedMetaHeaderDataFactory struct {
 	validityAttester        process.ValidityAttester
 	epochStartTrigger       process.EpochStartTriggerHandler
 	nonceConverter          typeConverters.Uint64ByteSliceConverter
+	syncStateHandler        process.SyncStateHandler
+	skipHeaderEpochCheck    bool
 }
 
 // NewInterceptedMetaHeaderDataFactory creates an instance of interceptedMetaHeaderDataFactory
@@ -56,6 +58,9 @@ func NewInterceptedMetaHeaderDataFactory(argument *ArgInterceptedDataFactory) (*
 	if check.IfNil(argument.NonceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if !argument.SkipHeaderEpochCheck && check.IfNil(argument.SyncStateHandler) {
+		return nil, process.ErrNilSyncStateHandler
+	}
 
 	return &interceptedMetaHeaderDataFactory{
 		marshalizer:             argument.ProtoMarshalizer,
@@ -66,6 +71,8 @@ func NewInterceptedMetaHeaderDataFactory(argument *ArgInterceptedDataFactory) (*
 		validityAttester:        argument.ValidityAttester,
 		epochStartTrigger:       argument.EpochStartTrigger,
 		nonceConverter:          argument.NonceConverter,
+		syncStateHandler:        argument.SyncStateHandler,
+		skipHeaderEpochCheck:    argument.SkipHeaderEpochCheck,
 	}, nil
 }
 
@@ -81,6 +88,8 @@ func (imhdf *interceptedMetaHeaderDataFactory) Create(buff []byte) (process.Inte
 		ValidityAttester:        imhdf.validityAttester,
 		EpochStartTrigger:       imhdf.epochStartTrigger,
 		NonceConverter:          imhdf.nonceConverter,
+		SyncStateHandler:        imhdf.syncStateHandler,
+		SkipHeaderEpochCheck:    imhdf.skipHeaderEpochCheck,
 	}
 
 	return interceptedBlocks.NewInterceptedMetaHeader(arg)
@@ -69,6 +69,7 @@ func createMockArgument() *ArgInterceptedDataFactory {
 		ValidityAttester:        &mock.ValidityAttesterStub{},
 		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
+		SyncStateHandler:        &mock.SyncStateHandlerStub{},
 		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
 	}
 }
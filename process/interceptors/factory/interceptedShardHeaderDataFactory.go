@@ -22,6 +22,8 @@ type interceptedShardHeaderDataFactory struct {
 	validityAttester        process.ValidityAttester
 	epochStartTrigger       process.EpochStartTriggerHandler
 	nonceConverter          typeConverters.Uint64ByteSliceConverter
+	syncStateHandler        process.SyncStateHandler
+	skipHeaderEpochCheck    bool
 }
 
 // NewInterceptedShardHeaderDataFactory creates an instance of interceptedShardHeaderDataFactory
@@ -56,6 +58,9 @@ func NewInterceptedShardHeaderDataFactory(argument *ArgInterceptedDataFactory) (
 	if check.IfNil(argument.NonceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if !argument.SkipHeaderEpochCheck && check.IfNil(argument.SyncStateHandler) {
+		return nil, process.ErrNilSyncStateHandler
+	}
 
 	return &interceptedShardHeaderDataFactory{
 		marshalizer:             argument.ProtoMarshalizer,
@@ -66,6 +71,8 @@ func NewInterceptedShardHeaderDataFactory(argument *ArgInterceptedDataFactory) (
 		validityAttester:        argument.ValidityAttester,
 		epochStartTrigger:       argument.EpochStartTrigger,
 		nonceConverter:          argument.NonceConverter,
+		syncStateHandler:        argument.SyncStateHandler,
+		skipHeaderEpochCheck:    argument.SkipHeaderEpochCheck,
 	}, nil
 }
 
@@ -81,6 +88,8 @@ func (ishdf *interceptedShardHeaderDataFactory) Create(buff []byte) (process.Int
 		ValidityAttester:        ishdf.validityAttester,
 		EpochStartTrigger:       ishdf.epochStartTrigger,
 		NonceConverter:          ishdf.nonceConverter,
+		SyncStateHandler:        ishdf.syncStateHandler,
+		SkipHeaderEpochCheck:    ishdf.skipHeaderEpochCheck,
 	}
 
 	return interceptedBlocks.NewInterceptedHeader(arg)
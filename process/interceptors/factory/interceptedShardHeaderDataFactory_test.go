@@ -97,6 +97,29 @@ func TestNewInterceptedShardHeaderDataFactory_NilNonceConverterShouldErr(t *test
 	assert.Equal(t, process.ErrNilUint64Converter, err)
 }
 
+func TestNewInterceptedShardHeaderDataFactory_NilSyncStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.SyncStateHandler = nil
+
+	imh, err := NewInterceptedShardHeaderDataFactory(arg)
+	assert.True(t, check.IfNil(imh))
+	assert.Equal(t, process.ErrNilSyncStateHandler, err)
+}
+
+func TestNewInterceptedShardHeaderDataFactory_NilSyncStateHandlerWithSkipHeaderEpochCheckShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.SyncStateHandler = nil
+	arg.SkipHeaderEpochCheck = true
+
+	imh, err := NewInterceptedShardHeaderDataFactory(arg)
+	assert.False(t, check.IfNil(imh))
+	assert.Nil(t, err)
+}
+
 func TestInterceptedShardHeaderDataFactory_ShouldWorkAndCreate(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,127 @@
+package interceptors
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// HeartbeatInterceptor is used for intercepting heartbeat messages. The messages are throttled and structurally
+// validated before being forwarded to the heartbeat processor
+type HeartbeatInterceptor struct {
+	marshalizer           marshal.Marshalizer
+	throttler             process.InterceptorThrottler
+	antifloodHandler      process.P2PAntifloodHandler
+	heartbeatValidator    process.HeartbeatValidator
+	mutHeartbeatProcessor sync.RWMutex
+	heartbeatProcessor    process.HeartbeatProcessor
+}
+
+// NewHeartbeatInterceptor hooks a new interceptor for heartbeat messages
+func NewHeartbeatInterceptor(
+	marshalizer marshal.Marshalizer,
+	throttler process.InterceptorThrottler,
+	antifloodHandler process.P2PAntifloodHandler,
+	heartbeatValidator process.HeartbeatValidator,
+) (*HeartbeatInterceptor, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(throttler) {
+		return nil, process.ErrNilInterceptorThrottler
+	}
+	if check.IfNil(antifloodHandler) {
+		return nil, process.ErrNilAntifloodHandler
+	}
+	if check.IfNil(heartbeatValidator) {
+		return nil, process.ErrNilHeartbeatValidator
+	}
+
+	return &HeartbeatInterceptor{
+		marshalizer:        marshalizer,
+		throttler:          throttler,
+		antifloodHandler:   antifloodHandler,
+		heartbeatValidator: heartbeatValidator,
+	}, nil
+}
+
+// ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to)
+func (hi *HeartbeatInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := preProcessMesage(hi.throttler, hi.antifloodHandler, message, fromConnectedPeer, core.HeartbeatTopic)
+	if err != nil {
+		return err
+	}
+	defer hi.throttler.EndProcessing()
+
+	hb, err := hi.checkHeartbeat(message.Data())
+	if err != nil {
+		log.Trace("intercepted heartbeat is not valid",
+			"pid", p2p.MessageOriginatorPid(message),
+			"seq no", p2p.MessageOriginatorSeq(message),
+			"error", err.Error(),
+		)
+
+		return err
+	}
+
+	hi.mutHeartbeatProcessor.RLock()
+	heartbeatProcessor := hi.heartbeatProcessor
+	hi.mutHeartbeatProcessor.RUnlock()
+
+	if check.IfNil(heartbeatProcessor) {
+		return process.ErrHeartbeatProcessorNotSet
+	}
+
+	return heartbeatProcessor.ProcessInterceptedHeartbeat(hb, message, fromConnectedPeer)
+}
+
+func (hi *HeartbeatInterceptor) checkHeartbeat(buff []byte) (*heartbeatData.Heartbeat, error) {
+	hb := &heartbeatData.Heartbeat{}
+	err := hi.marshalizer.Unmarshal(hb, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hi.heartbeatValidator.ValidateHeartbeat(hb, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidHeartbeat, err.Error())
+	}
+
+	return hb, nil
+}
+
+// SetHeartbeatProcessor sets the processor that will receive the validated heartbeat messages, which are forwarded
+// together with their unmarshalled heartbeat so that the processor does not unmarshal them again
+func (hi *HeartbeatInterceptor) SetHeartbeatProcessor(heartbeatProcessor process.HeartbeatProcessor) error {
+	if check.IfNil(heartbeatProcessor) {
+		return process.ErrNilHeartbeatProcessor
+	}
+
+	hi.mutHeartbeatProcessor.Lock()
+	hi.heartbeatProcessor = heartbeatProcessor
+	hi.mutHeartbeatProcessor.Unlock()
+
+	return nil
+}
+
+// SetInterceptedDebugHandler returns nil as the heartbeat messages are not tracked by the intercepted debug handler
+func (hi *HeartbeatInterceptor) SetInterceptedDebugHandler(handler process.InterceptedDebugger) error {
+	if check.IfNil(handler) {
+		return process.ErrNilDebugger
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hi *HeartbeatInterceptor) IsInterfaceNil() bool {
+	return hi == nil
+}
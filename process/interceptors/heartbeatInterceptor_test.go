@@ -0,0 +1,166 @@
+package interceptors_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createHeartbeatMessage(marshalizer *mock.MarshalizerMock, hb *heartbeatData.Heartbeat) *mock.P2PMessageMock {
+	buff, _ := marshalizer.Marshal(hb)
+
+	return &mock.P2PMessageMock{
+		DataField: buff,
+	}
+}
+
+func createValidHeartbeat() *heartbeatData.Heartbeat {
+	return &heartbeatData.Heartbeat{
+		Payload:   []byte(time.Now().String()),
+		Pubkey:    []byte("pubkey"),
+		Signature: []byte("signature"),
+		Pid:       []byte("pid"),
+	}
+}
+
+func createMockHeartbeatValidator() process.HeartbeatValidator {
+	heartbeatValidator, _ := heartbeatProcess.NewHeartbeatValidator(heartbeatProcess.ArgHeartbeatValidator{
+		ShardCoordinator: mock.NewMultipleShardsCoordinatorMock(),
+		MaxAge:           time.Minute,
+		MaxFutureDrift:   time.Minute,
+	})
+
+	return heartbeatValidator
+}
+
+func TestNewHeartbeatInterceptor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hi, err := interceptors.NewHeartbeatInterceptor(nil, createMockThrottler(), &mock.P2PAntifloodHandlerStub{}, createMockHeartbeatValidator())
+
+	assert.True(t, check.IfNil(hi))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewHeartbeatInterceptor_NilThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hi, err := interceptors.NewHeartbeatInterceptor(&mock.MarshalizerMock{}, nil, &mock.P2PAntifloodHandlerStub{}, createMockHeartbeatValidator())
+
+	assert.True(t, check.IfNil(hi))
+	assert.Equal(t, process.ErrNilInterceptorThrottler, err)
+}
+
+func TestNewHeartbeatInterceptor_NilAntifloodHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hi, err := interceptors.NewHeartbeatInterceptor(&mock.MarshalizerMock{}, createMockThrottler(), nil, createMockHeartbeatValidator())
+
+	assert.True(t, check.IfNil(hi))
+	assert.Equal(t, process.ErrNilAntifloodHandler, err)
+}
+
+func TestNewHeartbeatInterceptor_NilHeartbeatValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hi, err := interceptors.NewHeartbeatInterceptor(&mock.MarshalizerMock{}, createMockThrottler(), &mock.P2PAntifloodHandlerStub{}, nil)
+
+	assert.True(t, check.IfNil(hi))
+	assert.Equal(t, process.ErrNilHeartbeatValidator, err)
+}
+
+func TestHeartbeatInterceptor_ProcessReceivedMessageAntifloodErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	marshalizer := &mock.MarshalizerMock{}
+	antiflood := &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+			assert.Equal(t, core.HeartbeatTopic, topic)
+			return expectedErr
+		},
+	}
+	hi, _ := interceptors.NewHeartbeatInterceptor(marshalizer, createMockThrottler(), antiflood, createMockHeartbeatValidator())
+
+	err := hi.ProcessReceivedMessage(createHeartbeatMessage(marshalizer, createValidHeartbeat()), "")
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestHeartbeatInterceptor_ProcessReceivedMessageMalformedHeartbeatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	throttler := createMockThrottler()
+	hi, _ := interceptors.NewHeartbeatInterceptor(marshalizer, throttler, &mock.P2PAntifloodHandlerStub{}, createMockHeartbeatValidator())
+	_ = hi.SetHeartbeatProcessor(&mock.HeartbeatProcessorStub{
+		ProcessInterceptedHeartbeatCalled: func(hb *heartbeatData.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			assert.Fail(t, "malformed heartbeat should not have been forwarded")
+			return nil
+		},
+	})
+
+	err := hi.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("not a heartbeat")}, "")
+	assert.NotNil(t, err)
+
+	hb := createValidHeartbeat()
+	hb.Signature = nil
+	err = hi.ProcessReceivedMessage(createHeartbeatMessage(marshalizer, hb), "")
+	assert.True(t, errors.Is(err, process.ErrInvalidHeartbeat))
+
+	assert.Equal(t, throttler.StartProcessingCount(), throttler.EndProcessingCount())
+}
+
+func TestHeartbeatInterceptor_ProcessReceivedMessageShouldForwardToHeartbeatProcessor(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hi, _ := interceptors.NewHeartbeatInterceptor(marshalizer, createMockThrottler(), &mock.P2PAntifloodHandlerStub{}, createMockHeartbeatValidator())
+
+	forwarded := false
+	err := hi.SetHeartbeatProcessor(&mock.HeartbeatProcessorStub{
+		ProcessInterceptedHeartbeatCalled: func(hb *heartbeatData.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			forwarded = true
+			return nil
+		},
+	})
+	assert.Nil(t, err)
+
+	err = hi.ProcessReceivedMessage(createHeartbeatMessage(marshalizer, createValidHeartbeat()), "")
+
+	assert.Nil(t, err)
+	assert.True(t, forwarded)
+}
+
+func TestHeartbeatInterceptor_ProcessReceivedMessageWithoutHeartbeatProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	throttler := createMockThrottler()
+	hi, _ := interceptors.NewHeartbeatInterceptor(marshalizer, throttler, &mock.P2PAntifloodHandlerStub{}, createMockHeartbeatValidator())
+
+	err := hi.ProcessReceivedMessage(createHeartbeatMessage(marshalizer, createValidHeartbeat()), "")
+
+	assert.Equal(t, process.ErrHeartbeatProcessorNotSet, err)
+	assert.Equal(t, throttler.StartProcessingCount(), throttler.EndProcessingCount())
+}
+
+func TestHeartbeatInterceptor_SetNilHeartbeatProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hi, _ := interceptors.NewHeartbeatInterceptor(&mock.MarshalizerMock{}, createMockThrottler(), &mock.P2PAntifloodHandlerStub{}, createMockHeartbeatValidator())
+
+	err := hi.SetHeartbeatProcessor(nil)
+
+	assert.Equal(t, process.ErrNilHeartbeatProcessor, err)
+}
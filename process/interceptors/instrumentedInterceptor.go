@@ -0,0 +1,55 @@
+package interceptors
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// instrumentedInterceptor wraps an interceptor and reports the time spent on each received message to a metrics sink
+type instrumentedInterceptor struct {
+	process.Interceptor
+	topic       string
+	metricsSink process.InterceptorMetricsSink
+}
+
+// NewInstrumentedInterceptor creates a new interceptor that records the processing latency of the wrapped interceptor
+// for the provided topic
+func NewInstrumentedInterceptor(
+	topic string,
+	interceptor process.Interceptor,
+	metricsSink process.InterceptorMetricsSink,
+) (*instrumentedInterceptor, error) {
+	if len(topic) == 0 {
+		return nil, process.ErrEmptyTopic
+	}
+	if check.IfNil(interceptor) {
+		return nil, process.ErrNilInterceptor
+	}
+	if check.IfNil(metricsSink) {
+		return nil, process.ErrNilInterceptorMetricsSink
+	}
+
+	return &instrumentedInterceptor{
+		Interceptor: interceptor,
+		topic:       topic,
+		metricsSink: metricsSink,
+	}, nil
+}
+
+// ProcessReceivedMessage calls the wrapped interceptor and records the time it took to handle the message
+func (ii *instrumentedInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	startTime := time.Now()
+	err := ii.Interceptor.ProcessReceivedMessage(message, fromConnectedPeer)
+	ii.metricsSink.ObserveProcessingLatency(ii.topic, time.Since(startTime))
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ii *instrumentedInterceptor) IsInterfaceNil() bool {
+	return ii == nil
+}
@@ -0,0 +1,71 @@
+package interceptors_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInstrumentedInterceptor_EmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ii, err := interceptors.NewInstrumentedInterceptor("", &mock.InterceptorStub{}, &mock.InterceptorMetricsSinkStub{})
+
+	assert.Nil(t, ii)
+	assert.Equal(t, process.ErrEmptyTopic, err)
+}
+
+func TestNewInstrumentedInterceptor_NilInterceptorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ii, err := interceptors.NewInstrumentedInterceptor("topic", nil, &mock.InterceptorMetricsSinkStub{})
+
+	assert.Nil(t, ii)
+	assert.Equal(t, process.ErrNilInterceptor, err)
+}
+
+func TestNewInstrumentedInterceptor_NilMetricsSinkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ii, err := interceptors.NewInstrumentedInterceptor("topic", &mock.InterceptorStub{}, nil)
+
+	assert.Nil(t, ii)
+	assert.Equal(t, process.ErrNilInterceptorMetricsSink, err)
+}
+
+func TestInstrumentedInterceptor_ProcessReceivedMessageShouldRecordTheLatencyForTheTopic(t *testing.T) {
+	t.Parallel()
+
+	processingTime := time.Millisecond * 10
+	expectedErr := errors.New("expected error")
+	interceptor := &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			time.Sleep(processingTime)
+			return expectedErr
+		},
+	}
+	observedLatencies := make(map[string][]time.Duration)
+	sink := &mock.InterceptorMetricsSinkStub{
+		ObserveProcessingLatencyCalled: func(topic string, latency time.Duration) {
+			observedLatencies[topic] = append(observedLatencies[topic], latency)
+		},
+	}
+	ii, _ := interceptors.NewInstrumentedInterceptor("topic", interceptor, sink)
+
+	err := ii.ProcessReceivedMessage(&mock.P2PMessageMock{}, "pid")
+	assert.Equal(t, expectedErr, err)
+	_ = ii.ProcessReceivedMessage(&mock.P2PMessageMock{}, "pid")
+
+	latencies := observedLatencies["topic"]
+	assert.Equal(t, 2, len(latencies))
+	for _, latency := range latencies {
+		assert.True(t, latency >= processingTime)
+	}
+	assert.False(t, ii.IsInterfaceNil())
+}
@@ -128,12 +128,12 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		shouldProcess := isForCurrentShard || isWhiteListed
 		if !shouldProcess {
 			log.Trace("intercepted data should not be processed",
-				"pid", p2p.MessageOriginatorPid(message),
-				"seq no", p2p.MessageOriginatorSeq(message),
-				"topics", message.Topics(),
-				"hash", interceptedData.Hash(),
-				"is for this shard", isForCurrentShard,
-				"is white listed", isWhiteListed,
+				getNotForCurrentShardLogArgs(message, interceptedData, isForCurrentShard, isWhiteListed)...)
+			processDebugInterceptedData(
+				mdi.interceptedDebugHandler,
+				interceptedData,
+				mdi.topic,
+				createNotForCurrentShardError(interceptedData),
 			)
 			wgProcess.Done()
 			continue
@@ -96,12 +96,12 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	if !shouldProcess {
 		sdi.throttler.EndProcessing()
 		log.Trace("intercepted data is for other shards",
-			"pid", p2p.MessageOriginatorPid(message),
-			"seq no", p2p.MessageOriginatorSeq(message),
-			"topics", message.Topics(),
-			"hash", interceptedData.Hash(),
-			"is for current shard", isForCurrentShard,
-			"is white listed", isWhiteListed,
+			getNotForCurrentShardLogArgs(message, interceptedData, isForCurrentShard, isWhiteListed)...)
+		processDebugInterceptedData(
+			sdi.interceptedDebugHandler,
+			interceptedData,
+			sdi.topic,
+			createNotForCurrentShardError(interceptedData),
 		)
 
 		return nil
@@ -2,6 +2,7 @@ package interceptors_test
 
 import (
 	"errors"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -262,6 +263,59 @@ func testProcessReceiveMessage(t *testing.T, isForCurrentShard bool, validityErr
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
 }
 
+func TestSingleDataInterceptor_ProcessReceivedMessageNotForCurrentShardShouldLogShards(t *testing.T) {
+	t.Parallel()
+
+	interceptedData := &mock.InterceptedDataShardsStub{
+		InterceptedDataStub: mock.InterceptedDataStub{
+			CheckValidityCalled: func() error {
+				return nil
+			},
+			IsForCurrentShardCalled: func() bool {
+				return false
+			},
+		},
+		SenderShardIdCalled: func() uint32 {
+			return 1
+		},
+		ReceiverShardIdCalled: func() uint32 {
+			return 2
+		},
+		SelfShardIdCalled: func() uint32 {
+			return 0
+		},
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(
+		testTopic,
+		&mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+				return interceptedData, nil
+			},
+		},
+		createMockInterceptorStub(nil, nil),
+		createMockThrottler(),
+		&mock.P2PAntifloodHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+	)
+	var loggedErr error
+	_ = sdi.SetInterceptedDebugHandler(&mock.InterceptedDebugHandlerStub{
+		LogProcessedHashesCalled: func(topic string, hashes [][]byte, err error) {
+			loggedErr = err
+		},
+	})
+
+	msg := &mock.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	require.True(t, errors.Is(loggedErr, process.ErrInterceptedDataNotForCurrentShard))
+	assert.True(t, strings.Contains(loggedErr.Error(), "sender shard: 1"))
+	assert.True(t, strings.Contains(loggedErr.Error(), "receiver shard: 2"))
+	assert.True(t, strings.Contains(loggedErr.Error(), "self shard: 0"))
+}
+
 func TestSingleDataInterceptor_ProcessReceivedMessageWhitelistedShouldWork(t *testing.T) {
 	t.Parallel()
 
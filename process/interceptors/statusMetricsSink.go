@@ -0,0 +1,65 @@
+package interceptors
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type topicLatency struct {
+	numMessages    uint64
+	totalLatencyUs uint64
+	maxLatencyUs   uint64
+}
+
+// statusMetricsSink records the processing latency of the intercepted messages as per topic status metrics: the number
+// of processed messages, the total and the maximum processing time. The average latency of a topic is obtained by
+// dividing the total time by the number of messages
+type statusMetricsSink struct {
+	appStatusHandler core.AppStatusHandler
+	mutLatencies     sync.Mutex
+	latencies        map[string]*topicLatency
+}
+
+// NewStatusMetricsSink creates a new interceptor metrics sink which reports to the provided status handler
+func NewStatusMetricsSink(appStatusHandler core.AppStatusHandler) (*statusMetricsSink, error) {
+	if check.IfNil(appStatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	return &statusMetricsSink{
+		appStatusHandler: appStatusHandler,
+		latencies:        make(map[string]*topicLatency),
+	}, nil
+}
+
+// ObserveProcessingLatency adds the processing latency of a message to the metrics of the provided topic
+func (sms *statusMetricsSink) ObserveProcessingLatency(topic string, latency time.Duration) {
+	latencyUs := uint64(latency.Microseconds())
+
+	sms.mutLatencies.Lock()
+	tl, ok := sms.latencies[topic]
+	if !ok {
+		tl = &topicLatency{}
+		sms.latencies[topic] = tl
+	}
+	tl.numMessages++
+	tl.totalLatencyUs += latencyUs
+	if latencyUs > tl.maxLatencyUs {
+		tl.maxLatencyUs = latencyUs
+	}
+	current := *tl
+	sms.mutLatencies.Unlock()
+
+	sms.appStatusHandler.SetUInt64Value(core.MetricInterceptorMessagesTotalPrefix+topic, current.numMessages)
+	sms.appStatusHandler.SetUInt64Value(core.MetricInterceptorLatencyTotalUsPrefix+topic, current.totalLatencyUs)
+	sms.appStatusHandler.SetUInt64Value(core.MetricInterceptorLatencyMaxUsPrefix+topic, current.maxLatencyUs)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sms *statusMetricsSink) IsInterfaceNil() bool {
+	return sms == nil
+}
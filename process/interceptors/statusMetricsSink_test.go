@@ -0,0 +1,46 @@
+package interceptors_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStatusMetricsSink_NilAppStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sms, err := interceptors.NewStatusMetricsSink(nil)
+
+	assert.Nil(t, sms)
+	assert.Equal(t, process.ErrNilAppStatusHandler, err)
+}
+
+func TestStatusMetricsSink_ObserveProcessingLatencyShouldSetTheTopicMetrics(t *testing.T) {
+	t.Parallel()
+
+	mutMetrics := sync.Mutex{}
+	metrics := make(map[string]uint64)
+	sms, _ := interceptors.NewStatusMetricsSink(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mutMetrics.Lock()
+			metrics[key] = value
+			mutMetrics.Unlock()
+		},
+	})
+
+	sms.ObserveProcessingLatency("topic", 3*time.Millisecond)
+	sms.ObserveProcessingLatency("topic", time.Millisecond)
+	sms.ObserveProcessingLatency("other topic", 2*time.Microsecond)
+
+	assert.Equal(t, uint64(2), metrics[core.MetricInterceptorMessagesTotalPrefix+"topic"])
+	assert.Equal(t, uint64(4000), metrics[core.MetricInterceptorLatencyTotalUsPrefix+"topic"])
+	assert.Equal(t, uint64(3000), metrics[core.MetricInterceptorLatencyMaxUsPrefix+"topic"])
+	assert.Equal(t, uint64(1), metrics[core.MetricInterceptorMessagesTotalPrefix+"other topic"])
+	assert.Equal(t, uint64(2), metrics[core.MetricInterceptorLatencyMaxUsPrefix+"other topic"])
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
@@ -94,6 +95,14 @@ type InterceptedData interface {
 	String() string
 }
 
+// InterceptedDataShards defines the intercepted data able to report the shards it claims to be sent from and to,
+// along with the self shard it was checked against
+type InterceptedDataShards interface {
+	SenderShardId() uint32
+	ReceiverShardId() uint32
+	SelfShardId() uint32
+}
+
 // InterceptorProcessor further validates and saves received data
 type InterceptorProcessor interface {
 	Validate(data InterceptedData, fromConnectedPeer core.PeerID) error
@@ -252,6 +261,8 @@ type TransactionLogProcessorDatabase interface {
 // ValidatorsProvider is the main interface for validators' provider
 type ValidatorsProvider interface {
 	GetLatestValidators() map[string]*state.ValidatorApiResponse
+	GetValidatorsForEpoch(epoch uint32) (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorsPage(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int)
 	IsInterfaceNil() bool
 }
 
@@ -331,6 +342,7 @@ type InterceptorsContainer interface {
 // InterceptorsContainerFactory defines the functionality to create an interceptors container
 type InterceptorsContainerFactory interface {
 	Create() (InterceptorsContainer, error)
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -444,6 +456,7 @@ type TopicHandler interface {
 	HasTopic(name string) bool
 	CreateTopic(name string, createChannelForTopic bool) error
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
+	UnregisterMessageProcessor(topic string) error
 	IsInterfaceNil() bool
 }
 
@@ -604,6 +617,12 @@ type SCQuery struct {
 	Arguments [][]byte
 }
 
+// SCQueryResult holds the outcome of an asynchronously executed smart contract query
+type SCQueryResult struct {
+	VMOutput *vmcommon.VMOutput
+	Err      error
+}
+
 // GasHandler is able to perform some gas calculation
 type GasHandler interface {
 	Init()
@@ -689,6 +708,7 @@ type BlockTracker interface {
 // on a provided identifier between Reset calls
 type FloodPreventer interface {
 	IncreaseLoad(pid core.PeerID, size uint64) error
+	IncreaseLoadWithRemaining(pid core.PeerID, size uint64) (uint32, uint64, error)
 	ApplyConsensusSize(size int)
 	Reset()
 	IsInterfaceNil() bool
@@ -843,6 +863,19 @@ type WhiteListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// HeartbeatValidator defines the behavior of a component able to validate the received heartbeat messages
+type HeartbeatValidator interface {
+	ValidateHeartbeat(hb *heartbeatData.Heartbeat, now time.Time) error
+	IsInterfaceNil() bool
+}
+
+// HeartbeatProcessor defines the component which processes the heartbeat messages forwarded by the heartbeat
+// interceptor, which already unmarshalled them and charged them to the antiflood handler
+type HeartbeatProcessor interface {
+	ProcessInterceptedHeartbeat(hb *heartbeatData.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	IsInterfaceNil() bool
+}
+
 // InterceptedDebugger defines an interface for debugging the intercepted data
 type InterceptedDebugger interface {
 	LogReceivedHashes(topic string, hashes [][]byte)
@@ -850,6 +883,18 @@ type InterceptedDebugger interface {
 	IsInterfaceNil() bool
 }
 
+// RateLimiter defines the component able to bound the number of operations executed per time unit
+type RateLimiter interface {
+	TryAcquire() bool
+	IsInterfaceNil() bool
+}
+
+// InterceptorMetricsSink defines the component able to record the processing latency of the intercepted messages
+type InterceptorMetricsSink interface {
+	ObserveProcessingLatency(topic string, latency time.Duration)
+	IsInterfaceNil() bool
+}
+
 // AntifloodDebugger defines an interface for debugging the antiflood behavior
 type AntifloodDebugger interface {
 	AddData(pid core.PeerID, topic string, numRejected uint32, sizeRejected uint64, isBlacklisted bool)
@@ -870,6 +915,14 @@ type PoolsCleaner interface {
 	IsInterfaceNil() bool
 }
 
+// TxsPoolsCleaner defines the functionality of a transactions pools cleaner which can also be triggered on demand
+type TxsPoolsCleaner interface {
+	PoolsCleaner
+	ForceClean() int
+	WhitelistTxs(hashes [][]byte)
+	UnwhitelistTxs(hashes [][]byte)
+}
+
 // EpochHandler defines what a component which handles current epoch should be able to do
 type EpochHandler interface {
 	MetaEpoch() uint32
@@ -888,5 +941,13 @@ type NodesCoordinator interface {
 	GetAllEligibleValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
 	GetAllWaitingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
 	GetAllLeavingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
+	ShardIdForEpoch(epoch uint32) (uint32, error)
+	IsInterfaceNil() bool
+}
+
+// SyncStateHandler keeps track of the sync state of the node, as notified by the bootstrapper
+type SyncStateHandler interface {
+	ReceivedSyncState(isNodeSynchronized bool)
+	IsSyncing() bool
 	IsInterfaceNil() bool
 }
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"errors"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -9,23 +10,32 @@ import (
 
 // AccountsStub -
 type AccountsStub struct {
-	AddJournalEntryCalled    func(je state.JournalEntry)
-	GetExistingAccountCalled func(address []byte) (state.AccountHandler, error)
-	LoadAccountCalled        func(address []byte) (state.AccountHandler, error)
-	SaveAccountCalled        func(account state.AccountHandler) error
-	RemoveAccountCalled      func(address []byte) error
-	CommitCalled             func() ([]byte, error)
-	JournalLenCalled         func() int
-	RevertToSnapshotCalled   func(snapshot int) error
-	RootHashCalled           func() ([]byte, error)
-	RecreateTrieCalled       func(rootHash []byte) error
-	PruneTrieCalled          func(rootHash []byte, identifier data.TriePruningIdentifier)
-	CancelPruneCalled        func(rootHash []byte, identifier data.TriePruningIdentifier)
-	SnapshotStateCalled      func(rootHash []byte)
-	SetStateCheckpointCalled func(rootHash []byte)
-	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
-	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
+	AddJournalEntryCalled         func(je state.JournalEntry)
+	GetExistingAccountCalled      func(address []byte) (state.AccountHandler, error)
+	LoadAccountCalled             func(address []byte) (state.AccountHandler, error)
+	SaveAccountCalled             func(account state.AccountHandler) error
+	RemoveAccountCalled           func(address []byte) error
+	CommitCalled                  func() ([]byte, error)
+	JournalLenCalled              func() int
+	RevertToSnapshotCalled        func(snapshot int) error
+	RootHashCalled                func() ([]byte, error)
+	RecreateTrieCalled            func(rootHash []byte) error
+	PruneTrieCalled               func(rootHash []byte, identifier data.TriePruningIdentifier)
+	CancelPruneCalled             func(rootHash []byte, identifier data.TriePruningIdentifier)
+	SnapshotStateCalled           func(rootHash []byte)
+	SetStateCheckpointCalled      func(rootHash []byte)
+	IsPruningEnabledCalled        func() bool
+	GetAllLeavesCalled            func(rootHash []byte) (map[string][]byte, error)
+	RecreateAllTriesCalled        func(rootHash []byte) (map[string]data.Trie, error)
+	GetBalancesBeforeCommitCalled func() map[string]*big.Int
+}
+
+// GetBalancesBeforeCommit -
+func (as *AccountsStub) GetBalancesBeforeCommit() map[string]*big.Int {
+	if as.GetBalancesBeforeCommitCalled != nil {
+		return as.GetBalancesBeforeCommitCalled()
+	}
+	return nil
 }
 
 // RecreateAllTries -
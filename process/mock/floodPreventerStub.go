@@ -4,9 +4,10 @@ import "github.com/ElrondNetwork/elrond-go/core"
 
 // FloodPreventerStub -
 type FloodPreventerStub struct {
-	IncreaseLoadCalled       func(pid core.PeerID, size uint64) error
-	ApplyConsensusSizeCalled func(size int)
-	ResetCalled              func()
+	IncreaseLoadCalled              func(pid core.PeerID, size uint64) error
+	IncreaseLoadWithRemainingCalled func(pid core.PeerID, size uint64) (uint32, uint64, error)
+	ApplyConsensusSizeCalled        func(size int)
+	ResetCalled                     func()
 }
 
 // IncreaseLoad -
@@ -14,6 +15,15 @@ func (fps *FloodPreventerStub) IncreaseLoad(pid core.PeerID, size uint64) error
 	return fps.IncreaseLoadCalled(pid, size)
 }
 
+// IncreaseLoadWithRemaining -
+func (fps *FloodPreventerStub) IncreaseLoadWithRemaining(pid core.PeerID, size uint64) (uint32, uint64, error) {
+	if fps.IncreaseLoadWithRemainingCalled != nil {
+		return fps.IncreaseLoadWithRemainingCalled(pid, size)
+	}
+
+	return 0, 0, fps.IncreaseLoad(pid, size)
+}
+
 // ApplyConsensusSize -
 func (fps *FloodPreventerStub) ApplyConsensusSize(size int) {
 	if fps.ApplyConsensusSizeCalled != nil {
@@ -20,6 +20,7 @@ type HeaderHandlerStub struct {
 	CheckChainIDCalled               func(reference []byte) error
 	GetAccumulatedFeesCalled         func() *big.Int
 	GetDeveloperFeesCalled           func() *big.Int
+	GetEpochCalled                   func() uint32
 }
 
 // GetAccumulatedFees -
@@ -79,7 +80,10 @@ func (hhs *HeaderHandlerStub) GetNonce() uint64 {
 
 // GetEpoch -
 func (hhs *HeaderHandlerStub) GetEpoch() uint32 {
-	panic("implement me")
+	if hhs.GetEpochCalled != nil {
+		return hhs.GetEpochCalled()
+	}
+	return 0
 }
 
 // GetRound -
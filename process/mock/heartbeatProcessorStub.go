@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// HeartbeatProcessorStub -
+type HeartbeatProcessorStub struct {
+	ProcessInterceptedHeartbeatCalled func(hb *heartbeatData.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+}
+
+// ProcessInterceptedHeartbeat -
+func (hps *HeartbeatProcessorStub) ProcessInterceptedHeartbeat(hb *heartbeatData.Heartbeat, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if hps.ProcessInterceptedHeartbeatCalled != nil {
+		return hps.ProcessInterceptedHeartbeatCalled(hb, message, fromConnectedPeer)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (hps *HeartbeatProcessorStub) IsInterfaceNil() bool {
+	return hps == nil
+}
@@ -4,12 +4,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // IndexerMock is a mock implementation fot the Indexer interface
 type IndexerMock struct {
-	SaveBlockCalled func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	SaveBlockCalled               func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	SaveEpochStartEconomicsCalled func(epoch uint32, econ indexer.EpochEconomics)
+	SaveEpochStartInfoCalled      func(metaBlock *block.MetaBlock)
+	RevertIndexedBlockCalled      func(header data.HeaderHandler, body data.BodyHandler)
+	SaveLogsCalled                func(logs map[string]data.LogHandler)
+	SaveAccountsHistoryCalled     func(blockNonce uint64, changes []indexer.AccountBalanceChange)
+	IsNilIndexerCalled            func() bool
 }
 
 // SaveBlock -
@@ -28,6 +36,10 @@ func (im *IndexerMock) SaveValidatorsRating(_ string, _ []indexer.ValidatorRatin
 
 }
 
+// SaveValidatorsRatingHistory -
+func (im *IndexerMock) SaveValidatorsRatingHistory(_ uint32, _ map[string]*state.ValidatorApiResponse) {
+}
+
 // SaveMetaBlock -
 func (im *IndexerMock) SaveMetaBlock(_ data.HeaderHandler, _ []uint64) {
 }
@@ -37,21 +49,83 @@ func (im *IndexerMock) UpdateTPS(_ statistics.TPSBenchmark) {
 	panic("implement me")
 }
 
+// RevertIndexedBlock -
+func (im *IndexerMock) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if im.RevertIndexedBlockCalled != nil {
+		im.RevertIndexedBlockCalled(header, body)
+	}
+}
+
+// SaveLogs -
+func (im *IndexerMock) SaveLogs(logs map[string]data.LogHandler) {
+	if im.SaveLogsCalled != nil {
+		im.SaveLogsCalled(logs)
+	}
+}
+
 // SaveRoundInfo -
 func (im *IndexerMock) SaveRoundInfo(_ indexer.RoundInfo) {
 }
 
+// SaveRoundsInfo -
+func (im *IndexerMock) SaveRoundsInfo(_ []indexer.RoundInfo) {
+}
+
+// ReindexBlock -
+func (im *IndexerMock) ReindexBlock(_ []byte) error {
+	return nil
+}
+
 // SaveValidatorsPubKeys -
 func (im *IndexerMock) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 	panic("implement me")
 }
 
+// SaveAccountsHistory -
+func (im *IndexerMock) SaveAccountsHistory(blockNonce uint64, changes []indexer.AccountBalanceChange) {
+	if im.SaveAccountsHistoryCalled != nil {
+		im.SaveAccountsHistoryCalled(blockNonce, changes)
+	}
+}
+
+// SaveEpochStartEconomics -
+func (im *IndexerMock) SaveEpochStartEconomics(epoch uint32, econ indexer.EpochEconomics) {
+	if im.SaveEpochStartEconomicsCalled != nil {
+		im.SaveEpochStartEconomicsCalled(epoch, econ)
+	}
+}
+
+// SaveEpochStartInfo -
+func (im *IndexerMock) SaveEpochStartInfo(metaBlock *block.MetaBlock) {
+	if im.SaveEpochStartInfoCalled != nil {
+		im.SaveEpochStartInfoCalled(metaBlock)
+	}
+}
+
+// VerifyContiguity -
+func (im *IndexerMock) VerifyContiguity(_ uint64, _ uint64, _ uint32) ([]uint64, error) {
+	return nil, nil
+}
+
+// CheckHealth -
+func (im *IndexerMock) CheckHealth() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (im *IndexerMock) IsInterfaceNil() bool {
 	return im == nil
 }
 
+// Close -
+func (im *IndexerMock) Close() error {
+	return nil
+}
+
 // IsNilIndexer -
 func (im *IndexerMock) IsNilIndexer() bool {
+	if im.IsNilIndexerCalled != nil {
+		return im.IsNilIndexerCalled()
+	}
 	return true
 }
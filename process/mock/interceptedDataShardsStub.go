@@ -0,0 +1,41 @@
+package mock
+
+// InterceptedDataShardsStub -
+type InterceptedDataShardsStub struct {
+	InterceptedDataStub
+	SenderShardIdCalled   func() uint32
+	ReceiverShardIdCalled func() uint32
+	SelfShardIdCalled     func() uint32
+}
+
+// SenderShardId -
+func (idss *InterceptedDataShardsStub) SenderShardId() uint32 {
+	if idss.SenderShardIdCalled != nil {
+		return idss.SenderShardIdCalled()
+	}
+
+	return 0
+}
+
+// ReceiverShardId -
+func (idss *InterceptedDataShardsStub) ReceiverShardId() uint32 {
+	if idss.ReceiverShardIdCalled != nil {
+		return idss.ReceiverShardIdCalled()
+	}
+
+	return 0
+}
+
+// SelfShardId -
+func (idss *InterceptedDataShardsStub) SelfShardId() uint32 {
+	if idss.SelfShardIdCalled != nil {
+		return idss.SelfShardIdCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (idss *InterceptedDataShardsStub) IsInterfaceNil() bool {
+	return idss == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import "time"
+
+// InterceptorMetricsSinkStub -
+type InterceptorMetricsSinkStub struct {
+	ObserveProcessingLatencyCalled func(topic string, latency time.Duration)
+}
+
+// ObserveProcessingLatency -
+func (imss *InterceptorMetricsSinkStub) ObserveProcessingLatency(topic string, latency time.Duration) {
+	if imss.ObserveProcessingLatencyCalled != nil {
+		imss.ObserveProcessingLatencyCalled(topic, latency)
+	}
+}
+
+// IsInterfaceNil -
+func (imss *InterceptorMetricsSinkStub) IsInterfaceNil() bool {
+	return imss == nil
+}
@@ -26,6 +26,7 @@ type NodesCoordinatorMock struct {
 	GetAllWaitingValidatorsPublicKeysCalled  func() (map[uint32][][]byte, error)
 	GetAllLeavingValidatorsPublicKeysCalled  func() (map[uint32][][]byte, error)
 	ConsensusGroupSizeCalled                 func(uint32) int
+	ShardIdForEpochCalled                    func(epoch uint32) (uint32, error)
 }
 
 // NewNodesCoordinatorMock -
@@ -251,7 +252,11 @@ func (ncm *NodesCoordinatorMock) GetSavedStateKey() []byte {
 
 // ShardIdForEpoch returns the nodesCoordinator configured ShardId for specified epoch if epoch configuration exists,
 // otherwise error
-func (ncm *NodesCoordinatorMock) ShardIdForEpoch(_ uint32) (uint32, error) {
+func (ncm *NodesCoordinatorMock) ShardIdForEpoch(epoch uint32) (uint32, error) {
+	if ncm.ShardIdForEpochCalled != nil {
+		return ncm.ShardIdForEpochCalled(epoch)
+	}
+
 	return 0, nil
 }
 
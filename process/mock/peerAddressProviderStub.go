@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// PeerAddressProviderStub -
+type PeerAddressProviderStub struct {
+	PeerAddressCalled func(pid core.PeerID) string
+}
+
+// PeerAddress -
+func (paps *PeerAddressProviderStub) PeerAddress(pid core.PeerID) string {
+	if paps.PeerAddressCalled != nil {
+		return paps.PeerAddressCalled(pid)
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (paps *PeerAddressProviderStub) IsInterfaceNil() bool {
+	return paps == nil
+}
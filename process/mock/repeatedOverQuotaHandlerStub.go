@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// RepeatedOverQuotaHandlerStub -
+type RepeatedOverQuotaHandlerStub struct {
+	RepeatedOverQuotaCalled func(pid core.PeerID, numIntervals uint32)
+}
+
+// RepeatedOverQuota -
+func (rqhs *RepeatedOverQuotaHandlerStub) RepeatedOverQuota(pid core.PeerID, numIntervals uint32) {
+	if rqhs.RepeatedOverQuotaCalled != nil {
+		rqhs.RepeatedOverQuotaCalled(pid, numIntervals)
+	}
+}
+
+// IsInterfaceNil -
+func (rqhs *RepeatedOverQuotaHandlerStub) IsInterfaceNil() bool {
+	return rqhs == nil
+}
@@ -0,0 +1,28 @@
+package mock
+
+// SyncStateHandlerStub -
+type SyncStateHandlerStub struct {
+	ReceivedSyncStateCalled func(isNodeSynchronized bool)
+	IsSyncingCalled         func() bool
+}
+
+// ReceivedSyncState -
+func (sshs *SyncStateHandlerStub) ReceivedSyncState(isNodeSynchronized bool) {
+	if sshs.ReceivedSyncStateCalled != nil {
+		sshs.ReceivedSyncStateCalled(isNodeSynchronized)
+	}
+}
+
+// IsSyncing -
+func (sshs *SyncStateHandlerStub) IsSyncing() bool {
+	if sshs.IsSyncingCalled != nil {
+		return sshs.IsSyncingCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (sshs *SyncStateHandlerStub) IsInterfaceNil() bool {
+	return sshs == nil
+}
@@ -6,9 +6,10 @@ import (
 
 // TopicHandlerStub -
 type TopicHandlerStub struct {
-	HasTopicCalled                 func(name string) bool
-	CreateTopicCalled              func(name string, createChannelForTopic bool) error
-	RegisterMessageProcessorCalled func(topic string, handler p2p.MessageProcessor) error
+	HasTopicCalled                   func(name string) bool
+	CreateTopicCalled                func(name string, createChannelForTopic bool) error
+	RegisterMessageProcessorCalled   func(topic string, handler p2p.MessageProcessor) error
+	UnregisterMessageProcessorCalled func(topic string) error
 }
 
 // HasTopic -
@@ -26,6 +27,15 @@ func (ths *TopicHandlerStub) RegisterMessageProcessor(topic string, handler p2p.
 	return ths.RegisterMessageProcessorCalled(topic, handler)
 }
 
+// UnregisterMessageProcessor -
+func (ths *TopicHandlerStub) UnregisterMessageProcessor(topic string) error {
+	if ths.UnregisterMessageProcessorCalled != nil {
+		return ths.UnregisterMessageProcessorCalled(topic)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ths *TopicHandlerStub) IsInterfaceNil() bool {
 	return ths == nil
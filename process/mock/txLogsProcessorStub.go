@@ -7,12 +7,34 @@ import (
 
 // TxLogsProcessorStub -
 type TxLogsProcessorStub struct {
-	GetLogCalled func(txHash []byte) (data.LogHandler, error)
-	SaveLogCalled func(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error
+	GetLogCalled          func(txHash []byte) (data.LogHandler, error)
+	SaveLogCalled         func(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error
+	GetLogFromCacheCalled func(txHash []byte) (data.LogHandler, bool)
+	CleanCalled           func()
+}
+
+// GetLogFromCache -
+func (txls *TxLogsProcessorStub) GetLogFromCache(txHash []byte) (data.LogHandler, bool) {
+	if txls.GetLogFromCacheCalled != nil {
+		return txls.GetLogFromCacheCalled(txHash)
+	}
+
+	return nil, false
+}
+
+// EnableLogToBeSavedInCache -
+func (txls *TxLogsProcessorStub) EnableLogToBeSavedInCache() {
+}
+
+// Clean -
+func (txls *TxLogsProcessorStub) Clean() {
+	if txls.CleanCalled != nil {
+		txls.CleanCalled()
+	}
 }
 
 // GetLog -
-func(txls *TxLogsProcessorStub) GetLog(txHash []byte) (data.LogHandler, error) {
+func (txls *TxLogsProcessorStub) GetLog(txHash []byte) (data.LogHandler, error) {
 	if txls.GetLogCalled != nil {
 		return txls.GetLogCalled(txHash)
 	}
@@ -21,7 +43,7 @@ func(txls *TxLogsProcessorStub) GetLog(txHash []byte) (data.LogHandler, error) {
 }
 
 // SaveLog -
-func(txls *TxLogsProcessorStub) SaveLog(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error {
+func (txls *TxLogsProcessorStub) SaveLog(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error {
 	if txls.SaveLogCalled != nil {
 		return txls.SaveLogCalled(txHash, tx, vmLogs)
 	}
@@ -30,6 +52,6 @@ func(txls *TxLogsProcessorStub) SaveLog(txHash []byte, tx data.TransactionHandle
 }
 
 // IsInterfaceNil -
-func(txls *TxLogsProcessorStub) IsInterfaceNil() bool {
+func (txls *TxLogsProcessorStub) IsInterfaceNil() bool {
 	return txls == nil
 }
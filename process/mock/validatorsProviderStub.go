@@ -4,7 +4,9 @@ import "github.com/ElrondNetwork/elrond-go/data/state"
 
 // ValidatorsProviderStub -
 type ValidatorsProviderStub struct {
-	GetLatestValidatorsCalled func() map[string]*state.ValidatorApiResponse
+	GetLatestValidatorsCalled   func() map[string]*state.ValidatorApiResponse
+	GetValidatorsForEpochCalled func(epoch uint32) (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorsPageCalled     func(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int)
 }
 
 // GetLatestValidators -
@@ -15,6 +17,22 @@ func (vp *ValidatorsProviderStub) GetLatestValidators() map[string]*state.Valida
 	return nil
 }
 
+// GetValidatorsForEpoch -
+func (vp *ValidatorsProviderStub) GetValidatorsForEpoch(epoch uint32) (map[string]*state.ValidatorApiResponse, error) {
+	if vp.GetValidatorsForEpochCalled != nil {
+		return vp.GetValidatorsForEpochCalled(epoch)
+	}
+	return nil, nil
+}
+
+// GetValidatorsPage -
+func (vp *ValidatorsProviderStub) GetValidatorsPage(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+	if vp.GetValidatorsPageCalled != nil {
+		return vp.GetValidatorsPageCalled(offset, limit, sortBy)
+	}
+	return nil, 0
+}
+
 // IsInterfaceNil -
 func (vp *ValidatorsProviderStub) IsInterfaceNil() bool {
 	return vp == nil
@@ -3,6 +3,8 @@ package peer
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +19,15 @@ import (
 
 var _ process.ValidatorsProvider = (*validatorsProvider)(nil)
 
+// SortByRating is the sort criterion used to page the validators in descending rating order
+const SortByRating = "rating"
+
+// SortByPublicKey is the sort criterion used to page the validators in ascending public key order
+const SortByPublicKey = "pubkey"
+
+// maxNumPastEpochs is the number of past epochs for which the validators set can be rebuilt and cached
+const maxNumPastEpochs = 10
+
 // validatorsProvider is the main interface for validators' provider
 type validatorsProvider struct {
 	nodesCoordinator             process.NodesCoordinator
@@ -30,6 +41,11 @@ type validatorsProvider struct {
 	cancelFunc                   func()
 	maxRating                    uint32
 	pubkeyConverter              core.PubkeyConverter
+	chanceComputer               sharding.ChanceComputer
+	mutEpochsCache               sync.RWMutex
+	epochsRootHashes             map[uint32][]byte
+	epochsCache                  map[uint32]map[string]*state.ValidatorApiResponse
+	selfShardID                  uint32
 }
 
 // ArgValidatorsProvider contains all parameters needed for creating a validatorsProvider
@@ -41,6 +57,7 @@ type ArgValidatorsProvider struct {
 	ValidatorStatistics               process.ValidatorStatisticsProcessor
 	MaxRating                         uint32
 	PubKeyConverter                   core.PubkeyConverter
+	ChanceComputer                    sharding.ChanceComputer
 }
 
 // NewValidatorsProvider instantiates a new validatorsProvider structure responsible of keeping account of
@@ -67,6 +84,11 @@ func NewValidatorsProvider(
 		return nil, process.ErrInvalidCacheRefreshIntervalInSec
 	}
 
+	selfShardID, err := args.NodesCoordinator.ShardIdForEpoch(args.StartEpoch)
+	if err != nil {
+		selfShardID = core.AllShardId
+	}
+
 	currentContext, cancelfunc := context.WithCancel(context.Background())
 
 	validatorsProvider := &validatorsProvider{
@@ -79,7 +101,11 @@ func NewValidatorsProvider(
 		cancelFunc:                   cancelfunc,
 		maxRating:                    args.MaxRating,
 		pubkeyConverter:              args.PubKeyConverter,
+		chanceComputer:               args.ChanceComputer,
 		currentEpoch:                 args.StartEpoch,
+		epochsRootHashes:             make(map[uint32][]byte),
+		epochsCache:                  make(map[uint32]map[string]*state.ValidatorApiResponse),
+		selfShardID:                  selfShardID,
 	}
 
 	go validatorsProvider.startRefreshProcess(currentContext)
@@ -105,6 +131,92 @@ func (vp *validatorsProvider) GetLatestValidators() map[string]*state.ValidatorA
 	return clonedMap
 }
 
+// GetValidatorsForEpoch returns the validators set of the provided epoch. The set of a past epoch is rebuilt from the
+// last validators root hash seen during that epoch and cached. An error is returned if the root hash of the epoch is
+// not known or if its state was pruned
+func (vp *validatorsProvider) GetValidatorsForEpoch(epoch uint32) (map[string]*state.ValidatorApiResponse, error) {
+	vp.lock.RLock()
+	currentEpoch := vp.currentEpoch
+	vp.lock.RUnlock()
+
+	if epoch == currentEpoch {
+		return vp.GetLatestValidators(), nil
+	}
+	if epoch > currentEpoch {
+		return nil, fmt.Errorf("%w, requested epoch %d, current epoch %d",
+			process.ErrValidatorsForEpochNotAvailable, epoch, currentEpoch)
+	}
+
+	vp.mutEpochsCache.RLock()
+	cachedValidators, isCached := vp.epochsCache[epoch]
+	rootHash := vp.epochsRootHashes[epoch]
+	vp.mutEpochsCache.RUnlock()
+
+	if isCached {
+		return cloneMap(cachedValidators), nil
+	}
+	if len(rootHash) == 0 {
+		return nil, fmt.Errorf("%w, unknown root hash for epoch %d", process.ErrValidatorsForEpochNotAvailable, epoch)
+	}
+
+	allNodes, err := vp.validatorStatistics.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", process.ErrValidatorsForEpochNotAvailable, epoch, err.Error())
+	}
+
+	validators := vp.createNewCache(epoch, allNodes)
+
+	vp.mutEpochsCache.Lock()
+	if _, isTracked := vp.epochsRootHashes[epoch]; isTracked {
+		vp.epochsCache[epoch] = validators
+	}
+	vp.mutEpochsCache.Unlock()
+
+	return cloneMap(validators), nil
+}
+
+// GetValidatorsPage returns at most limit validators starting from the provided offset, sorted by the provided
+// criterion, along with the total number of validators. Unknown criteria will sort the validators by public key
+func (vp *validatorsProvider) GetValidatorsPage(offset int, limit int, sortBy string) ([]state.ValidatorApiResponseWithKey, int) {
+	validators := vp.GetLatestValidators()
+	sortedValidators := make([]state.ValidatorApiResponseWithKey, 0, len(validators))
+	for pubKey, validator := range validators {
+		if validator == nil {
+			continue
+		}
+
+		sortedValidators = append(sortedValidators, state.ValidatorApiResponseWithKey{
+			PublicKey:            pubKey,
+			ValidatorApiResponse: *validator,
+		})
+	}
+
+	sortValidators(sortedValidators, sortBy)
+
+	total := len(sortedValidators)
+	if offset < 0 || limit <= 0 || offset >= total {
+		return make([]state.ValidatorApiResponseWithKey, 0), total
+	}
+
+	// compared against the remaining validators so that a large limit can not overflow
+	end := total
+	if limit < total-offset {
+		end = offset + limit
+	}
+
+	return sortedValidators[offset:end], total
+}
+
+func sortValidators(validators []state.ValidatorApiResponseWithKey, sortBy string) {
+	sort.Slice(validators, func(i, j int) bool {
+		if sortBy == SortByRating && validators[i].Rating != validators[j].Rating {
+			return validators[i].Rating > validators[j].Rating
+		}
+
+		return validators[i].PublicKey < validators[j].PublicKey
+	})
+}
+
 func cloneMap(cache map[string]*state.ValidatorApiResponse) map[string]*state.ValidatorApiResponse {
 	newMap := make(map[string]*state.ValidatorApiResponse)
 
@@ -133,6 +245,7 @@ func cloneValidatorAPIResponse(v *state.ValidatorApiResponse) *state.ValidatorAp
 		TotalNumValidatorFailure: v.TotalNumValidatorFailure,
 		ShardId:                  v.ShardId,
 		ValidatorStatus:          v.ValidatorStatus,
+		SelectionChancePercent:   v.SelectionChancePercent,
 	}
 }
 
@@ -144,6 +257,7 @@ func (vp *validatorsProvider) epochStartEventHandler() sharding.EpochStartAction
 				"shard", hdr.GetShardID(),
 				"round", hdr.GetRound(),
 				"epoch", hdr.GetEpoch())
+			vp.handleShardChange(hdr.GetEpoch())
 			go func() {
 				vp.refreshCache <- hdr.GetEpoch()
 			}()
@@ -155,6 +269,37 @@ func (vp *validatorsProvider) epochStartEventHandler() sharding.EpochStartAction
 	return subscribeHandler
 }
 
+// handleShardChange drops all the cached validators if the node was moved to another shard in the provided epoch, as
+//  they were built on the assumptions of the old shard. The next call of GetLatestValidators will rebuild the cache
+func (vp *validatorsProvider) handleShardChange(epoch uint32) {
+	newShardID, err := vp.nodesCoordinator.ShardIdForEpoch(epoch)
+	if err != nil {
+		log.Debug("validatorsProvider - ShardIdForEpoch failed", "epoch", epoch, "error", err)
+		return
+	}
+
+	vp.lock.Lock()
+	oldShardID := vp.selfShardID
+	if oldShardID == newShardID {
+		vp.lock.Unlock()
+		return
+	}
+	vp.selfShardID = newShardID
+	vp.cache = make(map[string]*state.ValidatorApiResponse)
+	vp.lastCacheUpdate = time.Time{}
+	vp.lock.Unlock()
+
+	vp.mutEpochsCache.Lock()
+	vp.epochsRootHashes = make(map[uint32][]byte)
+	vp.epochsCache = make(map[uint32]map[string]*state.ValidatorApiResponse)
+	vp.mutEpochsCache.Unlock()
+
+	log.Debug("validatorsProvider - cache cleared on shard change",
+		"epoch", epoch,
+		"old shard", oldShardID,
+		"new shard", newShardID)
+}
+
 func (vp *validatorsProvider) startRefreshProcess(ctx context.Context) {
 	for {
 		vp.updateCache()
@@ -186,6 +331,8 @@ func (vp *validatorsProvider) updateCache() {
 	epoch := vp.currentEpoch
 	vp.lock.RUnlock()
 
+	vp.setEpochRootHash(epoch, lastFinalizedRootHash)
+
 	newCache := vp.createNewCache(epoch, allNodes)
 
 	vp.lock.Lock()
@@ -194,6 +341,25 @@ func (vp *validatorsProvider) updateCache() {
 	vp.lock.Unlock()
 }
 
+// setEpochRootHash remembers the last root hash seen in the provided epoch and evicts the epochs that are too old
+func (vp *validatorsProvider) setEpochRootHash(epoch uint32, rootHash []byte) {
+	vp.mutEpochsCache.Lock()
+	defer vp.mutEpochsCache.Unlock()
+
+	if vp.epochsRootHashes == nil {
+		vp.epochsRootHashes = make(map[uint32][]byte)
+		vp.epochsCache = make(map[uint32]map[string]*state.ValidatorApiResponse)
+	}
+
+	vp.epochsRootHashes[epoch] = rootHash
+	for trackedEpoch := range vp.epochsRootHashes {
+		if trackedEpoch+maxNumPastEpochs < epoch {
+			delete(vp.epochsRootHashes, trackedEpoch)
+			delete(vp.epochsCache, trackedEpoch)
+		}
+	}
+}
+
 func (vp *validatorsProvider) createNewCache(
 	epoch uint32,
 	allNodes map[uint32][]*state.ValidatorInfo,
@@ -212,9 +378,55 @@ func (vp *validatorsProvider) createNewCache(
 	}
 	vp.aggregateLists(newCache, nodesMapWaiting, core.WaitingList)
 
+	vp.computeSelectionChances(newCache, allNodes)
+
 	return newCache
 }
 
+// computeSelectionChances sets the chance of each eligible validator to be selected as proposer in its shard, using
+// the same rating based chances as the nodes coordinator. The field is left zero if the chances can not be computed
+func (vp *validatorsProvider) computeSelectionChances(
+	newCache map[string]*state.ValidatorApiResponse,
+	allNodes map[uint32][]*state.ValidatorInfo,
+) {
+	if check.IfNil(vp.chanceComputer) {
+		return
+	}
+
+	tempRatings := make(map[string]uint32)
+	for _, validatorInfosInShard := range allNodes {
+		for _, validatorInfo := range validatorInfosInShard {
+			tempRatings[vp.pubkeyConverter.Encode(validatorInfo.PublicKey)] = validatorInfo.TempRating
+		}
+	}
+
+	chances := make(map[string]uint32)
+	chancesPerShard := make(map[uint32]uint64)
+	for encodedKey, validator := range newCache {
+		if !strings.HasPrefix(validator.ValidatorStatus, string(core.EligibleList)) {
+			continue
+		}
+		tempRating, ok := tempRatings[encodedKey]
+		if !ok {
+			continue
+		}
+
+		chance := vp.chanceComputer.GetChance(tempRating)
+		chances[encodedKey] = chance
+		chancesPerShard[validator.ShardId] += uint64(chance)
+	}
+
+	for encodedKey, chance := range chances {
+		validator := newCache[encodedKey]
+		totalChances := chancesPerShard[validator.ShardId]
+		if totalChances == 0 {
+			continue
+		}
+
+		validator.SelectionChancePercent = float32(chance) * 100 / float32(totalChances)
+	}
+}
+
 func (vp *validatorsProvider) createValidatorApiResponseMapFromValidatorInfoMap(allNodes map[uint32][]*state.ValidatorInfo) map[string]*state.ValidatorApiResponse {
 	newCache := make(map[string]*state.ValidatorApiResponse)
 	inactiveList := string(core.InactiveList)
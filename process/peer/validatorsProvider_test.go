@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewValidatorsProvider_WithNilValidatorStatisticsShouldErr(t *testing.T) {
@@ -628,6 +629,282 @@ func TestValidatorsProvider_DoesntCallUpdateUpdateCacheWithoutRequests(t *testin
 	assert.Equal(t, 1, len(resp))
 	assert.NotNil(t, vsp.GetCache()[encodedEligible])
 }
+func TestValidatorsProvider_GetValidatorsForEpochShouldReturnTheSetOfEachEpoch(t *testing.T) {
+	t.Parallel()
+
+	validatorsPerRootHash := map[string]map[uint32][]*state.ValidatorInfo{
+		"rootHash1": {0: {{PublicKey: []byte("pk epoch 1"), List: string(core.EligibleList)}}},
+		"rootHash2": {0: {{PublicKey: []byte("pk epoch 2"), List: string(core.EligibleList)}}},
+	}
+	lastFinalizedRootHash := "rootHash1"
+	numGetValidatorInfoCalls := int32(0)
+	arg := createDefaultValidatorsProviderArg()
+	arg.ValidatorStatistics = &mock.ValidatorStatisticsProcessorStub{
+		LastFinalizedRootHashCalled: func() []byte {
+			return []byte(lastFinalizedRootHash)
+		},
+		GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+			atomic.AddInt32(&numGetValidatorInfoCalls, 1)
+			return validatorsPerRootHash[string(rootHash)], nil
+		},
+	}
+	vsp := createValidatorsProviderForEpochs(arg)
+
+	vsp.currentEpoch = 1
+	vsp.updateCache()
+	lastFinalizedRootHash = "rootHash2"
+	vsp.currentEpoch = 2
+	vsp.updateCache()
+	vsp.currentEpoch = 3
+	atomic.StoreInt32(&numGetValidatorInfoCalls, 0)
+
+	validatorsEpoch1, err := vsp.GetValidatorsForEpoch(1)
+	assert.Nil(t, err)
+	validatorsEpoch2, err := vsp.GetValidatorsForEpoch(2)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, len(validatorsEpoch1))
+	assert.NotNil(t, validatorsEpoch1[arg.PubKeyConverter.Encode([]byte("pk epoch 1"))])
+	assert.Equal(t, 1, len(validatorsEpoch2))
+	assert.NotNil(t, validatorsEpoch2[arg.PubKeyConverter.Encode([]byte("pk epoch 2"))])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numGetValidatorInfoCalls))
+
+	_, _ = vsp.GetValidatorsForEpoch(1)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numGetValidatorInfoCalls))
+}
+
+func TestValidatorsProvider_GetValidatorsForEpochUnknownEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultValidatorsProviderArg()
+	vsp := createValidatorsProviderForEpochs(arg)
+	vsp.currentEpoch = 3
+
+	validators, err := vsp.GetValidatorsForEpoch(2)
+	assert.Nil(t, validators)
+	assert.True(t, errors.Is(err, process.ErrValidatorsForEpochNotAvailable))
+
+	validators, err = vsp.GetValidatorsForEpoch(4)
+	assert.Nil(t, validators)
+	assert.True(t, errors.Is(err, process.ErrValidatorsForEpochNotAvailable))
+}
+
+func TestValidatorsProvider_GetValidatorsForEpochPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultValidatorsProviderArg()
+	arg.ValidatorStatistics = &mock.ValidatorStatisticsProcessorStub{
+		LastFinalizedRootHashCalled: func() []byte {
+			return []byte("rootHash")
+		},
+		GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+			return nil, errors.New("trie was pruned")
+		},
+	}
+	vsp := createValidatorsProviderForEpochs(arg)
+	vsp.currentEpoch = 1
+	vsp.updateCache()
+	vsp.currentEpoch = 2
+
+	validators, err := vsp.GetValidatorsForEpoch(1)
+	assert.Nil(t, validators)
+	assert.True(t, errors.Is(err, process.ErrValidatorsForEpochNotAvailable))
+}
+
+func TestValidatorsProvider_SelectionChancesShouldSumToOneHundredPercent(t *testing.T) {
+	t.Parallel()
+
+	validatorsMap := map[uint32][]*state.ValidatorInfo{
+		0: {
+			{PublicKey: []byte("pk1"), List: string(core.EligibleList), TempRating: 10},
+			{PublicKey: []byte("pk2"), List: string(core.EligibleList), TempRating: 20},
+			{PublicKey: []byte("pk3"), List: string(core.EligibleList), TempRating: 70},
+			{PublicKey: []byte("pk4"), List: string(core.WaitingList), TempRating: 100},
+		},
+	}
+	arg := createDefaultValidatorsProviderArg()
+	arg.ChanceComputer = &mock.RaterMock{
+		GetChancesCalled: func(rating uint32) uint32 {
+			return rating
+		},
+	}
+	vsp := createValidatorsProviderForEpochs(arg)
+
+	validators := vsp.createNewCache(0, validatorsMap)
+
+	sumChances := float32(0)
+	for _, validator := range validators {
+		sumChances += validator.SelectionChancePercent
+	}
+	assert.InDelta(t, 100, sumChances, 0.01)
+	assert.InDelta(t, 70, validators[arg.PubKeyConverter.Encode([]byte("pk3"))].SelectionChancePercent, 0.01)
+	assert.Equal(t, float32(0), validators[arg.PubKeyConverter.Encode([]byte("pk4"))].SelectionChancePercent)
+}
+
+func TestValidatorsProvider_SelectionChancesWithoutChanceComputerShouldBeZero(t *testing.T) {
+	t.Parallel()
+
+	validatorsMap := map[uint32][]*state.ValidatorInfo{
+		0: {{PublicKey: []byte("pk1"), List: string(core.EligibleList), TempRating: 10}},
+	}
+	arg := createDefaultValidatorsProviderArg()
+	vsp := createValidatorsProviderForEpochs(arg)
+
+	validators := vsp.createNewCache(0, validatorsMap)
+
+	assert.Equal(t, float32(0), validators[arg.PubKeyConverter.Encode([]byte("pk1"))].SelectionChancePercent)
+}
+
+func TestValidatorsProvider_ShardChangeShouldRebuildTheCache(t *testing.T) {
+	t.Parallel()
+
+	pkOldShard := []byte("pk old shard")
+	pkNewShard := []byte("pk new shard")
+	selfShardID := uint32(0)
+	mutShard := sync.RWMutex{}
+	arg := createDefaultValidatorsProviderArg()
+	arg.CacheRefreshIntervalDurationInSec = time.Hour
+	arg.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ShardIdForEpochCalled: func(_ uint32) (uint32, error) {
+			mutShard.RLock()
+			defer mutShard.RUnlock()
+
+			return selfShardID, nil
+		},
+	}
+	epochStartNotifier := &mock.EpochStartNotifierStub{}
+	arg.EpochStartEventNotifier = epochStartNotifier
+	arg.ValidatorStatistics = &mock.ValidatorStatisticsProcessorStub{
+		LastFinalizedRootHashCalled: func() []byte {
+			return []byte("rootHash")
+		},
+		GetValidatorInfoForRootHashCalled: func(_ []byte) (map[uint32][]*state.ValidatorInfo, error) {
+			mutShard.RLock()
+			defer mutShard.RUnlock()
+
+			pk := pkOldShard
+			if selfShardID != 0 {
+				pk = pkNewShard
+			}
+
+			return map[uint32][]*state.ValidatorInfo{
+				selfShardID: {{PublicKey: pk, ShardId: selfShardID, List: string(core.EligibleList)}},
+			}, nil
+		},
+	}
+
+	vsp, _ := NewValidatorsProvider(arg)
+	defer func() {
+		_ = vsp.Close()
+	}()
+	time.Sleep(time.Millisecond * 10)
+
+	validators := vsp.GetLatestValidators()
+	assert.Equal(t, 1, len(validators))
+	assert.NotNil(t, validators[arg.PubKeyConverter.Encode(pkOldShard)])
+
+	mutShard.Lock()
+	selfShardID = 1
+	mutShard.Unlock()
+	epochStartNotifier.NotifyAll(&block.Header{Epoch: 2})
+
+	validators = vsp.GetLatestValidators()
+	assert.Equal(t, 1, len(validators))
+	assert.NotNil(t, validators[arg.PubKeyConverter.Encode(pkNewShard)])
+	assert.Equal(t, uint32(1), validators[arg.PubKeyConverter.Encode(pkNewShard)].ShardId)
+}
+
+func createValidatorsProviderForEpochs(arg ArgValidatorsProvider) *validatorsProvider {
+	return &validatorsProvider{
+		nodesCoordinator:             arg.NodesCoordinator,
+		validatorStatistics:          arg.ValidatorStatistics,
+		cache:                        make(map[string]*state.ValidatorApiResponse),
+		cacheRefreshIntervalDuration: arg.CacheRefreshIntervalDurationInSec,
+		maxRating:                    arg.MaxRating,
+		pubkeyConverter:              arg.PubKeyConverter,
+		chanceComputer:               arg.ChanceComputer,
+		epochsRootHashes:             make(map[uint32][]byte),
+		epochsCache:                  make(map[uint32]map[string]*state.ValidatorApiResponse),
+	}
+}
+
+func createValidatorsProviderWithCache(cache map[string]*state.ValidatorApiResponse) *validatorsProvider {
+	return &validatorsProvider{
+		cache:                        cache,
+		cacheRefreshIntervalDuration: time.Hour,
+		lastCacheUpdate:              time.Now(),
+	}
+}
+
+func TestValidatorsProvider_GetValidatorsPageShouldRespectBoundaries(t *testing.T) {
+	t.Parallel()
+
+	cache := make(map[string]*state.ValidatorApiResponse)
+	for i := 0; i < 5; i++ {
+		cache[fmt.Sprintf("pk%d", i)] = &state.ValidatorApiResponse{Rating: float32(i)}
+	}
+	vp := createValidatorsProviderWithCache(cache)
+
+	page, total := vp.GetValidatorsPage(0, 2, SortByPublicKey)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, 2, len(page))
+	assert.Equal(t, "pk0", page[0].PublicKey)
+	assert.Equal(t, "pk1", page[1].PublicKey)
+
+	page, total = vp.GetValidatorsPage(4, 2, SortByPublicKey)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, 1, len(page))
+	assert.Equal(t, "pk4", page[0].PublicKey)
+
+	page, total = vp.GetValidatorsPage(5, 2, SortByPublicKey)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, 0, len(page))
+
+	page, _ = vp.GetValidatorsPage(-1, 2, SortByPublicKey)
+	assert.Equal(t, 0, len(page))
+
+	page, _ = vp.GetValidatorsPage(0, 0, SortByPublicKey)
+	assert.Equal(t, 0, len(page))
+}
+
+func TestValidatorsProvider_GetValidatorsPageMaxLimitShouldNotOverflow(t *testing.T) {
+	t.Parallel()
+
+	cache := make(map[string]*state.ValidatorApiResponse)
+	for i := 0; i < 5; i++ {
+		cache[fmt.Sprintf("pk%d", i)] = &state.ValidatorApiResponse{Rating: float32(i)}
+	}
+	vp := createValidatorsProviderWithCache(cache)
+
+	maxLimit := int(^uint(0) >> 1)
+	page, total := vp.GetValidatorsPage(2, maxLimit, SortByPublicKey)
+	assert.Equal(t, 5, total)
+	require.Equal(t, 3, len(page))
+	assert.Equal(t, "pk2", page[0].PublicKey)
+	assert.Equal(t, "pk4", page[2].PublicKey)
+}
+
+func TestValidatorsProvider_GetValidatorsPageSortByRatingShouldBeDescending(t *testing.T) {
+	t.Parallel()
+
+	cache := map[string]*state.ValidatorApiResponse{
+		"pk0": {Rating: 50},
+		"pk1": {Rating: 90},
+		"pk2": {Rating: 10},
+		"pk3": {Rating: 90},
+	}
+	vp := createValidatorsProviderWithCache(cache)
+
+	page, total := vp.GetValidatorsPage(0, 10, SortByRating)
+
+	assert.Equal(t, 4, total)
+	expectedOrder := []string{"pk1", "pk3", "pk0", "pk2"}
+	for i, pubKey := range expectedOrder {
+		assert.Equal(t, pubKey, page[i].PublicKey)
+		assert.Equal(t, cache[pubKey].Rating, page[i].Rating)
+	}
+}
+
 func createMockValidatorInfo() *state.ValidatorInfo {
 	initialInfo := &state.ValidatorInfo{
 		PublicKey:                  []byte("a1"),
@@ -131,6 +131,11 @@ func (inRTx *InterceptedRewardTransaction) SenderShardId() uint32 {
 	return inRTx.sndShard
 }
 
+// SelfShardId returns the shard id the transaction was checked against
+func (inRTx *InterceptedRewardTransaction) SelfShardId() uint32 {
+	return inRTx.coordinator.SelfId()
+}
+
 // Transaction returns the reward transaction pointer that actually holds the data
 func (inRTx *InterceptedRewardTransaction) Transaction() data.TransactionHandler {
 	return inRTx.rTx
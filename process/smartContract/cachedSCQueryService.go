@@ -0,0 +1,185 @@
+package smartContract
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ external.SCQueryService = (*cachedSCQueryService)(nil)
+
+const sizeUint32 = 4
+
+// ArgsCachedSCQueryService represents the arguments needed to create a cached SC query service
+type ArgsCachedSCQueryService struct {
+	QueryService external.SCQueryService
+	BlockChain   data.ChainHandler
+	Cacher       storage.Cacher
+}
+
+// cachedSCQueryService caches the results of the smart contract queries. The cached results are dropped each time
+// a new block is committed as the state they were computed on changed
+type cachedSCQueryService struct {
+	queryService  external.SCQueryService
+	blockChain    data.ChainHandler
+	cacher        storage.Cacher
+	mutBlockHash  sync.Mutex
+	lastBlockHash []byte
+}
+
+// NewCachedSCQueryService returns a SC query service that caches the results of the wrapped query service
+func NewCachedSCQueryService(args ArgsCachedSCQueryService) (*cachedSCQueryService, error) {
+	if check.IfNil(args.QueryService) {
+		return nil, process.ErrNilSCQueryService
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.Cacher) {
+		return nil, process.ErrNilCacher
+	}
+
+	return &cachedSCQueryService{
+		queryService:  args.QueryService,
+		blockChain:    args.BlockChain,
+		cacher:        args.Cacher,
+		lastBlockHash: args.BlockChain.GetCurrentBlockHeaderHash(),
+	}, nil
+}
+
+// ExecuteQuery returns the cached VMOutput of an identical query executed on the current block or runs the query
+// through the wrapped service. The returned VMOutput is shared between callers and should not be altered
+func (service *cachedSCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if query == nil {
+		return service.queryService.ExecuteQuery(query)
+	}
+
+	blockHash := service.updateBlockHash()
+	key := computeQueryKey(query)
+	vmOutput, ok := service.getCachedOutput(key)
+	if ok {
+		return vmOutput, nil
+	}
+
+	vmOutput, err := service.queryService.ExecuteQuery(query)
+	if err != nil {
+		return vmOutput, err
+	}
+
+	service.cacheOutput(blockHash, key, vmOutput)
+
+	return vmOutput, nil
+}
+
+// ExecuteQueryAsync delivers the cached VMOutput of an identical query executed on the current block right away or
+// runs the query through the wrapped service, caching its result once delivered
+func (service *cachedSCQueryService) ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	if query == nil {
+		return service.queryService.ExecuteQueryAsync(query)
+	}
+
+	chResult := make(chan process.SCQueryResult, 1)
+	blockHash := service.updateBlockHash()
+	key := computeQueryKey(query)
+	vmOutput, ok := service.getCachedOutput(key)
+	if ok {
+		chResult <- process.SCQueryResult{
+			VMOutput: vmOutput,
+		}
+		return chResult
+	}
+
+	chQueryResult := service.queryService.ExecuteQueryAsync(query)
+	go func() {
+		result := <-chQueryResult
+		if result.Err == nil {
+			service.cacheOutput(blockHash, key, result.VMOutput)
+		}
+
+		chResult <- result
+	}()
+
+	return chResult
+}
+
+func (service *cachedSCQueryService) getCachedOutput(key []byte) (*vmcommon.VMOutput, bool) {
+	cachedOutput, ok := service.cacher.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	vmOutput, isVMOutput := cachedOutput.(*vmcommon.VMOutput)
+
+	return vmOutput, isVMOutput
+}
+
+func (service *cachedSCQueryService) cacheOutput(blockHash []byte, key []byte, vmOutput *vmcommon.VMOutput) {
+	service.mutBlockHash.Lock()
+	defer service.mutBlockHash.Unlock()
+
+	// a block committed while the query was running might have changed the result
+	if bytes.Equal(blockHash, service.lastBlockHash) {
+		service.cacher.Put(key, vmOutput, computeVMOutputSize(vmOutput))
+	}
+}
+
+// updateBlockHash clears the cache if a new block was committed since the last call and returns the current block hash
+func (service *cachedSCQueryService) updateBlockHash() []byte {
+	currentBlockHash := service.blockChain.GetCurrentBlockHeaderHash()
+
+	service.mutBlockHash.Lock()
+	defer service.mutBlockHash.Unlock()
+
+	if !bytes.Equal(currentBlockHash, service.lastBlockHash) {
+		service.cacher.Clear()
+		service.lastBlockHash = currentBlockHash
+	}
+
+	return currentBlockHash
+}
+
+// ComputeScCallGasLimit will estimate how many gas a transaction will consume. The estimations are not cached
+func (service *cachedSCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	return service.queryService.ComputeScCallGasLimit(tx)
+}
+
+func computeQueryKey(query *process.SCQuery) []byte {
+	buff := bytes.Buffer{}
+	writeKeyPart(&buff, query.ScAddress)
+	writeKeyPart(&buff, []byte(query.FuncName))
+	for _, arg := range query.Arguments {
+		writeKeyPart(&buff, arg)
+	}
+
+	return buff.Bytes()
+}
+
+// writeKeyPart prefixes each part with its length so different queries can not produce the same key
+func writeKeyPart(buff *bytes.Buffer, part []byte) {
+	lenPart := make([]byte, sizeUint32)
+	binary.BigEndian.PutUint32(lenPart, uint32(len(part)))
+	buff.Write(lenPart)
+	buff.Write(part)
+}
+
+func computeVMOutputSize(vmOutput *vmcommon.VMOutput) int {
+	size := 0
+	for _, returnData := range vmOutput.ReturnData {
+		size += len(returnData)
+	}
+
+	return size + len(vmOutput.ReturnMessage)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (service *cachedSCQueryService) IsInterfaceNil() bool {
+	return service == nil
+}
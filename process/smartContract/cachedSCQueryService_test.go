@@ -0,0 +1,193 @@
+package smartContract
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsCachedSCQueryService(numExecutions *int, currentBlockHash *[]byte) ArgsCachedSCQueryService {
+	cacher, _ := lrucache.NewCache(10)
+
+	return ArgsCachedSCQueryService{
+		QueryService: &mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				*numExecutions++
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte(query.FuncName)}}, nil
+			},
+		},
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return *currentBlockHash
+			},
+		},
+		Cacher: cacher,
+	}
+}
+
+func createTestQuery(args ...[]byte) *process.SCQuery {
+	return &process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "getTotalSupply",
+		Arguments: args,
+	}
+}
+
+func TestNewCachedSCQueryService_NilQueryServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	args := createMockArgsCachedSCQueryService(&numExecutions, &blockHash)
+	args.QueryService = nil
+	service, err := NewCachedSCQueryService(args)
+
+	assert.True(t, check.IfNil(service))
+	assert.Equal(t, process.ErrNilSCQueryService, err)
+}
+
+func TestNewCachedSCQueryService_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	args := createMockArgsCachedSCQueryService(&numExecutions, &blockHash)
+	args.BlockChain = nil
+	service, err := NewCachedSCQueryService(args)
+
+	assert.True(t, check.IfNil(service))
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewCachedSCQueryService_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	args := createMockArgsCachedSCQueryService(&numExecutions, &blockHash)
+	args.Cacher = nil
+	service, err := NewCachedSCQueryService(args)
+
+	assert.True(t, check.IfNil(service))
+	assert.Equal(t, process.ErrNilCacher, err)
+}
+
+func TestCachedSCQueryService_ExecuteQueryRepeatedQueryShouldHitCache(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	service, _ := NewCachedSCQueryService(createMockArgsCachedSCQueryService(&numExecutions, &blockHash))
+
+	firstOutput, err := service.ExecuteQuery(createTestQuery([]byte("arg")))
+	assert.Nil(t, err)
+	secondOutput, err := service.ExecuteQuery(createTestQuery([]byte("arg")))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, numExecutions)
+	assert.True(t, firstOutput == secondOutput) //pointer testing
+}
+
+func TestCachedSCQueryService_ExecuteQueryDifferentArgumentsShouldNotHitCache(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	service, _ := NewCachedSCQueryService(createMockArgsCachedSCQueryService(&numExecutions, &blockHash))
+
+	_, _ = service.ExecuteQuery(createTestQuery([]byte("ab"), []byte("c")))
+	_, _ = service.ExecuteQuery(createTestQuery([]byte("a"), []byte("bc")))
+
+	assert.Equal(t, 2, numExecutions)
+}
+
+func TestCachedSCQueryService_ExecuteQueryNewBlockCommittedShouldInvalidateCache(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	service, _ := NewCachedSCQueryService(createMockArgsCachedSCQueryService(&numExecutions, &blockHash))
+
+	_, _ = service.ExecuteQuery(createTestQuery())
+	_, _ = service.ExecuteQuery(createTestQuery())
+	assert.Equal(t, 1, numExecutions)
+
+	blockHash = []byte("new hash")
+	_, _ = service.ExecuteQuery(createTestQuery())
+	assert.Equal(t, 2, numExecutions)
+
+	_, _ = service.ExecuteQuery(createTestQuery())
+	assert.Equal(t, 2, numExecutions)
+}
+
+func TestCachedSCQueryService_ExecuteQueryErrorShouldNotBeCached(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	expectedErr := errors.New("expected error")
+	args := createMockArgsCachedSCQueryService(&numExecutions, &blockHash)
+	args.QueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			numExecutions++
+			return nil, expectedErr
+		},
+	}
+	service, _ := NewCachedSCQueryService(args)
+
+	_, err := service.ExecuteQuery(createTestQuery())
+	assert.Equal(t, expectedErr, err)
+	_, err = service.ExecuteQuery(createTestQuery())
+	assert.Equal(t, expectedErr, err)
+
+	assert.Equal(t, 2, numExecutions)
+	assert.Equal(t, 0, args.Cacher.Len())
+}
+
+func TestCachedSCQueryService_ExecuteQueryAsyncRepeatedQueryShouldHitCache(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	service, _ := NewCachedSCQueryService(createMockArgsCachedSCQueryService(&numExecutions, &blockHash))
+
+	firstResult := <-service.ExecuteQueryAsync(createTestQuery([]byte("arg")))
+	secondResult := <-service.ExecuteQueryAsync(createTestQuery([]byte("arg")))
+
+	assert.Nil(t, firstResult.Err)
+	assert.Nil(t, secondResult.Err)
+	assert.Equal(t, 1, numExecutions)
+	assert.True(t, firstResult.VMOutput == secondResult.VMOutput)
+
+	vmOutput, err := service.ExecuteQuery(createTestQuery([]byte("arg")))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numExecutions)
+	assert.True(t, firstResult.VMOutput == vmOutput)
+}
+
+func TestCachedSCQueryService_ExecuteQueryAsyncErrorShouldNotBeCached(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	blockHash := []byte("hash")
+	args := createMockArgsCachedSCQueryService(&numExecutions, &blockHash)
+	args.QueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			numExecutions++
+			return nil, errors.New("expected error")
+		},
+	}
+	service, _ := NewCachedSCQueryService(args)
+
+	result := <-service.ExecuteQueryAsync(createTestQuery())
+	assert.NotNil(t, result.Err)
+	result = <-service.ExecuteQueryAsync(createTestQuery())
+	assert.NotNil(t, result.Err)
+	assert.Equal(t, 2, numExecutions)
+}
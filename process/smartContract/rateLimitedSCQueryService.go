@@ -0,0 +1,78 @@
+package smartContract
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ external.SCQueryService = (*rateLimitedSCQueryService)(nil)
+
+// ArgsRateLimitedSCQueryService represents the arguments needed to create a rate limited SC query service
+type ArgsRateLimitedSCQueryService struct {
+	QueryService external.SCQueryService
+	RateLimiter  process.RateLimiter
+}
+
+// rateLimitedSCQueryService rejects the smart contract executions above the rate allowed by the rate limiter, which
+// is shared by all the callers, so that a flood of queries will not take the resources needed for block processing
+type rateLimitedSCQueryService struct {
+	queryService external.SCQueryService
+	rateLimiter  process.RateLimiter
+}
+
+// NewRateLimitedSCQueryService returns a SC query service that bounds the executions rate of the wrapped query service
+func NewRateLimitedSCQueryService(args ArgsRateLimitedSCQueryService) (*rateLimitedSCQueryService, error) {
+	if check.IfNil(args.QueryService) {
+		return nil, process.ErrNilSCQueryService
+	}
+	if check.IfNil(args.RateLimiter) {
+		return nil, process.ErrNilRateLimiter
+	}
+
+	return &rateLimitedSCQueryService{
+		queryService: args.QueryService,
+		rateLimiter:  args.RateLimiter,
+	}, nil
+}
+
+// ExecuteQuery runs the query through the wrapped service or returns ErrSCQueryRateLimited if the allowed rate was
+// exceeded
+func (service *rateLimitedSCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if !service.rateLimiter.TryAcquire() {
+		return nil, process.ErrSCQueryRateLimited
+	}
+
+	return service.queryService.ExecuteQuery(query)
+}
+
+// ExecuteQueryAsync runs the query through the wrapped service or delivers ErrSCQueryRateLimited right away if the
+// allowed rate was exceeded
+func (service *rateLimitedSCQueryService) ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	if !service.rateLimiter.TryAcquire() {
+		chResult := make(chan process.SCQueryResult, 1)
+		chResult <- process.SCQueryResult{
+			Err: process.ErrSCQueryRateLimited,
+		}
+		return chResult
+	}
+
+	return service.queryService.ExecuteQueryAsync(query)
+}
+
+// ComputeScCallGasLimit estimates the gas through the wrapped service or returns ErrSCQueryRateLimited if the allowed
+// rate was exceeded
+func (service *rateLimitedSCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	if !service.rateLimiter.TryAcquire() {
+		return 0, process.ErrSCQueryRateLimited
+	}
+
+	return service.queryService.ComputeScCallGasLimit(tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (service *rateLimitedSCQueryService) IsInterfaceNil() bool {
+	return service == nil
+}
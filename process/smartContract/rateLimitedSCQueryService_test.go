@@ -0,0 +1,99 @@
+package smartContract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsRateLimitedSCQueryService(numExecutions *int) ArgsRateLimitedSCQueryService {
+	rateLimiter, _ := throttler.NewTokenBucketRateLimiter(10, 2)
+
+	return ArgsRateLimitedSCQueryService{
+		QueryService: &mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				*numExecutions++
+				return &vmcommon.VMOutput{}, nil
+			},
+			ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+				*numExecutions++
+				return 1, nil
+			},
+		},
+		RateLimiter: rateLimiter,
+	}
+}
+
+func TestNewRateLimitedSCQueryService_NilQueryServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	args := createMockArgsRateLimitedSCQueryService(&numExecutions)
+	args.QueryService = nil
+	service, err := NewRateLimitedSCQueryService(args)
+
+	assert.True(t, check.IfNil(service))
+	assert.Equal(t, process.ErrNilSCQueryService, err)
+}
+
+func TestNewRateLimitedSCQueryService_NilRateLimiterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	args := createMockArgsRateLimitedSCQueryService(&numExecutions)
+	args.RateLimiter = nil
+	service, err := NewRateLimitedSCQueryService(args)
+
+	assert.True(t, check.IfNil(service))
+	assert.Equal(t, process.ErrNilRateLimiter, err)
+}
+
+func TestRateLimitedSCQueryService_ExecuteQueryAboveTheRateShouldErrUntilTheBucketRefills(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	service, _ := NewRateLimitedSCQueryService(createMockArgsRateLimitedSCQueryService(&numExecutions))
+	assert.False(t, check.IfNil(service))
+
+	_, err := service.ExecuteQuery(createTestQuery())
+	assert.Nil(t, err)
+	_, err = service.ComputeScCallGasLimit(&transaction.Transaction{})
+	assert.Nil(t, err)
+
+	vmOutput, err := service.ExecuteQuery(createTestQuery())
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrSCQueryRateLimited, err)
+	_, err = service.ComputeScCallGasLimit(&transaction.Transaction{})
+	assert.Equal(t, process.ErrSCQueryRateLimited, err)
+	assert.Equal(t, 2, numExecutions)
+
+	time.Sleep(time.Millisecond * 150)
+
+	_, err = service.ExecuteQuery(createTestQuery())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numExecutions)
+}
+
+func TestRateLimitedSCQueryService_ExecuteQueryAsyncAboveTheRateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	service, _ := NewRateLimitedSCQueryService(createMockArgsRateLimitedSCQueryService(&numExecutions))
+
+	result := <-service.ExecuteQueryAsync(createTestQuery())
+	assert.Nil(t, result.Err)
+	result = <-service.ExecuteQueryAsync(createTestQuery())
+	assert.Nil(t, result.Err)
+
+	result = <-service.ExecuteQueryAsync(createTestQuery())
+	assert.Nil(t, result.VMOutput)
+	assert.Equal(t, process.ErrSCQueryRateLimited, result.Err)
+	assert.Equal(t, 2, numExecutions)
+}
@@ -6,19 +6,24 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
-	"github.com/pkg/errors"
 )
 
+// maxNumPendingQueries bounds the number of go routines started by ExecuteQueryAsync. The queries are run one at a
+// time, so the go routines above this limit would only wait for the VM
+const maxNumPendingQueries = 100
+
 var _ process.SCQueryService = (*SCQueryService)(nil)
 
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer  process.VirtualMachinesContainer
-	economicsFee process.FeeHandler
-	mutRunSc     sync.Mutex
+	vmContainer      process.VirtualMachinesContainer
+	economicsFee     process.FeeHandler
+	queriesThrottler process.InterceptorThrottler
+	mutRunSc         sync.Mutex
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -33,14 +38,54 @@ func NewSCQueryService(
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
 
+	queriesThrottler, err := throttler.NewNumGoRoutinesThrottler(maxNumPendingQueries)
+	if err != nil {
+		return nil, err
+	}
+
 	return &SCQueryService{
-		vmContainer:  vmContainer,
-		economicsFee: economicsFee,
+		vmContainer:      vmContainer,
+		economicsFee:     economicsFee,
+		queriesThrottler: queriesThrottler,
 	}, nil
 }
 
-// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
+// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract. If the VM returned a
+// not ok code, the VMOutput is returned along with the error
 func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	result := <-service.ExecuteQueryAsync(query)
+
+	return result.VMOutput, result.Err
+}
+
+// ExecuteQueryAsync runs the query on a separate go routine and returns the channel on which the result will be
+// delivered, so that the caller can stop waiting for it (for example, on a timeout). The channel is buffered, so the
+// go routine always finishes, even if nobody reads the result anymore. If too many queries are already pending, the
+// channel delivers process.ErrSystemBusy right away
+func (service *SCQueryService) ExecuteQueryAsync(query *process.SCQuery) <-chan process.SCQueryResult {
+	chResult := make(chan process.SCQueryResult, 1)
+	if !service.queriesThrottler.CanProcess() {
+		chResult <- process.SCQueryResult{
+			Err: process.ErrSystemBusy,
+		}
+		return chResult
+	}
+
+	service.queriesThrottler.StartProcessing()
+	go func() {
+		defer service.queriesThrottler.EndProcessing()
+
+		vmOutput, err := service.executeQuery(query)
+		chResult <- process.SCQueryResult{
+			VMOutput: vmOutput,
+			Err:      err,
+		}
+	}()
+
+	return chResult
+}
+
+func (service *SCQueryService) executeQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	if query.ScAddress == nil {
 		return nil, process.ErrNilScAddress
 	}
@@ -68,7 +113,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 
 	err = service.checkVMOutput(vmOutput)
 	if err != nil {
-		return nil, err
+		return vmOutput, err
 	}
 
 	return vmOutput, nil
@@ -95,12 +140,30 @@ func (service *SCQueryService) createVMCallInput(query *process.SCQuery, gasPric
 
 func (service *SCQueryService) checkVMOutput(vmOutput *vmcommon.VMOutput) error {
 	if vmOutput.ReturnCode != vmcommon.Ok {
-		return errors.New(fmt.Sprintf("error running vm func: code: %d, %s", vmOutput.ReturnCode, vmOutput.ReturnCode))
+		return fmt.Errorf("%w, error running vm func: code: %d, %s, message: %s",
+			convertReturnCodeToError(vmOutput.ReturnCode),
+			vmOutput.ReturnCode,
+			vmOutput.ReturnCode,
+			vmOutput.ReturnMessage,
+		)
 	}
 
 	return nil
 }
 
+// convertReturnCodeToError maps the VM return codes to the exported errors so the callers are able to tell why a
+// query failed
+func convertReturnCodeToError(returnCode vmcommon.ReturnCode) error {
+	switch returnCode {
+	case vmcommon.FunctionNotFound:
+		return process.ErrFunctionNotFound
+	case vmcommon.ContractNotFound, vmcommon.ContractInvalid:
+		return process.ErrAccountNotContract
+	default:
+		return process.ErrExecutionFailed
+	}
+}
+
 // ComputeScCallGasLimit will estimate how many gas a transaction will consume
 func (service *SCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	argumentParser := vmcommon.NewAtArgumentParser()
@@ -1,6 +1,7 @@
 package smartContract
 
 import (
+	"errors"
 	"math"
 	"math/big"
 	"sync"
@@ -202,7 +203,61 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "error running vm func")
-	assert.Nil(t, returnedData)
+	require.NotNil(t, returnedData)
+	assert.Equal(t, vmcommon.OutOfGas, returnedData.ReturnCode)
+}
+
+func TestExecuteQuery_NotOkCodesShouldMapToTypedErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErrors := map[vmcommon.ReturnCode]error{
+		vmcommon.FunctionNotFound:       process.ErrFunctionNotFound,
+		vmcommon.FunctionWrongSignature: process.ErrExecutionFailed,
+		vmcommon.ContractNotFound:       process.ErrAccountNotContract,
+		vmcommon.UserError:              process.ErrExecutionFailed,
+		vmcommon.OutOfGas:               process.ErrExecutionFailed,
+		vmcommon.AccountCollision:       process.ErrExecutionFailed,
+		vmcommon.OutOfFunds:             process.ErrExecutionFailed,
+		vmcommon.CallStackOverFlow:      process.ErrExecutionFailed,
+		vmcommon.ContractInvalid:        process.ErrAccountNotContract,
+		vmcommon.ExecutionFailed:        process.ErrExecutionFailed,
+		vmcommon.UpgradeFailed:          process.ErrExecutionFailed,
+	}
+
+	for returnCode, expectedErr := range expectedErrors {
+		vmReturnCode := returnCode
+		mockVM := &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				return &vmcommon.VMOutput{
+					ReturnCode:    vmReturnCode,
+					ReturnMessage: "vm message",
+				}, nil
+			},
+		}
+		target, _ := NewSCQueryService(
+			&mock.VMContainerMock{
+				GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+					return mockVM, nil
+				},
+			},
+			&mock.FeeHandlerStub{
+				MaxGasLimitPerBlockCalled: func() uint64 {
+					return uint64(math.MaxUint64)
+				},
+			},
+		)
+
+		query := process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+		}
+		vmOutput, err := target.ExecuteQuery(&query)
+
+		assert.True(t, errors.Is(err, expectedErr), "return code %s", returnCode)
+		assert.Contains(t, err.Error(), "vm message")
+		require.NotNil(t, vmOutput)
+		assert.Equal(t, returnCode, vmOutput.ReturnCode)
+	}
 }
 
 func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
@@ -292,3 +347,147 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, consumedGas, cost)
 }
+
+func TestExecuteQueryAsync_ShouldDeliverTheResultOnTheChannel(t *testing.T) {
+	t.Parallel()
+
+	expectedData := []byte("data")
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{expectedData},
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+	)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+
+	select {
+	case result := <-target.ExecuteQueryAsync(&query):
+		assert.Nil(t, result.Err)
+		assert.Equal(t, [][]byte{expectedData}, result.VMOutput.ReturnData)
+	case <-time.After(time.Second):
+		assert.Fail(t, "the result should have been delivered")
+	}
+
+	query.FuncName = ""
+	result := <-target.ExecuteQueryAsync(&query)
+	assert.Nil(t, result.VMOutput)
+	assert.Equal(t, process.ErrEmptyFunctionName, result.Err)
+}
+
+func TestExecuteQueryAsync_SlowQueryShouldAllowTheCallerToTimeout(t *testing.T) {
+	t.Parallel()
+
+	chReleaseVM := make(chan struct{})
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			<-chReleaseVM
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+	)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+	chResult := target.ExecuteQueryAsync(&query)
+
+	timedOut := false
+	select {
+	case <-chResult:
+	case <-time.After(time.Millisecond * 50):
+		timedOut = true
+	}
+	assert.True(t, timedOut)
+
+	close(chReleaseVM)
+	select {
+	case result := <-chResult:
+		assert.Nil(t, result.Err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "the result should have been delivered after the VM finished")
+	}
+}
+
+func TestExecuteQueryAsync_TooManyPendingQueriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chReleaseVM := make(chan struct{})
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			<-chReleaseVM
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+	)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+	pendingResults := make([]<-chan process.SCQueryResult, 0, maxNumPendingQueries)
+	for i := 0; i < maxNumPendingQueries; i++ {
+		pendingResults = append(pendingResults, target.ExecuteQueryAsync(&query))
+	}
+
+	select {
+	case result := <-target.ExecuteQueryAsync(&query):
+		assert.Nil(t, result.VMOutput)
+		assert.Equal(t, process.ErrSystemBusy, result.Err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "the query above the limit should have been rejected right away")
+	}
+
+	close(chReleaseVM)
+	for _, chResult := range pendingResults {
+		result := <-chResult
+		assert.Nil(t, result.Err)
+	}
+
+	result := <-target.ExecuteQueryAsync(&query)
+	assert.Nil(t, result.Err)
+}
@@ -0,0 +1,37 @@
+package sync
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.SyncStateHandler = (*SyncState)(nil)
+
+// SyncState keeps the last sync state notified by the bootstrapper. The node is considered to be syncing until the
+// bootstrapper notifies otherwise
+type SyncState struct {
+	isSyncing atomic.Flag
+}
+
+// NewSyncState creates a new sync state holder
+func NewSyncState() *SyncState {
+	ss := &SyncState{}
+	ss.isSyncing.Set()
+
+	return ss
+}
+
+// ReceivedSyncState updates the sync state. It can be registered as a bootstrapper sync state listener
+func (ss *SyncState) ReceivedSyncState(isNodeSynchronized bool) {
+	ss.isSyncing.Toggle(!isNodeSynchronized)
+}
+
+// IsSyncing returns true if the node is syncing
+func (ss *SyncState) IsSyncing() bool {
+	return ss.isSyncing.IsSet()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *SyncState) IsInterfaceNil() bool {
+	return ss == nil
+}
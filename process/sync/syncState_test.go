@@ -0,0 +1,21 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncState_ShouldBeSyncingUntilNotifiedOtherwise(t *testing.T) {
+	t.Parallel()
+
+	ss := NewSyncState()
+	assert.False(t, ss.IsInterfaceNil())
+	assert.True(t, ss.IsSyncing())
+
+	ss.ReceivedSyncState(true)
+	assert.False(t, ss.IsSyncing())
+
+	ss.ReceivedSyncState(false)
+	assert.True(t, ss.IsSyncing())
+}
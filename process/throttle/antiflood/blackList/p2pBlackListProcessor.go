@@ -124,6 +124,16 @@ func (pbp *p2pBlackListProcessor) incrementStatsFloodingPeer(pid core.PeerID) {
 	pbp.cacher.Put(pid.Bytes(), val+1, sizeBlacklistInfo)
 }
 
+// RepeatedOverQuota adds the peer that exceeded its quota in too many intervals to the black list handler
+func (pbp *p2pBlackListProcessor) RepeatedOverQuota(pid core.PeerID, numIntervals uint32) {
+	log.Debug("added new peer to black list",
+		"peer ID", pid.Pretty(),
+		"ban period", pbp.banDuration,
+		"num over quota intervals", numIntervals,
+	)
+	_ = pbp.peerBlacklistHandler.AddWithSpan(pid, pbp.banDuration)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pbp *p2pBlackListProcessor) IsInterfaceNil() bool {
 	return pbp == nil
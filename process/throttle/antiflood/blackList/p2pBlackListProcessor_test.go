@@ -411,3 +411,33 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsOverNumFloodingRoundsShouldBl
 	assert.True(t, removedCalled)
 	assert.True(t, addToBlacklistCalled)
 }
+
+//------- RepeatedOverQuota
+
+func TestP2PQuotaBlacklistProcessor_RepeatedOverQuotaShouldBlackList(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	addToBlacklistCalled := false
+	duration := time.Second * 3892
+	pbp, _ := blackList.NewP2PBlackListProcessor(
+		&mock.CacherStub{},
+		&mock.PeerBlackListHandlerStub{
+			AddWithSpanCalled: func(p core.PeerID, span time.Duration) error {
+				addToBlacklistCalled = true
+				assert.Equal(t, pid, p)
+				assert.Equal(t, duration, span)
+
+				return nil
+			},
+		},
+		1,
+		1,
+		2,
+		duration,
+	)
+
+	pbp.RepeatedOverQuota(pid, 5)
+
+	assert.True(t, addToBlacklistCalled)
+}
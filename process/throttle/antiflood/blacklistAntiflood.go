@@ -0,0 +1,61 @@
+package antiflood
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.P2PAntifloodHandler = (*blacklistAntiflood)(nil)
+
+// blacklistAntiflood only rejects the messages originating from blacklisted peers, without accounting any per peer
+// quota. It should be used on the topics protected by a dedicated rate limiter
+type blacklistAntiflood struct {
+	blacklistHandler process.PeerBlackListHandler
+}
+
+// NewBlacklistAntiflood creates a new antiflood handler that only checks the messages originator against the blacklist
+func NewBlacklistAntiflood(blacklistHandler process.PeerBlackListHandler) (*blacklistAntiflood, error) {
+	if check.IfNil(blacklistHandler) {
+		return nil, process.ErrNilBlackListHandler
+	}
+
+	return &blacklistAntiflood{
+		blacklistHandler: blacklistHandler,
+	}, nil
+}
+
+// CanProcessMessage returns an error if the message originator is blacklisted
+func (ba *blacklistAntiflood) CanProcessMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	if message == nil {
+		return p2p.ErrNilMessage
+	}
+
+	if ba.blacklistHandler.Has(message.Peer()) {
+		return fmt.Errorf("%w for pid %s", process.ErrOriginatorIsBlacklisted, message.Peer().Pretty())
+	}
+
+	return nil
+}
+
+// CanProcessMessagesOnTopic returns nil as no topic quota is accounted
+func (ba *blacklistAntiflood) CanProcessMessagesOnTopic(_ core.PeerID, _ string, _ uint32, _ uint64) error {
+	return nil
+}
+
+// ApplyConsensusSize does nothing as no quota is accounted
+func (ba *blacklistAntiflood) ApplyConsensusSize(_ int) {
+}
+
+// SetDebugger returns nil as the blacklist check does not use a debugger
+func (ba *blacklistAntiflood) SetDebugger(_ process.AntifloodDebugger) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ba *blacklistAntiflood) IsInterfaceNil() bool {
+	return ba == nil
+}
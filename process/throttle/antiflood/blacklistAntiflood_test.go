@@ -0,0 +1,76 @@
+package antiflood_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlacklistAntiflood_NilBlacklistHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ba, err := antiflood.NewBlacklistAntiflood(nil)
+
+	assert.True(t, check.IfNil(ba))
+	assert.Equal(t, process.ErrNilBlackListHandler, err)
+}
+
+func TestNewBlacklistAntiflood_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ba, err := antiflood.NewBlacklistAntiflood(&mock.PeerBlackListHandlerStub{})
+
+	assert.False(t, check.IfNil(ba))
+	assert.Nil(t, err)
+}
+
+func TestBlacklistAntiflood_CanProcessMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ba, _ := antiflood.NewBlacklistAntiflood(&mock.PeerBlackListHandlerStub{})
+
+	err := ba.CanProcessMessage(nil, "connected peer")
+
+	assert.Equal(t, p2p.ErrNilMessage, err)
+}
+
+func TestBlacklistAntiflood_CanProcessMessageBlacklistedOriginatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	originator := core.PeerID("originator")
+	ba, _ := antiflood.NewBlacklistAntiflood(&mock.PeerBlackListHandlerStub{
+		HasCalled: func(pid core.PeerID) bool {
+			return pid == originator
+		},
+	})
+
+	err := ba.CanProcessMessage(&mock.P2PMessageMock{PeerField: originator}, "connected peer")
+
+	assert.True(t, errors.Is(err, process.ErrOriginatorIsBlacklisted))
+}
+
+func TestBlacklistAntiflood_CanProcessMessageShouldNotAccountAnyQuota(t *testing.T) {
+	t.Parallel()
+
+	ba, _ := antiflood.NewBlacklistAntiflood(&mock.PeerBlackListHandlerStub{
+		HasCalled: func(pid core.PeerID) bool {
+			return false
+		},
+	})
+
+	msg := &mock.P2PMessageMock{
+		PeerField: "originator",
+		DataField: make([]byte, 1024),
+	}
+	for i := 0; i < 10000; i++ {
+		assert.Nil(t, ba.CanProcessMessage(msg, "connected peer"))
+		assert.Nil(t, ba.CanProcessMessagesOnTopic("connected peer", "topic", 1, 1024))
+	}
+}
@@ -0,0 +1,77 @@
+package antiflood
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.P2PAntifloodHandler = (*chainedAntiflood)(nil)
+
+// chainedAntiflood applies all the contained antiflood handlers, in the provided order. A message can be processed
+// only if every handler accepts it
+type chainedAntiflood struct {
+	handlers []process.P2PAntifloodHandler
+}
+
+// NewChainedAntiflood creates a new antiflood handler that delegates to all the provided handlers
+func NewChainedAntiflood(handlers ...process.P2PAntifloodHandler) (*chainedAntiflood, error) {
+	for _, handler := range handlers {
+		if check.IfNil(handler) {
+			return nil, process.ErrNilAntifloodHandler
+		}
+	}
+
+	return &chainedAntiflood{
+		handlers: handlers,
+	}, nil
+}
+
+// CanProcessMessage returns the first error returned by the contained handlers
+func (ca *chainedAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	for _, handler := range ca.handlers {
+		err := handler.CanProcessMessage(message, fromConnectedPeer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CanProcessMessagesOnTopic returns the first error returned by the contained handlers
+func (ca *chainedAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+	for _, handler := range ca.handlers {
+		err := handler.CanProcessMessagesOnTopic(peer, topic, numMessages, totalSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyConsensusSize applies the consensus size on all contained handlers
+func (ca *chainedAntiflood) ApplyConsensusSize(size int) {
+	for _, handler := range ca.handlers {
+		handler.ApplyConsensusSize(size)
+	}
+}
+
+// SetDebugger sets the antiflood debugger on all contained handlers
+func (ca *chainedAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
+	for _, handler := range ca.handlers {
+		err := handler.SetDebugger(debugger)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ca *chainedAntiflood) IsInterfaceNil() bool {
+	return ca == nil
+}
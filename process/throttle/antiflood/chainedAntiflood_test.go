@@ -0,0 +1,86 @@
+package antiflood_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChainedAntiflood_NilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ca, err := antiflood.NewChainedAntiflood(&mock.P2PAntifloodHandlerStub{}, nil)
+
+	assert.True(t, check.IfNil(ca))
+	assert.Equal(t, process.ErrNilAntifloodHandler, err)
+}
+
+func TestChainedAntiflood_CanProcessMessageShouldCallAllHandlersUntilTheFirstError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numCalls := make([]int, 3)
+	createHandler := func(idx int, err error) *mock.P2PAntifloodHandlerStub {
+		return &mock.P2PAntifloodHandlerStub{
+			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				numCalls[idx]++
+				return err
+			},
+			CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+				numCalls[idx]++
+				return err
+			},
+		}
+	}
+	ca, _ := antiflood.NewChainedAntiflood(createHandler(0, nil), createHandler(1, expectedErr), createHandler(2, nil))
+
+	err := ca.CanProcessMessage(&mock.P2PMessageMock{}, "pid")
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []int{1, 1, 0}, numCalls)
+
+	err = ca.CanProcessMessagesOnTopic("pid", "topic", 1, 1)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []int{2, 2, 0}, numCalls)
+}
+
+func TestChainedAntiflood_AllHandlersAcceptingShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	ca, _ := antiflood.NewChainedAntiflood(&mock.P2PAntifloodHandlerStub{}, &mock.P2PAntifloodHandlerStub{})
+
+	assert.Nil(t, ca.CanProcessMessage(&mock.P2PMessageMock{}, "pid"))
+	assert.Nil(t, ca.CanProcessMessagesOnTopic("pid", "topic", 1, 1))
+}
+
+func TestChainedAntiflood_ApplyConsensusSizeAndSetDebuggerShouldBeForwarded(t *testing.T) {
+	t.Parallel()
+
+	numConsensusSizeCalls := 0
+	numSetDebuggerCalls := 0
+	createHandler := func() *mock.P2PAntifloodHandlerStub {
+		return &mock.P2PAntifloodHandlerStub{
+			ApplyConsensusSizeCalled: func(size int) {
+				numConsensusSizeCalls++
+			},
+			SetDebuggerCalled: func(debugger process.AntifloodDebugger) error {
+				numSetDebuggerCalls++
+				return nil
+			},
+		}
+	}
+	ca, _ := antiflood.NewChainedAntiflood(createHandler(), createHandler())
+
+	ca.ApplyConsensusSize(5)
+	err := ca.SetDebugger(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numConsensusSizeCalls)
+	assert.Equal(t, 2, numSetDebuggerCalls)
+}
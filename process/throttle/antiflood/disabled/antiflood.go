@@ -1,6 +1,9 @@
 package disabled
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -9,8 +12,31 @@ import (
 
 var _ consensus.P2PAntifloodHandler = (*AntiFlood)(nil)
 
-// AntiFlood is a mock implementation of the antiflood interface
+// AntiFlood is a mock implementation of the antiflood interface. Optional per topic thresholds can be set in order to
+// exercise the rejection path in tests, without a full flood preventer
 type AntiFlood struct {
+	mutThresholds   sync.RWMutex
+	topicThresholds map[string]topicThreshold
+}
+
+type topicThreshold struct {
+	maxNumMessages uint32
+	maxTotalSize   uint64
+}
+
+// SetTopicThreshold will make the CanProcessMessagesOnTopic calls on the provided topic fail if the number of messages
+// or their total size exceeds the provided values. A 0 value will not limit the corresponding dimension
+func (af *AntiFlood) SetTopicThreshold(topic string, maxNumMessages uint32, maxTotalSize uint64) {
+	af.mutThresholds.Lock()
+	defer af.mutThresholds.Unlock()
+
+	if af.topicThresholds == nil {
+		af.topicThresholds = make(map[string]topicThreshold)
+	}
+	af.topicThresholds[topic] = topicThreshold{
+		maxNumMessages: maxNumMessages,
+		maxTotalSize:   maxTotalSize,
+	}
 }
 
 // ResetForTopic won't do anything
@@ -26,8 +52,24 @@ func (af *AntiFlood) CanProcessMessage(_ p2p.MessageP2P, _ core.PeerID) error {
 	return nil
 }
 
-// CanProcessMessagesOnTopic will always return nil
-func (af *AntiFlood) CanProcessMessagesOnTopic(_ core.PeerID, _ string, _ uint32, _ uint64) error {
+// CanProcessMessagesOnTopic will return nil unless a threshold was set for the provided topic and it was exceeded
+func (af *AntiFlood) CanProcessMessagesOnTopic(_ core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
+	af.mutThresholds.RLock()
+	threshold, ok := af.topicThresholds[topic]
+	af.mutThresholds.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	if threshold.maxNumMessages > 0 && numMessages > threshold.maxNumMessages {
+		return fmt.Errorf("%w in disabled antiflood, topic %s, num messages %d, max %d",
+			process.ErrSystemBusy, topic, numMessages, threshold.maxNumMessages)
+	}
+	if threshold.maxTotalSize > 0 && totalSize > threshold.maxTotalSize {
+		return fmt.Errorf("%w in disabled antiflood, topic %s, total size %d, max %d",
+			process.ErrSystemBusy, topic, totalSize, threshold.maxTotalSize)
+	}
+
 	return nil
 }
 
@@ -1,10 +1,12 @@
 package disabled
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,3 +27,38 @@ func TestAntiFlood_ShouldNotPanic(t *testing.T) {
 	_ = daf.CanProcessMessagesOnTopic(core.PeerID(1), "test", 1, 0)
 	_ = daf.CanProcessMessage(nil, core.PeerID(2))
 }
+
+func TestAntiFlood_NoThresholdShouldAllowEverything(t *testing.T) {
+	t.Parallel()
+
+	daf := &AntiFlood{}
+
+	assert.Nil(t, daf.CanProcessMessagesOnTopic("pid", "topic", 1000000, 1000000000))
+}
+
+func TestAntiFlood_TopicThresholdShouldRejectBeyondIt(t *testing.T) {
+	t.Parallel()
+
+	daf := &AntiFlood{}
+	daf.SetTopicThreshold("limited", 10, 1000)
+
+	assert.Nil(t, daf.CanProcessMessagesOnTopic("pid", "limited", 10, 1000))
+
+	err := daf.CanProcessMessagesOnTopic("pid", "limited", 11, 1000)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+
+	err = daf.CanProcessMessagesOnTopic("pid", "limited", 10, 1001)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+
+	assert.Nil(t, daf.CanProcessMessagesOnTopic("pid", "open", 11, 1001))
+}
+
+func TestAntiFlood_TopicThresholdZeroValueShouldNotLimit(t *testing.T) {
+	t.Parallel()
+
+	daf := &AntiFlood{}
+	daf.SetTopicThreshold("limited", 0, 1000)
+
+	assert.Nil(t, daf.CanProcessMessagesOnTopic("pid", "limited", 1000000, 1000))
+	assert.NotNil(t, daf.CanProcessMessagesOnTopic("pid", "limited", 1, 1001))
+}
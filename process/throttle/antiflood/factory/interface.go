@@ -0,0 +1,9 @@
+package factory
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// PeerAddressProvider is able to return the network address of a peer
+type PeerAddressProvider interface {
+	PeerAddress(pid core.PeerID) string
+	IsInterfaceNil() bool
+}
@@ -2,6 +2,7 @@ package factory
 
 import (
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -24,21 +25,24 @@ var durationSweepP2PBlacklist = time.Second * 5
 var log = logger.GetOrCreate("p2p/antiflood/factory")
 
 const defaultSpan = 300 * time.Second
+const quotaSnapshotInterval = time.Second
 const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
 const outputIdentifier = "output"
 
-// NewP2PAntiFloodAndBlackList will return instances of antiflood and blacklist, based on the config
+// NewP2PAntiFloodAndBlackList will return instances of antiflood and blacklist, based on the config. The peer address
+// provider is only needed if the quotas are shared by the peers of an IP subnet
 func NewP2PAntiFloodAndBlackList(
 	config config.Config,
 	statusHandler core.AppStatusHandler,
+	peerAddressProvider PeerAddressProvider,
 ) (process.P2PAntifloodHandler, process.PeerBlackListHandler, error) {
 	if check.IfNil(statusHandler) {
 		return nil, nil, p2p.ErrNilStatusHandler
 	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodAndBlackList(config, statusHandler)
+		return initP2PAntiFloodAndBlackList(config, statusHandler, peerAddressProvider)
 	}
 
 	return &disabled.AntiFlood{}, &disabled.PeerBlacklistHandler{}, nil
@@ -47,6 +51,7 @@ func NewP2PAntiFloodAndBlackList(
 func initP2PAntiFloodAndBlackList(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
+	peerAddressProvider PeerAddressProvider,
 ) (process.P2PAntifloodHandler, process.PeerBlackListHandler, error) {
 	cache := timecache.NewTimeCache(defaultSpan)
 	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
@@ -54,10 +59,16 @@ func initP2PAntiFloodAndBlackList(
 		return nil, nil, err
 	}
 
+	identifierNormalizer, err := createIdentifierNormalizer(mainConfig.Antiflood.QuotaGranularity, peerAddressProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		mainConfig.Antiflood.FastReacting,
 		mainConfig.Antiflood.Cache,
 		statusHandler,
+		identifierNormalizer,
 		fastReactingIdentifier,
 		p2pPeerBlackList,
 	)
@@ -69,6 +80,7 @@ func initP2PAntiFloodAndBlackList(
 		mainConfig.Antiflood.SlowReacting,
 		mainConfig.Antiflood.Cache,
 		statusHandler,
+		identifierNormalizer,
 		slowReactingIdentifier,
 		p2pPeerBlackList,
 	)
@@ -80,6 +92,7 @@ func initP2PAntiFloodAndBlackList(
 		mainConfig.Antiflood.OutOfSpecs,
 		mainConfig.Antiflood.Cache,
 		statusHandler,
+		identifierNormalizer,
 		outOfSpecsIdentifier,
 		p2pPeerBlackList,
 	)
@@ -153,6 +166,7 @@ func createFloodPreventer(
 	floodPreventerConfig config.FloodPreventerConfig,
 	antifloodCacheConfig config.CacheConfig,
 	statusHandler core.AppStatusHandler,
+	identifierNormalizer func(pid core.PeerID) core.PeerID,
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListHandler,
 ) (process.FloodPreventer, error) {
@@ -184,6 +198,11 @@ func createFloodPreventer(
 		return nil, err
 	}
 
+	overQuotaCache, err := storageUnit.NewCache(cacheConfig.Type, cacheConfig.Capacity, cacheConfig.Shards, cacheConfig.SizeInBytes)
+	if err != nil {
+		return nil, err
+	}
+
 	basePeerMaxMessagesPerInterval := floodPreventerConfig.PeerMaxInput.BaseMessagesPerInterval
 	peerMaxTotalSizePerInterval := floodPreventerConfig.PeerMaxInput.TotalSizePerInterval
 	reservedPercent := floodPreventerConfig.ReservedPercent
@@ -197,6 +216,10 @@ func createFloodPreventer(
 		PercentReserved:           reservedPercent,
 		IncreaseThreshold:         floodPreventerConfig.PeerMaxInput.IncreaseFactor.Threshold,
 		IncreaseFactor:            floodPreventerConfig.PeerMaxInput.IncreaseFactor.Factor,
+		OverQuotaCacher:           overQuotaCache,
+		OverQuotaThreshold:        floodPreventerConfig.BlackList.NumOverQuotaIntervals,
+		OverQuotaHandler:          blackListProcessor,
+		IdentifierNormalizer:      identifierNormalizer,
 	}
 	floodPreventer, err := floodPreventers.NewQuotaFloodPreventer(argFloodPreventer)
 	if err != nil {
@@ -206,24 +229,66 @@ func createFloodPreventer(
 	log.Debug("started antiflood & blacklist component",
 		"type", quotaIdentifier,
 		"interval in seconds", floodPreventerConfig.IntervalInSeconds,
+		"reset jitter in milliseconds", floodPreventerConfig.ResetJitterInMilliseconds,
 		"base peerMaxMessagesPerInterval", basePeerMaxMessagesPerInterval,
 		"peerMaxTotalSizePerInterval", core.ConvertBytes(peerMaxTotalSizePerInterval),
 		"peerBanDurationInSeconds", floodPreventerConfig.BlackList.PeerBanDurationInSeconds,
 		"thresholdNumMessagesPerSecond", floodPreventerConfig.BlackList.ThresholdNumMessagesPerInterval,
 		"thresholdSizePerSecond", floodPreventerConfig.BlackList.ThresholdSizePerInterval,
 		"numFloodingRounds", floodPreventerConfig.BlackList.NumFloodingRounds,
+		"numOverQuotaIntervals", floodPreventerConfig.BlackList.NumOverQuotaIntervals,
 		"increase threshold", floodPreventerConfig.PeerMaxInput.IncreaseFactor.Threshold,
 		"increase factor", floodPreventerConfig.PeerMaxInput.IncreaseFactor.Factor,
 	)
 
 	go func() {
-		wait := time.Duration(floodPreventerConfig.IntervalInSeconds) * time.Second
+		interval := time.Duration(floodPreventerConfig.IntervalInSeconds) * time.Second
+		jitter := time.Duration(floodPreventerConfig.ResetJitterInMilliseconds) * time.Millisecond
+		randomizer := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 		for {
-			time.Sleep(wait)
+			time.Sleep(computeResetInterval(randomizer, interval, jitter))
 			floodPreventer.Reset()
 		}
 	}()
 
+	go func() {
+		for {
+			time.Sleep(quotaSnapshotInterval)
+			setQuotaSnapshotMetrics(statusHandler, quotaIdentifier, floodPreventer)
+		}
+	}()
+
 	return floodPreventer, nil
 }
+
+// setQuotaSnapshotMetrics publishes the quotas of the current interval without resetting them, so they can be
+// monitored between the flood preventer resets
+func setQuotaSnapshotMetrics(
+	statusHandler core.AppStatusHandler,
+	quotaIdentifier string,
+	snapshotHandler floodPreventers.QuotaSnapshotHandler,
+) {
+	snapshot := snapshotHandler.Snapshot()
+
+	maxNumReceivedMessages := uint32(0)
+	maxSizeReceivedMessages := uint64(0)
+	for _, pq := range snapshot {
+		maxNumReceivedMessages = core.MaxUint32(maxNumReceivedMessages, pq.NumReceivedMessages)
+		maxSizeReceivedMessages = core.MaxUint64(maxSizeReceivedMessages, pq.SizeReceivedMessages)
+	}
+
+	statusHandler.SetUInt64Value(core.MetricP2PLivePeerNumReceivedMessages+"_"+quotaIdentifier, uint64(maxNumReceivedMessages))
+	statusHandler.SetUInt64Value(core.MetricP2PLivePeerSizeReceivedMessages+"_"+quotaIdentifier, maxSizeReceivedMessages)
+	statusHandler.SetUInt64Value(core.MetricP2PLiveNumReceiverPeers+"_"+quotaIdentifier, uint64(len(snapshot)))
+}
+
+// computeResetInterval returns the base interval increased with a random value in the [0, jitter] range so the flood
+// preventers of different nodes will not reset all at the same time
+func computeResetInterval(randomizer *rand.Rand, interval time.Duration, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return interval
+	}
+
+	return interval + time.Duration(randomizer.Int63n(int64(jitter)+1))
+}
@@ -1,12 +1,17 @@
 package factory
 
 import (
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,7 +19,7 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.Config{}
-	af, bl, err := NewP2PAntiFloodAndBlackList(cfg, nil)
+	af, bl, err := NewP2PAntiFloodAndBlackList(cfg, nil, nil)
 	assert.Nil(t, af)
 	assert.Nil(t, bl)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
@@ -29,7 +34,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 		},
 	}
 	ash := &mock.AppStatusHandlerMock{}
-	af, bl, err := NewP2PAntiFloodAndBlackList(cfg, ash)
+	af, bl, err := NewP2PAntiFloodAndBlackList(cfg, ash, nil)
 	assert.NotNil(t, af)
 	assert.NotNil(t, bl)
 	assert.Nil(t, err)
@@ -61,7 +66,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	}
 
 	ash := &mock.AppStatusHandlerMock{}
-	af, bl, err := NewP2PAntiFloodAndBlackList(cfg, ash)
+	af, bl, err := NewP2PAntiFloodAndBlackList(cfg, ash, nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, af)
 	assert.NotNil(t, bl)
@@ -82,3 +87,54 @@ func createFloodPreventerConfig() config.FloodPreventerConfig {
 		},
 	}
 }
+
+func TestComputeResetInterval_ZeroJitterShouldReturnTheBaseInterval(t *testing.T) {
+	t.Parallel()
+
+	randomizer := rand.New(rand.NewSource(0))
+	interval := time.Second
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, interval, computeResetInterval(randomizer, interval, 0))
+	}
+}
+
+func TestComputeResetInterval_ShouldVaryWithinJitterBounds(t *testing.T) {
+	t.Parallel()
+
+	randomizer := rand.New(rand.NewSource(0))
+	interval := time.Second
+	jitter := 100 * time.Millisecond
+	intervals := make(map[time.Duration]struct{})
+	for i := 0; i < 100; i++ {
+		computed := computeResetInterval(randomizer, interval, jitter)
+		assert.True(t, computed >= interval)
+		assert.True(t, computed <= interval+jitter)
+		intervals[computed] = struct{}{}
+	}
+
+	assert.True(t, len(intervals) > 1)
+}
+
+func TestSetQuotaSnapshotMetrics_ShouldPublishTheCurrentQuotasWithoutResettingThem(t *testing.T) {
+	t.Parallel()
+
+	cacher, _ := lrucache.NewCache(10)
+	qfp, _ := floodPreventers.NewQuotaFloodPreventer(floodPreventers.ArgQuotaFloodPreventer{
+		Name:                      "test",
+		Cacher:                    cacher,
+		StatusHandlers:            make([]floodPreventers.QuotaStatusHandler, 0),
+		BaseMaxNumMessagesPerPeer: 100,
+		MaxTotalSizePerPeer:       10000,
+	})
+	_ = qfp.IncreaseLoad("pid1", 10)
+	_ = qfp.IncreaseLoad("pid1", 20)
+	_ = qfp.IncreaseLoad("pid2", 50)
+
+	ash := mock.NewAppStatusHandlerMock()
+	setQuotaSnapshotMetrics(ash, "identifier", qfp)
+
+	assert.Equal(t, uint64(2), ash.GetUint64(core.MetricP2PLivePeerNumReceivedMessages+"_identifier"))
+	assert.Equal(t, uint64(50), ash.GetUint64(core.MetricP2PLivePeerSizeReceivedMessages+"_identifier"))
+	assert.Equal(t, uint64(2), ash.GetUint64(core.MetricP2PLiveNumReceiverPeers+"_identifier"))
+	assert.Equal(t, 2, len(qfp.Snapshot()))
+}
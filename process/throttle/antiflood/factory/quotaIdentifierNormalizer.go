@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const peerQuotaGranularity = "peer"
+const ip4SubnetQuotaGranularity = "ip4subnet"
+const ip4SubnetMaskBits = 24
+
+// createIdentifierNormalizer returns the function which maps the peers to the identifier their quota is shared on. A nil
+// function is returned for the default peer granularity, as each peer has its own quota
+func createIdentifierNormalizer(
+	quotaGranularity string,
+	peerAddressProvider PeerAddressProvider,
+) (func(pid core.PeerID) core.PeerID, error) {
+	switch quotaGranularity {
+	case "", peerQuotaGranularity:
+		return nil, nil
+	case ip4SubnetQuotaGranularity:
+		if check.IfNil(peerAddressProvider) {
+			return nil, fmt.Errorf("%w for the %s quota granularity", process.ErrNilMessenger, quotaGranularity)
+		}
+
+		return func(pid core.PeerID) core.PeerID {
+			return ip4SubnetIdentifier(pid, peerAddressProvider.PeerAddress(pid))
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w, QuotaGranularity: provided %s, known %s and %s",
+			process.ErrInvalidValue,
+			quotaGranularity,
+			peerQuotaGranularity,
+			ip4SubnetQuotaGranularity,
+		)
+	}
+}
+
+// ip4SubnetIdentifier returns the /24 subnet of a peer address such as /ip4/10.0.0.1/tcp/37373. The peer ID is returned
+// if the address is unknown or is not an IPv4 one, so that the peer keeps its own quota
+func ip4SubnetIdentifier(pid core.PeerID, address string) core.PeerID {
+	parts := strings.Split(address, "/")
+	if len(parts) < 3 || parts[1] != "ip4" {
+		return pid
+	}
+
+	ip := net.ParseIP(parts[2]).To4()
+	if ip == nil {
+		return pid
+	}
+
+	subnet := net.IPNet{
+		IP:   ip.Mask(net.CIDRMask(ip4SubnetMaskBits, 32)),
+		Mask: net.CIDRMask(ip4SubnetMaskBits, 32),
+	}
+
+	return core.PeerID(subnet.String())
+}
@@ -0,0 +1,61 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateIdentifierNormalizer_PeerGranularityShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	normalizer, err := createIdentifierNormalizer(peerQuotaGranularity, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, normalizer)
+
+	normalizer, err = createIdentifierNormalizer("", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, normalizer)
+}
+
+func TestCreateIdentifierNormalizer_UnknownGranularityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	normalizer, err := createIdentifierNormalizer("country", &mock.PeerAddressProviderStub{})
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.Nil(t, normalizer)
+}
+
+func TestCreateIdentifierNormalizer_SubnetGranularityWithNilProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	normalizer, err := createIdentifierNormalizer(ip4SubnetQuotaGranularity, nil)
+	assert.True(t, errors.Is(err, process.ErrNilMessenger))
+	assert.Nil(t, normalizer)
+}
+
+func TestCreateIdentifierNormalizer_SubnetGranularityShouldMapToTheSubnet(t *testing.T) {
+	t.Parallel()
+
+	addresses := map[core.PeerID]string{
+		"pid1": "/ip4/192.168.1.10/tcp/37373",
+		"pid2": "/ip4/192.168.1.20/tcp/37374",
+		"pid3": "/ip6/::1/tcp/37373",
+	}
+	provider := &mock.PeerAddressProviderStub{
+		PeerAddressCalled: func(pid core.PeerID) string {
+			return addresses[pid]
+		},
+	}
+
+	normalizer, err := createIdentifierNormalizer(ip4SubnetQuotaGranularity, provider)
+	assert.Nil(t, err)
+	assert.Equal(t, core.PeerID("192.168.1.0/24"), normalizer("pid1"))
+	assert.Equal(t, core.PeerID("192.168.1.0/24"), normalizer("pid2"))
+	assert.Equal(t, core.PeerID("pid3"), normalizer("pid3"))
+	assert.Equal(t, core.PeerID("unknown"), normalizer("unknown"))
+}
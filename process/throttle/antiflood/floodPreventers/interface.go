@@ -9,3 +9,17 @@ type QuotaStatusHandler interface {
 	AddQuota(pid core.PeerID, numReceived uint32, sizeReceived uint64, numProcessed uint32, sizeProcessed uint64)
 	IsInterfaceNil() bool
 }
+
+// QuotaSnapshotHandler defines the behavior of a flood preventer able to provide a copy of the peers quota measured in
+// the current interval, without clearing them
+type QuotaSnapshotHandler interface {
+	Snapshot() []PeerQuota
+	IsInterfaceNil() bool
+}
+
+// RepeatedOverQuotaHandler defines the behavior of a component able to react when a peer exceeded its quota in too
+// many intervals
+type RepeatedOverQuotaHandler interface {
+	RepeatedOverQuota(pid core.PeerID, numIntervals uint32)
+	IsInterfaceNil() bool
+}
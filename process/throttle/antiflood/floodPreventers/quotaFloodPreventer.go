@@ -2,9 +2,12 @@ package floodPreventers
 
 import (
 	"fmt"
+	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -20,16 +23,24 @@ type ArgQuotaFloodPreventer struct {
 	PercentReserved           float32
 	IncreaseThreshold         uint32
 	IncreaseFactor            float32
+	OverQuotaCacher           storage.Cacher
+	OverQuotaThreshold        uint32
+	OverQuotaHandler          RepeatedOverQuotaHandler
+	// IdentifierNormalizer, if set, maps the peers to a shared identifier (e.g. the IP subnet) whose quota limits all
+	// of them together. The statistics are still reported for each peer
+	IdentifierNormalizer func(pid core.PeerID) core.PeerID
 }
 
 var _ process.FloodPreventer = (*quotaFloodPreventer)(nil)
+var _ QuotaSnapshotHandler = (*quotaFloodPreventer)(nil)
 
 const minMessages = 1
 const minTotalSize = 1 //1Byte
-const initNumMessages = 1
 const maxPercentReserved = 90.0
 const minPercentReserved = 0.0
 const quotaStructSize = 24
+const overQuotaIntervalsSize = 4
+const statusHandlersTimeout = time.Second
 
 type quota struct {
 	numReceivedMessages   uint32
@@ -55,6 +66,13 @@ type quotaFloodPreventer struct {
 	percentReserved               float32
 	increaseThreshold             uint32
 	increaseFactor                float32
+	overQuotaCacher               storage.Cacher
+	overQuotaThreshold            uint32
+	overQuotaHandler              RepeatedOverQuotaHandler
+	identifierNormalizer          func(pid core.PeerID) core.PeerID
+	identifierQuotas              map[core.PeerID]*quota
+	statusHandlersTimeout         time.Duration
+	isNotifying                   atomic.Flag
 }
 
 // NewQuotaFloodPreventer creates a new flood preventer based on quota / peer
@@ -102,6 +120,14 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 			arg.IncreaseFactor,
 		)
 	}
+	if arg.OverQuotaThreshold > 0 {
+		if check.IfNil(arg.OverQuotaCacher) {
+			return nil, fmt.Errorf("%w for the over quota intervals", process.ErrNilCacher)
+		}
+		if check.IfNil(arg.OverQuotaHandler) {
+			return nil, process.ErrNilRepeatedOverQuotaHandler
+		}
+	}
 
 	return &quotaFloodPreventer{
 		name:                          arg.Name,
@@ -113,6 +139,12 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 		percentReserved:               arg.PercentReserved,
 		increaseThreshold:             arg.IncreaseThreshold,
 		increaseFactor:                arg.IncreaseFactor,
+		overQuotaCacher:               arg.OverQuotaCacher,
+		overQuotaThreshold:            arg.OverQuotaThreshold,
+		overQuotaHandler:              arg.OverQuotaHandler,
+		identifierNormalizer:          arg.IdentifierNormalizer,
+		identifierQuotas:              make(map[core.PeerID]*quota),
+		statusHandlersTimeout:         statusHandlersTimeout,
 	}, nil
 }
 
@@ -122,80 +154,163 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 // Otherwise we might yield a slightly higher number of false valid increments
 // This method also checks the global sum quota but does not increment its values
 func (qfp *quotaFloodPreventer) IncreaseLoad(pid core.PeerID, size uint64) error {
+	_, _, err := qfp.IncreaseLoadWithRemaining(pid, size)
+
+	return err
+}
+
+// IncreaseLoadWithRemaining behaves like IncreaseLoad but also returns the number of messages and the number of bytes
+// the peer is still allowed to send in the current interval, so callers can degrade gracefully before the hard rejection
+func (qfp *quotaFloodPreventer) IncreaseLoadWithRemaining(pid core.PeerID, size uint64) (uint32, uint64, error) {
 	qfp.mutOperation.Lock()
 	defer qfp.mutOperation.Unlock()
 
-	return qfp.increaseLoad(pid, size)
+	q, err := qfp.increaseLoad(pid, size)
+	remainingMessages := qfp.computeRemaining(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
+	remainingBytes := qfp.computeRemaining(qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
+
+	return uint32(remainingMessages), remainingBytes, err
+}
+
+// increaseLoad accounts the message in the quota of the peer. If an identifier normalizer is set, the message is also
+// accounted in the quota of the normalized identifier, which is the one checked against the limits. The first message
+// of a quota is always accepted
+func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) (*quota, error) {
+	peerQuota, isNewPeerQuota := qfp.getPeerQuota(pid)
+	limitedQuota, isNewLimitedQuota := peerQuota, isNewPeerQuota
+	if qfp.identifierNormalizer != nil {
+		limitedQuota, isNewLimitedQuota = qfp.getIdentifierQuota(qfp.identifierNormalizer(pid))
+	}
+	quotas := []*quota{limitedQuota}
+	if limitedQuota != peerQuota {
+		quotas = append(quotas, peerQuota)
+	}
+
+	for _, q := range quotas {
+		q.numReceivedMessages++
+		q.sizeReceivedMessages += size
+	}
+	if isNewPeerQuota {
+		qfp.cacher.Put(pid.Bytes(), peerQuota, peerQuota.Size())
+	}
+
+	if !isNewLimitedQuota {
+		maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(limitedQuota.numReceivedMessages))
+		maxSizeMessagesReached := qfp.isMaximumReached(qfp.maxTotalSizePerPeer, limitedQuota.sizeReceivedMessages)
+		isPeerQuotaReached := maxNumMessagesReached || maxSizeMessagesReached
+		if isPeerQuotaReached {
+			return limitedQuota, fmt.Errorf("%w for pid %s", process.ErrSystemBusy, pid.Pretty())
+		}
+	}
+
+	for _, q := range quotas {
+		q.numProcessedMessages++
+		q.sizeProcessedMessages += size
+	}
+
+	return limitedQuota, nil
 }
 
-func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error {
+// getPeerQuota returns the quota of the peer, or a new one which has to be put in the cacher, and true if it is new
+func (qfp *quotaFloodPreventer) getPeerQuota(pid core.PeerID) (*quota, bool) {
 	valueQuota, ok := qfp.cacher.Get(pid.Bytes())
 	if !ok {
-		qfp.putDefaultQuota(pid, size)
-
-		return nil
+		return &quota{}, true
 	}
 
 	q, isQuota := valueQuota.(*quota)
 	if !isQuota {
-		qfp.putDefaultQuota(pid, size)
-
-		return nil
+		return &quota{}, true
 	}
 
-	q.numReceivedMessages++
-	q.sizeReceivedMessages += size
+	return q, false
+}
 
-	maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
-	maxSizeMessagesReached := qfp.isMaximumReached(qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
-	isPeerQuotaReached := maxNumMessagesReached || maxSizeMessagesReached
-	if isPeerQuotaReached {
-		return fmt.Errorf("%w for pid %s", process.ErrSystemBusy, pid.Pretty())
+// getIdentifierQuota returns the quota shared by the peers mapped to the identifier and true if it has just been created
+func (qfp *quotaFloodPreventer) getIdentifierQuota(identifier core.PeerID) (*quota, bool) {
+	q, found := qfp.identifierQuotas[identifier]
+	if found {
+		return q, false
 	}
 
-	q.numProcessedMessages++
-	q.sizeProcessedMessages += size
+	q = &quota{}
+	qfp.identifierQuotas[identifier] = q
 
-	return nil
+	return q, true
 }
 
 func (qfp *quotaFloodPreventer) isMaximumReached(absoluteMax uint64, counted uint64) bool {
-	max := uint64(100-qfp.percentReserved) * absoluteMax / 100
-
-	return counted > max
+	return counted > qfp.computeMaximum(absoluteMax)
 }
 
-func (qfp *quotaFloodPreventer) putDefaultQuota(pid core.PeerID, size uint64) {
-	q := &quota{
-		numReceivedMessages:   initNumMessages,
-		sizeReceivedMessages:  size,
-		numProcessedMessages:  initNumMessages,
-		sizeProcessedMessages: size,
+func (qfp *quotaFloodPreventer) computeRemaining(absoluteMax uint64, counted uint64) uint64 {
+	max := qfp.computeMaximum(absoluteMax)
+	if counted >= max {
+		return 0
 	}
-	qfp.cacher.Put(pid.Bytes(), q, q.Size())
+
+	return max - counted
+}
+
+func (qfp *quotaFloodPreventer) computeMaximum(absoluteMax uint64) uint64 {
+	return uint64(100-qfp.percentReserved) * absoluteMax / 100
 }
 
-// Reset clears all map values
+// Reset clears all map values. The status handlers are notified with a copy of the quotas after the lock is released
+// and defensively, so a misbehaving handler can neither prevent the quotas from being cleared nor block the messages
+// accounting
 func (qfp *quotaFloodPreventer) Reset() {
 	qfp.mutOperation.Lock()
-	defer qfp.mutOperation.Unlock()
-
-	qfp.resetStatusHandlers()
-	qfp.createStatistics()
+	quotas := qfp.createStatistics()
+	qfp.updateOverQuotaIntervals()
 
 	//TODO change this if cacher.Clear() is time consuming
 	qfp.cacher.Clear()
+	qfp.identifierQuotas = make(map[core.PeerID]*quota)
+	qfp.mutOperation.Unlock()
+
+	qfp.notifyStatusHandlers(quotas)
 }
 
-func (qfp *quotaFloodPreventer) resetStatusHandlers() {
-	for _, statusHandler := range qfp.statusHandlers {
-		statusHandler.ResetStatistics()
+// PeerQuota holds the counters of a peer measured in the current interval
+type PeerQuota struct {
+	Pid                   core.PeerID
+	NumReceivedMessages   uint32
+	SizeReceivedMessages  uint64
+	NumProcessedMessages  uint32
+	SizeProcessedMessages uint64
+}
+
+// Snapshot returns a copy of the current quotas. The quotas are not cleared and the status handlers are not notified,
+// so the statistics can be observed without affecting the enforcement
+func (qfp *quotaFloodPreventer) Snapshot() []PeerQuota {
+	qfp.mutOperation.RLock()
+	quotas := qfp.createStatistics()
+	qfp.mutOperation.RUnlock()
+
+	snapshot := make([]PeerQuota, 0, len(quotas))
+	for _, pq := range quotas {
+		snapshot = append(snapshot, PeerQuota{
+			Pid:                   pq.pid,
+			NumReceivedMessages:   pq.numReceivedMessages,
+			SizeReceivedMessages:  pq.sizeReceivedMessages,
+			NumProcessedMessages:  pq.numProcessedMessages,
+			SizeProcessedMessages: pq.sizeProcessedMessages,
+		})
 	}
+
+	return snapshot
+}
+
+type peerQuota struct {
+	pid core.PeerID
+	quota
 }
 
 // createStatistics is useful to benchmark the system when running
-func (qfp *quotaFloodPreventer) createStatistics() {
+func (qfp *quotaFloodPreventer) createStatistics() []peerQuota {
 	keys := qfp.cacher.Keys()
+	quotas := make([]peerQuota, 0, len(keys))
 	for _, k := range keys {
 		val, ok := qfp.cacher.Get(k)
 		if !ok {
@@ -207,14 +322,139 @@ func (qfp *quotaFloodPreventer) createStatistics() {
 			continue
 		}
 
-		qfp.addQuota(
-			core.PeerID(k),
-			q.numReceivedMessages,
-			q.sizeReceivedMessages,
-			q.numProcessedMessages,
-			q.sizeProcessedMessages,
+		quotas = append(quotas, peerQuota{pid: core.PeerID(k), quota: *q})
+	}
+
+	return quotas
+}
+
+// notifyStatusHandlers resets the status handlers and provides them the quotas of the ending interval. The handlers
+// are called on a separate go routine which is not waited for longer than the status handlers timeout and a panic
+// raised by a handler is recovered. The notification is skipped while the previous one is still running, so the
+// handlers are never called concurrently
+func (qfp *quotaFloodPreventer) notifyStatusHandlers(quotas []peerQuota) {
+	if len(qfp.statusHandlers) == 0 {
+		return
+	}
+	wasNotifying := qfp.isNotifying.Set()
+	if wasNotifying {
+		log.Debug("quotaFloodPreventer.notifyStatusHandlers: previous notification still running, skipping",
+			"name", qfp.name,
 		)
+		return
 	}
+
+	chDone := make(chan struct{})
+	go func() {
+		defer func() {
+			qfp.isNotifying.Unset()
+			r := recover()
+			if r != nil {
+				log.Warn("quotaFloodPreventer.notifyStatusHandlers: status handler panicked",
+					"name", qfp.name,
+					"error", r,
+					"stack trace", string(debug.Stack()),
+				)
+			}
+			close(chDone)
+		}()
+
+		qfp.resetStatusHandlers()
+		for _, pq := range quotas {
+			qfp.addQuota(
+				pq.pid,
+				pq.numReceivedMessages,
+				pq.sizeReceivedMessages,
+				pq.numProcessedMessages,
+				pq.sizeProcessedMessages,
+			)
+		}
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(qfp.statusHandlersTimeout):
+		log.Warn("quotaFloodPreventer.notifyStatusHandlers: status handlers timed out",
+			"name", qfp.name,
+			"timeout", qfp.statusHandlersTimeout,
+		)
+	}
+}
+
+func (qfp *quotaFloodPreventer) resetStatusHandlers() {
+	for _, statusHandler := range qfp.statusHandlers {
+		statusHandler.ResetStatistics()
+	}
+}
+
+// updateOverQuotaIntervals counts, for each peer, the intervals in which it exceeded its quota. The count decays with
+// each interval in which the peer stayed within its quota and the handler is notified when the threshold is reached
+func (qfp *quotaFloodPreventer) updateOverQuotaIntervals() {
+	if qfp.overQuotaThreshold == 0 {
+		return
+	}
+
+	overQuotaPeers := make(map[core.PeerID]struct{})
+	for _, k := range qfp.cacher.Keys() {
+		val, ok := qfp.cacher.Peek(k)
+		if !ok {
+			continue
+		}
+
+		q, isQuota := val.(*quota)
+		if !isQuota {
+			continue
+		}
+
+		if q.numReceivedMessages > q.numProcessedMessages {
+			overQuotaPeers[core.PeerID(k)] = struct{}{}
+		}
+	}
+
+	for _, k := range qfp.overQuotaCacher.Keys() {
+		_, isOverQuota := overQuotaPeers[core.PeerID(k)]
+		if isOverQuota {
+			continue
+		}
+
+		numIntervals := qfp.getNumOverQuotaIntervals(k)
+		if numIntervals <= 1 {
+			qfp.overQuotaCacher.Remove(k)
+			continue
+		}
+
+		qfp.overQuotaCacher.Put(k, numIntervals-1, overQuotaIntervalsSize)
+	}
+
+	for pid := range overQuotaPeers {
+		numIntervals := qfp.getNumOverQuotaIntervals(pid.Bytes()) + 1
+		if numIntervals >= qfp.overQuotaThreshold {
+			qfp.overQuotaCacher.Remove(pid.Bytes())
+			log.Debug("peer exceeded its quota in too many intervals",
+				"name", qfp.name,
+				"pid", pid.Pretty(),
+				"num intervals", numIntervals,
+			)
+			qfp.overQuotaHandler.RepeatedOverQuota(pid, numIntervals)
+			continue
+		}
+
+		qfp.overQuotaCacher.Put(pid.Bytes(), numIntervals, overQuotaIntervalsSize)
+	}
+}
+
+func (qfp *quotaFloodPreventer) getNumOverQuotaIntervals(key []byte) uint32 {
+	val, ok := qfp.overQuotaCacher.Peek(key)
+	if !ok {
+		return 0
+	}
+
+	numIntervals, ok := val.(uint32)
+	if !ok {
+		return 0
+	}
+
+	return numIntervals
 }
 
 func (qfp *quotaFloodPreventer) addQuota(
@@ -4,8 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -106,6 +109,30 @@ func TestNewQuotaFloodPreventer_NegativeIncreaseFactorShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, process.ErrInvalidValue))
 }
 
+func TestNewQuotaFloodPreventer_NilOverQuotaCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.OverQuotaThreshold = 2
+	arg.OverQuotaHandler = &mock.RepeatedOverQuotaHandlerStub{}
+	qfp, err := NewQuotaFloodPreventer(arg)
+
+	assert.True(t, check.IfNil(qfp))
+	assert.True(t, errors.Is(err, process.ErrNilCacher))
+}
+
+func TestNewQuotaFloodPreventer_NilOverQuotaHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.OverQuotaThreshold = 2
+	arg.OverQuotaCacher = &mock.CacherStub{}
+	qfp, err := NewQuotaFloodPreventer(arg)
+
+	assert.True(t, check.IfNil(qfp))
+	assert.Equal(t, process.ErrNilRepeatedOverQuotaHandler, err)
+}
+
 func TestNewQuotaFloodPreventer_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -298,6 +325,73 @@ func TestCountersMap_IncreaseLoadShouldWorkConcurrently(t *testing.T) {
 	wg.Wait()
 }
 
+func TestNewQuotaFloodPreventer_IncreaseLoadWithRemainingShouldDecrementRemainingValues(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	arg.MaxTotalSizePerPeer = 1000
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	// 10% reserved, so only 9 messages and 900 bytes are allowed
+	size := uint64(100)
+	pid := core.PeerID("pid")
+	for i := uint32(1); i <= 9; i++ {
+		remainingMessages, remainingBytes, err := qfp.IncreaseLoadWithRemaining(pid, size)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 9-i, remainingMessages)
+		assert.Equal(t, 900-uint64(i)*size, remainingBytes)
+	}
+
+	remainingMessages, remainingBytes, err := qfp.IncreaseLoadWithRemaining(pid, size)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+	assert.Equal(t, uint32(0), remainingMessages)
+	assert.Equal(t, uint64(0), remainingBytes)
+}
+
+func TestNewQuotaFloodPreventer_IncreaseLoadWithIdentifierNormalizerShouldShareQuota(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	arg.MaxTotalSizePerPeer = 1000
+	arg.IdentifierNormalizer = func(pid core.PeerID) core.PeerID {
+		ip := string(pid)
+		return core.PeerID(ip[:strings.LastIndex(ip, ".")] + ".0/24")
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	// 10% reserved, so only 9 messages are allowed for the whole subnet
+	size := uint64(1)
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, qfp.IncreaseLoad("192.168.1.10", size))
+		assert.Nil(t, qfp.IncreaseLoad("192.168.1.20", size))
+	}
+	assert.Nil(t, qfp.IncreaseLoad("192.168.2.10", size))
+	assert.Equal(t, 3, arg.Cacher.Len())
+
+	remainingMessages, _, err := qfp.IncreaseLoadWithRemaining("192.168.1.30", size)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), remainingMessages)
+
+	remainingMessages, _, err = qfp.IncreaseLoadWithRemaining("192.168.1.40", size)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+	assert.Equal(t, uint32(0), remainingMessages)
+
+	// the statistics are reported for each peer, not for the shared identifier
+	quotas := make(map[core.PeerID]PeerQuota)
+	for _, pq := range qfp.Snapshot() {
+		quotas[pq.Pid] = pq
+	}
+	assert.Equal(t, 5, len(quotas))
+	assert.Equal(t, uint32(4), quotas["192.168.1.10"].NumProcessedMessages)
+	assert.Equal(t, uint32(1), quotas["192.168.1.40"].NumReceivedMessages)
+	assert.Equal(t, uint32(0), quotas["192.168.1.40"].NumProcessedMessages)
+}
+
 //------- Reset
 
 func TestCountersMap_ResetShouldCallCacherClear(t *testing.T) {
@@ -385,6 +479,155 @@ func TestCountersMap_ResetShouldCallQuotaStatus(t *testing.T) {
 	assert.True(t, quota2Compared)
 }
 
+func TestCountersMap_ResetWithPanickingStatusHandlerShouldStillClearTheCacher(t *testing.T) {
+	t.Parallel()
+
+	cacher := mock.NewCacherMock()
+	q := &quota{numReceivedMessages: 1}
+	cacher.HasOrAdd([]byte("key"), q, q.Size())
+
+	arg := createDefaultArgument()
+	arg.Cacher = cacher
+	arg.StatusHandlers = []QuotaStatusHandler{
+		&mock.QuotaStatusHandlerStub{
+			ResetStatisticsCalled: func() {},
+			AddQuotaCalled: func(_ core.PeerID, _ uint32, _ uint64, _ uint32, _ uint64) {
+				panic("status handler panic")
+			},
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	assert.NotPanics(t, qfp.Reset)
+	assert.Equal(t, 0, cacher.Len())
+}
+
+func TestCountersMap_ResetWithSlowStatusHandlerShouldNotWaitForIt(t *testing.T) {
+	t.Parallel()
+
+	cacher := mock.NewCacherMock()
+	q := &quota{numReceivedMessages: 1}
+	cacher.HasOrAdd([]byte("key"), q, q.Size())
+
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+	arg := createDefaultArgument()
+	arg.Cacher = cacher
+	arg.StatusHandlers = []QuotaStatusHandler{
+		&mock.QuotaStatusHandlerStub{
+			ResetStatisticsCalled: func() {
+				<-chRelease
+			},
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.statusHandlersTimeout = time.Millisecond * 10
+
+	qfp.Reset()
+
+	assert.Equal(t, 0, cacher.Len())
+}
+
+func TestCountersMap_ResetShouldNotifyTheStatusHandlersWithoutHoldingTheLock(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	var qfp *quotaFloodPreventer
+	increaseLoadDone := false
+	arg.StatusHandlers = []QuotaStatusHandler{
+		&mock.QuotaStatusHandlerStub{
+			ResetStatisticsCalled: func() {
+				_ = qfp.IncreaseLoad("identifier", 1)
+				increaseLoadDone = true
+			},
+		},
+	}
+	qfp, _ = NewQuotaFloodPreventer(arg)
+	qfp.statusHandlersTimeout = time.Second * 10
+
+	qfp.Reset()
+
+	assert.True(t, increaseLoadDone)
+}
+
+func TestCountersMap_ResetWhileTheStatusHandlersAreStillRunningShouldSkipTheNotification(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	numResets := uint32(0)
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	arg.StatusHandlers = []QuotaStatusHandler{
+		&mock.QuotaStatusHandlerStub{
+			ResetStatisticsCalled: func() {
+				atomic.AddUint32(&numResets, 1)
+				<-chRelease
+			},
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.statusHandlersTimeout = time.Millisecond * 10
+
+	qfp.Reset()
+	qfp.Reset()
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numResets))
+
+	close(chRelease)
+	for qfp.isNotifying.IsSet() {
+		time.Sleep(time.Millisecond)
+	}
+
+	qfp.Reset()
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numResets))
+}
+
+func TestCountersMap_SnapshotShouldReturnQuotasWithoutClearingOrNotifying(t *testing.T) {
+	t.Parallel()
+
+	identifier := core.PeerID("identifier")
+	size := uint64(100)
+	numMessages := 3
+	numNotifications := 0
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	arg.MaxTotalSizePerPeer = 10000
+	arg.StatusHandlers = []QuotaStatusHandler{
+		&mock.QuotaStatusHandlerStub{
+			ResetStatisticsCalled: func() {
+				numNotifications++
+			},
+			AddQuotaCalled: func(pid core.PeerID, numReceivedMessages uint32, sizeReceivedMessages uint64, numProcessedMessages uint32, sizeProcessedMessages uint64) {
+				numNotifications++
+			},
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	for i := 0; i < numMessages; i++ {
+		_ = qfp.IncreaseLoad(identifier, size)
+	}
+
+	snapshot := qfp.Snapshot()
+
+	expectedQuota := PeerQuota{
+		Pid:                   identifier,
+		NumReceivedMessages:   uint32(numMessages),
+		SizeReceivedMessages:  uint64(numMessages) * size,
+		NumProcessedMessages:  uint32(numMessages),
+		SizeProcessedMessages: uint64(numMessages) * size,
+	}
+	assert.Equal(t, []PeerQuota{expectedQuota}, snapshot)
+	assert.Equal(t, 1, arg.Cacher.Len())
+	assert.Equal(t, 0, numNotifications)
+
+	// the quota was kept so the counting continues from the returned values
+	_ = qfp.IncreaseLoad(identifier, size)
+	snapshot = qfp.Snapshot()
+	assert.Equal(t, uint32(numMessages+1), snapshot[0].NumReceivedMessages)
+	assert.Equal(t, 0, numNotifications)
+}
+
 func TestCountersMap_IncrementAndResetShouldWorkConcurrently(t *testing.T) {
 	t.Parallel()
 
@@ -490,3 +733,73 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+//------- over quota intervals
+
+func overQuotaInterval(qfp *quotaFloodPreventer, pid core.PeerID) {
+	for i := 0; i < 2; i++ {
+		_ = qfp.IncreaseLoad(pid, minTotalSize)
+	}
+	qfp.Reset()
+}
+
+func TestQuotaFloodPreventer_RepeatedOverQuotaShouldSignalAtThreshold(t *testing.T) {
+	t.Parallel()
+
+	threshold := uint32(3)
+	pid := core.PeerID("pid")
+	numSignals := 0
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	arg.OverQuotaCacher = mock.NewCacherMock()
+	arg.OverQuotaThreshold = threshold
+	arg.OverQuotaHandler = &mock.RepeatedOverQuotaHandlerStub{
+		RepeatedOverQuotaCalled: func(p core.PeerID, numIntervals uint32) {
+			assert.Equal(t, pid, p)
+			assert.Equal(t, threshold, numIntervals)
+			numSignals++
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	for i := uint32(1); i < threshold; i++ {
+		overQuotaInterval(qfp, pid)
+		assert.Equal(t, 0, numSignals)
+		assert.Equal(t, i, qfp.getNumOverQuotaIntervals(pid.Bytes()))
+	}
+
+	overQuotaInterval(qfp, pid)
+	assert.Equal(t, 1, numSignals)
+	assert.Equal(t, uint32(0), qfp.getNumOverQuotaIntervals(pid.Bytes()))
+}
+
+func TestQuotaFloodPreventer_OverQuotaIntervalsShouldDecayWhenPeerBehaves(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	arg := createDefaultArgument()
+	arg.Cacher = mock.NewCacherMock()
+	arg.OverQuotaCacher = mock.NewCacherMock()
+	arg.OverQuotaThreshold = 3
+	arg.OverQuotaHandler = &mock.RepeatedOverQuotaHandlerStub{
+		RepeatedOverQuotaCalled: func(_ core.PeerID, _ uint32) {
+			assert.Fail(t, "should have not signaled the peer")
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	overQuotaInterval(qfp, pid)
+	overQuotaInterval(qfp, pid)
+	assert.Equal(t, uint32(2), qfp.getNumOverQuotaIntervals(pid.Bytes()))
+
+	_ = qfp.IncreaseLoad(pid, minTotalSize)
+	qfp.Reset()
+	assert.Equal(t, uint32(1), qfp.getNumOverQuotaIntervals(pid.Bytes()))
+
+	overQuotaInterval(qfp, pid)
+	assert.Equal(t, uint32(2), qfp.getNumOverQuotaIntervals(pid.Bytes()))
+
+	qfp.Reset()
+	qfp.Reset()
+	assert.Equal(t, uint32(0), qfp.getNumOverQuotaIntervals(pid.Bytes()))
+}
@@ -99,7 +99,7 @@ func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topics []string, numRe
 
 func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	//protect from directly connected peer
-	err := fp.IncreaseLoad(fromConnectedPeer, uint64(len(message.Data())))
+	err := af.increaseLoad(fp, fromConnectedPeer, uint64(len(message.Data())))
 	if err != nil {
 		log.Trace("floodPreventer.IncreaseLoad connected peer",
 			"error", err,
@@ -114,7 +114,7 @@ func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p
 
 	if fromConnectedPeer != message.Peer() {
 		//protect from the flooding messages that originate from the same source but come from different peers
-		err = fp.IncreaseLoad(message.Peer(), uint64(len(message.Data())))
+		err = af.increaseLoad(fp, message.Peer(), uint64(len(message.Data())))
 		if err != nil {
 			log.Trace("floodPreventer.IncreaseLoad originator",
 				"error", err,
@@ -131,6 +131,25 @@ func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p
 	return nil
 }
 
+// increaseLoad accounts the message in the flood preventer and signals the peers that have just used up their quota, as
+// their next messages in the current interval will be rejected
+func (af *p2pAntiflood) increaseLoad(fp process.FloodPreventer, pid core.PeerID, size uint64) error {
+	remainingMessages, remainingBytes, err := fp.IncreaseLoadWithRemaining(pid, size)
+	if err != nil {
+		return err
+	}
+
+	if remainingMessages == 0 || remainingBytes == 0 {
+		log.Debug("floodPreventer quota reached, next messages will be rejected",
+			"pid", p2p.PeerIdToShortString(pid),
+			"remaining messages", remainingMessages,
+			"remaining bytes", remainingBytes,
+		)
+	}
+
+	return nil
+}
+
 // CanProcessMessagesOnTopic signals if a p2p message can be processed or not for a given topic
 func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64) error {
 	err := af.topicPreventer.IncreaseLoad(peer, topic, numMessages)
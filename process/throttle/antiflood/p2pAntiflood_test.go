@@ -188,6 +188,36 @@ func TestP2PAntiflood_ShouldWorkWithMoreThanOneFlodPreventer(t *testing.T) {
 	assert.Equal(t, 4, numIncreasedLoads)
 }
 
+func TestP2PAntiflood_CanProcessMessageQuotaJustReachedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	messageOriginator := core.PeerID("originator")
+	fromConnectedPeer := core.PeerID("from connected peer")
+	message := &mock.P2PMessageMock{
+		DataField: []byte("data"),
+		PeerField: messageOriginator,
+	}
+	accountedPids := make([]core.PeerID, 0)
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{
+			IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+				assert.Fail(t, "should have accounted the load with the remaining allowance")
+				return nil
+			},
+			IncreaseLoadWithRemainingCalled: func(pid core.PeerID, size uint64) (uint32, uint64, error) {
+				accountedPids = append(accountedPids, pid)
+				return 0, 0, nil
+			},
+		},
+	)
+
+	err := afm.CanProcessMessage(message, fromConnectedPeer)
+	assert.Nil(t, err)
+	assert.Equal(t, []core.PeerID{fromConnectedPeer, messageOriginator}, accountedPids)
+}
+
 //------- CanProcessMessagesOnTopic
 
 func TestP2pAntiflood_CanProcessMessagesOnTopicCanNotAccumulateShouldError(t *testing.T) {
@@ -0,0 +1,143 @@
+package antiflood
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const trieNodesAntifloodInterval = time.Second
+
+var _ process.P2PAntifloodHandler = (*trieNodesAntiflood)(nil)
+
+// trieNodesAntiflood is a dedicated rate limiter for the trie nodes topics. The maximum number of messages and the
+// maximum total size accepted from a connected peer are relaxed while the node is syncing, as the trie nodes are
+// requested in large numbers, and tightened otherwise
+type trieNodesAntiflood struct {
+	syncStateHandler              process.SyncStateHandler
+	maxMessagesPerIntervalInSync  uint32
+	maxMessagesPerInterval        uint32
+	maxTotalSizePerIntervalInSync uint64
+	maxTotalSizePerInterval       uint64
+	mutCounters                   sync.Mutex
+	numMessagesPerPeer            map[core.PeerID]uint32
+	totalSizePerPeer              map[core.PeerID]uint64
+	intervalStartTime             time.Time
+}
+
+// NewTrieNodesAntiflood creates a new trie nodes rate limiter
+func NewTrieNodesAntiflood(
+	syncStateHandler process.SyncStateHandler,
+	maxMessagesPerSecDuringSync uint32,
+	maxMessagesPerSec uint32,
+	maxTotalSizePerSecDuringSync uint64,
+	maxTotalSizePerSec uint64,
+) (*trieNodesAntiflood, error) {
+	if check.IfNil(syncStateHandler) {
+		return nil, process.ErrNilSyncStateHandler
+	}
+	if maxMessagesPerSec == 0 {
+		return nil, fmt.Errorf("%w, maxMessagesPerSec: provided %d", process.ErrInvalidValue, maxMessagesPerSec)
+	}
+	if maxMessagesPerSecDuringSync < maxMessagesPerSec {
+		return nil, fmt.Errorf("%w, maxMessagesPerSecDuringSync: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxMessagesPerSecDuringSync,
+			maxMessagesPerSec,
+		)
+	}
+	if maxTotalSizePerSec == 0 {
+		return nil, fmt.Errorf("%w, maxTotalSizePerSec: provided %d", process.ErrInvalidValue, maxTotalSizePerSec)
+	}
+	if maxTotalSizePerSecDuringSync < maxTotalSizePerSec {
+		return nil, fmt.Errorf("%w, maxTotalSizePerSecDuringSync: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxTotalSizePerSecDuringSync,
+			maxTotalSizePerSec,
+		)
+	}
+
+	return &trieNodesAntiflood{
+		syncStateHandler:              syncStateHandler,
+		maxMessagesPerIntervalInSync:  maxMessagesPerSecDuringSync,
+		maxMessagesPerInterval:        maxMessagesPerSec,
+		maxTotalSizePerIntervalInSync: maxTotalSizePerSecDuringSync,
+		maxTotalSizePerInterval:       maxTotalSizePerSec,
+		numMessagesPerPeer:            make(map[core.PeerID]uint32),
+		totalSizePerPeer:              make(map[core.PeerID]uint64),
+	}, nil
+}
+
+// CanProcessMessage returns an error if the connected peer has already sent the maximum number of trie nodes
+// messages or the maximum total size allowed in the current interval
+func (tna *trieNodesAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if message == nil {
+		return p2p.ErrNilMessage
+	}
+
+	maxMessages, maxTotalSize := tna.limits()
+
+	tna.mutCounters.Lock()
+	defer tna.mutCounters.Unlock()
+
+	now := time.Now()
+	if now.Sub(tna.intervalStartTime) >= trieNodesAntifloodInterval {
+		tna.numMessagesPerPeer = make(map[core.PeerID]uint32)
+		tna.totalSizePerPeer = make(map[core.PeerID]uint64)
+		tna.intervalStartTime = now
+	}
+
+	numMessages := tna.numMessagesPerPeer[fromConnectedPeer] + 1
+	tna.numMessagesPerPeer[fromConnectedPeer] = numMessages
+	if numMessages > maxMessages {
+		return fmt.Errorf("%w in trieNodesAntiflood for connected peer %s, maximum %d messages",
+			process.ErrSystemBusy,
+			fromConnectedPeer.Pretty(),
+			maxMessages,
+		)
+	}
+
+	totalSize := tna.totalSizePerPeer[fromConnectedPeer] + uint64(len(message.Data()))
+	tna.totalSizePerPeer[fromConnectedPeer] = totalSize
+	if totalSize > maxTotalSize {
+		return fmt.Errorf("%w in trieNodesAntiflood for connected peer %s, maximum %d bytes",
+			process.ErrSystemBusy,
+			fromConnectedPeer.Pretty(),
+			maxTotalSize,
+		)
+	}
+
+	return nil
+}
+
+func (tna *trieNodesAntiflood) limits() (uint32, uint64) {
+	if tna.syncStateHandler.IsSyncing() {
+		return tna.maxMessagesPerIntervalInSync, tna.maxTotalSizePerIntervalInSync
+	}
+
+	return tna.maxMessagesPerInterval, tna.maxTotalSizePerInterval
+}
+
+// CanProcessMessagesOnTopic returns nil as the messages are only counted in CanProcessMessage
+func (tna *trieNodesAntiflood) CanProcessMessagesOnTopic(_ core.PeerID, _ string, _ uint32, _ uint64) error {
+	return nil
+}
+
+// ApplyConsensusSize does nothing as the trie nodes limits do not depend on the consensus size
+func (tna *trieNodesAntiflood) ApplyConsensusSize(_ int) {
+}
+
+// SetDebugger returns nil as the trie nodes limiter does not use a debugger
+func (tna *trieNodesAntiflood) SetDebugger(_ process.AntifloodDebugger) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tna *trieNodesAntiflood) IsInterfaceNil() bool {
+	return tna == nil
+}
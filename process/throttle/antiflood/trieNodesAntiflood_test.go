@@ -0,0 +1,134 @@
+package antiflood_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrieNodesAntiflood_NilSyncStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tna, err := antiflood.NewTrieNodesAntiflood(nil, 100, 10, 1000, 100)
+
+	assert.True(t, check.IfNil(tna))
+	assert.Equal(t, process.ErrNilSyncStateHandler, err)
+}
+
+func TestNewTrieNodesAntiflood_ZeroMaxMessagesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tna, err := antiflood.NewTrieNodesAntiflood(&mock.SyncStateHandlerStub{}, 100, 0, 1000, 100)
+
+	assert.True(t, check.IfNil(tna))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewTrieNodesAntiflood_SyncLimitLowerThanTheNormalOneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tna, err := antiflood.NewTrieNodesAntiflood(&mock.SyncStateHandlerStub{}, 9, 10, 1000, 100)
+
+	assert.True(t, check.IfNil(tna))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewTrieNodesAntiflood_ZeroMaxTotalSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tna, err := antiflood.NewTrieNodesAntiflood(&mock.SyncStateHandlerStub{}, 100, 10, 1000, 0)
+
+	assert.True(t, check.IfNil(tna))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewTrieNodesAntiflood_SyncTotalSizeLowerThanTheNormalOneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tna, err := antiflood.NewTrieNodesAntiflood(&mock.SyncStateHandlerStub{}, 100, 10, 99, 100)
+
+	assert.True(t, check.IfNil(tna))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestTrieNodesAntiflood_CanProcessMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tna, _ := antiflood.NewTrieNodesAntiflood(&mock.SyncStateHandlerStub{}, 100, 10, 1000, 100)
+
+	err := tna.CanProcessMessage(nil, "pid")
+
+	assert.NotNil(t, err)
+}
+
+func TestTrieNodesAntiflood_LimitShouldBeRelaxedWhileSyncingAndTightenedOtherwise(t *testing.T) {
+	t.Parallel()
+
+	isSyncing := atomic.Flag{}
+	isSyncing.Set()
+	syncStateHandler := &mock.SyncStateHandlerStub{
+		IsSyncingCalled: func() bool {
+			return isSyncing.IsSet()
+		},
+	}
+	maxMessagesDuringSync := 20
+	maxMessages := 5
+	tna, _ := antiflood.NewTrieNodesAntiflood(syncStateHandler, uint32(maxMessagesDuringSync), uint32(maxMessages), 1000, 100)
+	message := &mock.P2PMessageMock{DataField: []byte("trie node")}
+
+	numAccepted := countAcceptedMessages(tna, message, "syncing peer", maxMessagesDuringSync+1)
+	assert.Equal(t, maxMessagesDuringSync, numAccepted)
+
+	isSyncing.Unset()
+	numAccepted = countAcceptedMessages(tna, message, "synced peer", maxMessagesDuringSync+1)
+	assert.Equal(t, maxMessages, numAccepted)
+}
+
+func TestTrieNodesAntiflood_TotalSizeShouldBeRelaxedWhileSyncingAndTightenedOtherwise(t *testing.T) {
+	t.Parallel()
+
+	isSyncing := atomic.Flag{}
+	isSyncing.Set()
+	syncStateHandler := &mock.SyncStateHandlerStub{
+		IsSyncingCalled: func() bool {
+			return isSyncing.IsSet()
+		},
+	}
+	message := &mock.P2PMessageMock{DataField: make([]byte, 10)}
+	tna, _ := antiflood.NewTrieNodesAntiflood(syncStateHandler, 100, 100, 80, 30)
+
+	numAccepted := countAcceptedMessages(tna, message, "syncing peer", 10)
+	assert.Equal(t, 8, numAccepted)
+
+	isSyncing.Unset()
+	numAccepted = countAcceptedMessages(tna, message, "synced peer", 10)
+	assert.Equal(t, 3, numAccepted)
+}
+
+func countAcceptedMessages(
+	tna process.P2PAntifloodHandler,
+	message *mock.P2PMessageMock,
+	pid core.PeerID,
+	numMessages int,
+) int {
+	numAccepted := 0
+	for i := 0; i < numMessages; i++ {
+		err := tna.CanProcessMessage(message, pid)
+		if err == nil {
+			numAccepted++
+			continue
+		}
+		if !errors.Is(err, process.ErrSystemBusy) {
+			return -1
+		}
+	}
+
+	return numAccepted
+}
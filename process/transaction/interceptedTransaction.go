@@ -222,6 +222,11 @@ func (inTx *InterceptedTransaction) SenderShardId() uint32 {
 	return inTx.sndShard
 }
 
+// SelfShardId returns the shard id the transaction was checked against
+func (inTx *InterceptedTransaction) SelfShardId() uint32 {
+	return inTx.coordinator.SelfId()
+}
+
 // Nonce returns the transaction nonce
 func (inTx *InterceptedTransaction) Nonce() uint64 {
 	return inTx.tx.Nonce
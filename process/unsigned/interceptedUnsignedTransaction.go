@@ -150,6 +150,11 @@ func (inUTx *InterceptedUnsignedTransaction) SenderShardId() uint32 {
 	return inUTx.sndShard
 }
 
+// SelfShardId returns the shard id the transaction was checked against
+func (inUTx *InterceptedUnsignedTransaction) SelfShardId() uint32 {
+	return inUTx.coordinator.SelfId()
+}
+
 // IsForCurrentShard returns true if this transaction is meant to be processed by the node from this shard
 func (inUTx *InterceptedUnsignedTransaction) IsForCurrentShard() bool {
 	return inUTx.isForCurrentShard
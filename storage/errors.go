@@ -117,3 +117,9 @@ var ErrNegativeSizeInBytes = errors.New("negative size in bytes")
 
 // ErrNilTimeCache signals that a nil time cache has been provided
 var ErrNilTimeCache = errors.New("nil time cache")
+
+// ErrEmptyDBFilePath signals that an empty file path was provided for a persistent database
+var ErrEmptyDBFilePath = errors.New("empty database file path")
+
+// ErrPathNotWritable signals that the database files can not be written at the provided path
+var ErrPathNotWritable = errors.New("path is not writable")
@@ -179,6 +179,14 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	txPoolSnapshotUnit, err := psf.createTxPoolSnapshotUnit()
+	if err != nil {
+		return nil, err
+	}
+	if txPoolSnapshotUnit != nil {
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txPoolSnapshotUnit)
+	}
+
 	bootstrapUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage)
 	bootstrapUnit, err = pruning.NewPruningStorer(bootstrapUnitArgs)
 	if err != nil {
@@ -208,6 +216,9 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if txPoolSnapshotUnit != nil {
+		store.AddStorer(dataRetriever.TxPoolSnapshotUnit, txPoolSnapshotUnit)
+	}
 
 	return store, err
 }
@@ -295,6 +306,14 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	txPoolSnapshotUnit, err := psf.createTxPoolSnapshotUnit()
+	if err != nil {
+		return nil, err
+	}
+	if txPoolSnapshotUnit != nil {
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txPoolSnapshotUnit)
+	}
+
 	txUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxStorage)
 	txUnit, err = pruning.NewPruningStorer(txUnitArgs)
 	if err != nil {
@@ -353,10 +372,30 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if txPoolSnapshotUnit != nil {
+		store.AddStorer(dataRetriever.TxPoolSnapshotUnit, txPoolSnapshotUnit)
+	}
 
 	return store, err
 }
 
+// createTxPoolSnapshotUnit creates the static storer of the transactions pool snapshot. Nil is returned if the
+// snapshot is disabled
+func (psf *StorageServiceFactory) createTxPoolSnapshotUnit() (storage.Storer, error) {
+	if !psf.generalConfig.TxPoolSnapshotConfig.Enabled {
+		return nil, nil
+	}
+
+	shardId := core.GetShardIdString(psf.shardCoordinator.SelfId())
+	txPoolSnapshotDbConfig := GetDBFromConfig(psf.generalConfig.TxPoolSnapshotStorage.DB)
+	txPoolSnapshotDbConfig.FilePath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.TxPoolSnapshotStorage.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.TxPoolSnapshotStorage.Cache),
+		txPoolSnapshotDbConfig,
+		GetBloomFromConfig(psf.generalConfig.TxPoolSnapshotStorage.Bloom))
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.StorageConfig) *pruning.StorerArgs {
 	fullArchiveMode := psf.generalConfig.StoragePruning.FullArchive
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
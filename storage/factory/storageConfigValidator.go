@@ -0,0 +1,134 @@
+package factory
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+type namedStorageConfig struct {
+	name     string
+	config   config.StorageConfig
+	isStatic bool
+}
+
+// Validate checks the configs of all the storers needed by the current shard without opening any persister
+func (psf *StorageServiceFactory) Validate() error {
+	for _, namedConfig := range psf.getStorageConfigsToValidate() {
+		err := psf.validateStorageConfig(namedConfig)
+		if err != nil {
+			return fmt.Errorf("%w for %s", err, namedConfig.name)
+		}
+	}
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) getStorageConfigsToValidate() []namedStorageConfig {
+	cfg := psf.generalConfig
+	namedConfigs := []namedStorageConfig{
+		{name: "TxStorage", config: cfg.TxStorage},
+		{name: "UnsignedTransactionStorage", config: cfg.UnsignedTransactionStorage},
+		{name: "RewardTxStorage", config: cfg.RewardTxStorage},
+		{name: "MiniBlocksStorage", config: cfg.MiniBlocksStorage},
+		{name: "BlockHeaderStorage", config: cfg.BlockHeaderStorage},
+		{name: "MetaBlockStorage", config: cfg.MetaBlockStorage},
+		{name: "MetaHdrNonceHashStorage", config: cfg.MetaHdrNonceHashStorage},
+		{name: "ShardHdrNonceHashStorage", config: cfg.ShardHdrNonceHashStorage},
+		{name: "BootstrapStorage", config: cfg.BootstrapStorage},
+		{name: "TxLogsStorage", config: cfg.TxLogsStorage},
+		{name: "HeartbeatStorage", config: cfg.Heartbeat.HeartbeatStorage, isStatic: true},
+		{name: "StatusMetricsStorage", config: cfg.StatusMetricsStorage, isStatic: true},
+	}
+	if psf.shardCoordinator.SelfId() != core.MetachainShardId {
+		namedConfigs = append(namedConfigs, namedStorageConfig{name: "PeerBlockBodyStorage", config: cfg.PeerBlockBodyStorage})
+	}
+	if cfg.TxPoolSnapshotConfig.Enabled {
+		namedConfigs = append(namedConfigs, namedStorageConfig{name: "TxPoolSnapshotStorage", config: cfg.TxPoolSnapshotStorage, isStatic: true})
+	}
+
+	return namedConfigs
+}
+
+func (psf *StorageServiceFactory) validateStorageConfig(namedConfig namedStorageConfig) error {
+	storageConfig := namedConfig.config
+	cacheConfig := GetCacherFromConfig(storageConfig.Cache)
+	if storageConfig.DB.MaxBatchSize > int(cacheConfig.Capacity) {
+		return storage.ErrCacheSizeIsLowerThanBatchSize
+	}
+
+	_, err := storageUnit.NewCache(cacheConfig.Type, cacheConfig.Capacity, cacheConfig.Shards, cacheConfig.SizeInBytes)
+	if err != nil {
+		return err
+	}
+
+	bloomConfig := GetBloomFromConfig(storageConfig.Bloom)
+	if !reflect.DeepEqual(bloomConfig, storageUnit.BloomConfig{}) {
+		_, err = storageUnit.NewBloomFilter(bloomConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch storageUnit.DBType(storageConfig.DB.Type) {
+	case storageUnit.MemoryDB:
+		return nil
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial:
+		if len(storageConfig.DB.FilePath) == 0 {
+			return storage.ErrEmptyDBFilePath
+		}
+
+		return checkPathIsWritable(psf.computeDBPath(namedConfig))
+	default:
+		return storage.ErrNotSupportedDBType
+	}
+}
+
+func (psf *StorageServiceFactory) computeDBPath(namedConfig namedStorageConfig) string {
+	shardId := core.GetShardIdString(psf.shardCoordinator.SelfId())
+	if namedConfig.isStatic {
+		return psf.pathManager.PathForStatic(shardId, namedConfig.config.DB.FilePath)
+	}
+
+	return psf.pathManager.PathForEpoch(shardId, psf.currentEpoch, namedConfig.config.DB.FilePath)
+}
+
+// checkPathIsWritable verifies that files can be created in the closest existing directory of the provided path
+func checkPathIsWritable(path string) error {
+	dir := filepath.Clean(path)
+	for {
+		info, err := os.Stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%w, %s is not a directory", storage.ErrPathNotWritable, dir)
+			}
+			break
+		}
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("%w, %s: %v", storage.ErrPathNotWritable, dir, err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return fmt.Errorf("%w, no existing directory found for %s", storage.ErrPathNotWritable, path)
+		}
+		dir = parent
+	}
+
+	file, err := ioutil.TempFile(dir, ".write_check")
+	if err != nil {
+		return fmt.Errorf("%w, %s: %v", storage.ErrPathNotWritable, dir, err)
+	}
+
+	_ = file.Close()
+	_ = os.Remove(file.Name())
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package pathmanager
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.PathManagerHandler = (*epochAwarePathManager)(nil)
+
+// epochAwarePathManager will resolve the paths of the epochs older than the active epoch through an archive path
+// manager, while the active and the following epochs, as well as the static storers, use the main path manager
+type epochAwarePathManager struct {
+	mainPathManager    storage.PathManagerHandler
+	archivePathManager storage.PathManagerHandler
+	activeEpoch        uint32
+}
+
+// NewEpochAwarePathManager will return a new instance of epochAwarePathManager if the provided arguments are fine
+func NewEpochAwarePathManager(
+	mainPathManager storage.PathManagerHandler,
+	archivePathManager storage.PathManagerHandler,
+	activeEpoch uint32,
+) (*epochAwarePathManager, error) {
+	if check.IfNil(mainPathManager) {
+		return nil, storage.ErrNilPathManager
+	}
+	if check.IfNil(archivePathManager) {
+		return nil, fmt.Errorf("%w for the archive paths", storage.ErrNilPathManager)
+	}
+
+	return &epochAwarePathManager{
+		mainPathManager:    mainPathManager,
+		archivePathManager: archivePathManager,
+		activeEpoch:        activeEpoch,
+	}, nil
+}
+
+// PathForEpoch will return the archive path for the epochs older than the active epoch and the main path otherwise
+func (eapm *epochAwarePathManager) PathForEpoch(shardId string, epoch uint32, identifier string) string {
+	if epoch < eapm.activeEpoch {
+		return eapm.archivePathManager.PathForEpoch(shardId, epoch, identifier)
+	}
+
+	return eapm.mainPathManager.PathForEpoch(shardId, epoch, identifier)
+}
+
+// PathForStatic will return the main path for a static storer
+func (eapm *epochAwarePathManager) PathForStatic(shardId string, identifier string) string {
+	return eapm.mainPathManager.PathForStatic(shardId, identifier)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eapm *epochAwarePathManager) IsInterfaceNil() bool {
+	return eapm == nil
+}
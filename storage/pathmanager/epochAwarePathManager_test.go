@@ -0,0 +1,42 @@
+package pathmanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEpochAwarePathManager_NilMainPathManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	archive, _ := pathmanager.NewPathManager("archive/epoch_[E]/shard_[S]/[I]", "shard_[S]/[I]")
+	eapm, err := pathmanager.NewEpochAwarePathManager(nil, archive, 0)
+	assert.True(t, check.IfNil(eapm))
+	assert.Equal(t, storage.ErrNilPathManager, err)
+}
+
+func TestNewEpochAwarePathManager_NilArchivePathManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	main, _ := pathmanager.NewPathManager("epoch_[E]/shard_[S]/[I]", "shard_[S]/[I]")
+	eapm, err := pathmanager.NewEpochAwarePathManager(main, nil, 0)
+	assert.True(t, check.IfNil(eapm))
+	assert.True(t, errors.Is(err, storage.ErrNilPathManager))
+}
+
+func TestEpochAwarePathManager_PathForEpochShouldUseArchiveForOldEpochs(t *testing.T) {
+	t.Parallel()
+
+	main, _ := pathmanager.NewPathManager("epoch_[E]/shard_[S]/[I]", "shard_[S]/[I]")
+	archive, _ := pathmanager.NewPathManager("archive/epoch_[E]/shard_[S]/[I]", "archive/shard_[S]/[I]")
+	eapm, _ := pathmanager.NewEpochAwarePathManager(main, archive, 5)
+
+	assert.Equal(t, "archive/epoch_4/shard_0/db", eapm.PathForEpoch("0", 4, "db"))
+	assert.Equal(t, "epoch_5/shard_0/db", eapm.PathForEpoch("0", 5, "db"))
+	assert.Equal(t, "epoch_6/shard_0/db", eapm.PathForEpoch("0", 6, "db"))
+	assert.Equal(t, "shard_0/db", eapm.PathForStatic("0", "db"))
+}
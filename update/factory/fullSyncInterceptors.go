@@ -1,6 +1,7 @@
 package factory
 
 import (
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
@@ -23,6 +24,8 @@ import (
 
 var _ process.InterceptorsContainerFactory = (*fullSyncInterceptorsContainerFactory)(nil)
 
+var log = logger.GetOrCreate("update/factory")
+
 const numGoRoutines = 2000
 
 // fullSyncInterceptorsContainerFactory will handle the creation the interceptors container for shards
@@ -47,6 +50,7 @@ type fullSyncInterceptorsContainerFactory struct {
 	whiteListHandler       update.WhiteListHandler
 	whiteListerVerifiedTxs update.WhiteListHandler
 	antifloodHandler       process.P2PAntifloodHandler
+	registeredTopics       []string
 }
 
 // ArgsNewFullSyncInterceptorsContainerFactory holds the arguments needed for fullSyncInterceptorsContainerFactory
@@ -165,6 +169,8 @@ func NewFullSyncInterceptorsContainerFactory(
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		// the headers are requested while syncing the state for the hardfork, so their epoch is not checked
+		SkipHeaderEpochCheck: true,
 	}
 
 	icf := &fullSyncInterceptorsContainerFactory{
@@ -455,7 +461,14 @@ func (ficf *fullSyncInterceptorsContainerFactory) createTopicAndAssignHandler(
 		return nil, err
 	}
 
-	return interceptor, ficf.messenger.RegisterMessageProcessor(topic, interceptor)
+	err = ficf.messenger.RegisterMessageProcessor(topic, interceptor)
+	if err != nil {
+		return nil, err
+	}
+
+	ficf.registeredTopics = append(ficf.registeredTopics, topic)
+
+	return interceptor, nil
 }
 
 func (ficf *fullSyncInterceptorsContainerFactory) generateTxInterceptors() error {
@@ -782,6 +795,28 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateRewardTxInterceptors()
 	return ficf.container.AddMultiple(keys, interceptorSlice)
 }
 
+// Close unregisters the interceptors from the topics registered by this factory and removes them from the container.
+// The interceptors found in the container when Create was called are left in place
+func (ficf *fullSyncInterceptorsContainerFactory) Close() error {
+	var lastErr error
+	for _, topic := range ficf.registeredTopics {
+		err := ficf.messenger.UnregisterMessageProcessor(topic)
+		if err != nil {
+			log.Warn("fullSyncInterceptorsContainerFactory.Close: unregister message processor",
+				"topic", topic,
+				"error", err.Error(),
+			)
+			lastErr = err
+		}
+
+		ficf.container.Remove(topic)
+	}
+
+	ficf.registeredTopics = nil
+
+	return lastErr
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ficf *fullSyncInterceptorsContainerFactory) IsInterfaceNil() bool {
 	return ficf == nil